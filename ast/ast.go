@@ -1,6 +1,10 @@
 package ast
 
-import "github.com/LissaGreense/GO4SQL/token"
+import (
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
 
 // Sequence - Sequence of operations commands
 //
@@ -70,6 +74,172 @@ type Anonymitifier struct {
 func (ls Anonymitifier) IsIdentifier() bool    { return false }
 func (ls Anonymitifier) GetToken() token.Token { return ls.Token }
 
+// FunctionCall - Represent scalar function called with arguments, which are evaluated separately for every row
+//
+// Example:
+// UPPER(column1)
+// first_name || ' ' || last_name
+// price * (1 + tax)
+// ARRAY['a', 'b']
+// tags[1]
+type FunctionCall struct {
+	Name      token.Token // function name or operator, ex. UPPER, token.CONCAT or token.PLUS
+	Arguments []Tifier
+}
+
+func (ls FunctionCall) IsIdentifier() bool    { return false }
+func (ls FunctionCall) GetToken() token.Token { return ls.Name }
+
+// GetIdentifiers - Return array of all Identifiers used as arguments, including nested function calls
+func (ls FunctionCall) GetIdentifiers() []Identifier {
+	var identifiers []Identifier
+	for _, argument := range ls.Arguments {
+		identifiers = append(identifiers, GetTifierIdentifiers(argument)...)
+	}
+	return identifiers
+}
+
+// String - Return function call in the same form as it was written in command
+func (ls FunctionCall) String() string {
+	arguments := make([]string, 0, len(ls.Arguments))
+	for _, argument := range ls.Arguments {
+		arguments = append(arguments, TifierToString(argument))
+	}
+
+	switch ls.Name.Type {
+	case token.CONCAT, token.PLUS, token.MINUS, token.ASTERISK, token.SLASH:
+		if len(arguments) == 1 {
+			return ls.Name.Literal + wrapOperand(ls.Arguments[0], arguments[0], ls.GetPrecedence())
+		}
+		left := wrapOperand(ls.Arguments[0], arguments[0], ls.GetPrecedence())
+		// operators are left associative, so right operand of the same precedence must be wrapped
+		right := wrapOperand(ls.Arguments[1], arguments[1], ls.GetPrecedence()+1)
+		return left + " " + ls.Name.Literal + " " + right
+	case token.TYPECAST:
+		return arguments[0] + ls.Name.Literal + arguments[1]
+	case token.ARROW, token.DOUBLE_ARROW:
+		left := wrapOperand(ls.Arguments[0], arguments[0], ls.GetPrecedence())
+		right := wrapOperand(ls.Arguments[1], arguments[1], ls.GetPrecedence()+1)
+		return left + ls.Name.Literal + right
+	case token.CAST:
+		return ls.Name.Literal + "(" + arguments[0] + " AS " + arguments[1] + ")"
+	case token.ARRAY:
+		return ls.Name.Literal + "[" + strings.Join(arguments, ", ") + "]"
+	case token.LBRACKET:
+		return wrapOperand(ls.Arguments[0], arguments[0], ls.GetPrecedence()) + "[" + arguments[1] + "]"
+	case token.EXTRACT:
+		return ls.Name.Literal + "(" + ls.Arguments[0].GetToken().Literal + " " + token.FROM + " " + arguments[1] + ")"
+	}
+	return ls.Name.Literal + "(" + strings.Join(arguments, ", ") + ")"
+}
+
+// GetPrecedence - Return binding power of the operator, function calls written with parentheses have the highest
+// precedence
+func (ls FunctionCall) GetPrecedence() int {
+	switch ls.Name.Type {
+	case token.CONCAT:
+		return 1
+	case token.PLUS, token.MINUS:
+		if len(ls.Arguments) == 1 {
+			return 4
+		}
+		return 2
+	case token.ASTERISK, token.SLASH:
+		return 3
+	default:
+		return 5
+	}
+}
+
+// wrapOperand - Wrap operand text with parentheses when it binds weaker than required precedence
+func wrapOperand(operand Tifier, text string, requiredPrecedence int) string {
+	functionCall, isFunctionCall := operand.(FunctionCall)
+	if isFunctionCall && functionCall.GetPrecedence() < requiredPrecedence {
+		return "(" + text + ")"
+	}
+	return text
+}
+
+// GetTifierIdentifiers - Return Identifiers that value of Tifier depends on
+func GetTifierIdentifiers(tifier Tifier) []Identifier {
+	switch mappedTifier := tifier.(type) {
+	case Identifier:
+		return []Identifier{mappedTifier}
+	case FunctionCall:
+		return mappedTifier.GetIdentifiers()
+	default:
+		return []Identifier{}
+	}
+}
+
+// RenameTifierIdentifiers - Return copy of Tifier in which Identifiers with old name are replaced with new name
+func RenameTifierIdentifiers(tifier Tifier, oldName string, newName string) Tifier {
+	switch mappedTifier := tifier.(type) {
+	case Identifier:
+		if mappedTifier.Token.Literal == oldName {
+			mappedTifier.Token.Literal = newName
+		}
+		return mappedTifier
+	case FunctionCall:
+		arguments := make([]Tifier, 0, len(mappedTifier.Arguments))
+		for _, argument := range mappedTifier.Arguments {
+			arguments = append(arguments, RenameTifierIdentifiers(argument, oldName, newName))
+		}
+		return FunctionCall{Name: mappedTifier.Name, Arguments: arguments}
+	default:
+		return tifier
+	}
+}
+
+// RenameExpressionIdentifiers - Return copy of Expression in which Identifiers with old name are replaced with new
+// name, ex. condition of CHECK constraint after column was renamed
+func RenameExpressionIdentifiers(expression Expression, oldName string, newName string) Expression {
+	switch mappedExpression := expression.(type) {
+	case *OperationExpression:
+		return &OperationExpression{
+			Left:      RenameExpressionIdentifiers(mappedExpression.Left, oldName, newName),
+			Right:     RenameExpressionIdentifiers(mappedExpression.Right, oldName, newName),
+			Operation: mappedExpression.Operation,
+		}
+	case *ConditionExpression:
+		return &ConditionExpression{
+			Left:       RenameTifierIdentifiers(mappedExpression.Left, oldName, newName),
+			Right:      RenameTifierIdentifiers(mappedExpression.Right, oldName, newName),
+			Condition:  mappedExpression.Condition,
+			Quantifier: mappedExpression.Quantifier,
+		}
+	case *ContainExpression:
+		return &ContainExpression{
+			Left:     RenameTifierIdentifiers(mappedExpression.Left, oldName, newName),
+			Right:    mappedExpression.Right,
+			Contains: mappedExpression.Contains,
+		}
+	case *PredicateExpression:
+		return &PredicateExpression{Value: RenameTifierIdentifiers(mappedExpression.Value, oldName, newName)}
+	default:
+		return expression
+	}
+}
+
+// TifierToString - Return Tifier in the same form as it was written in command, text and binary values are wrapped
+// with apostrophes
+func TifierToString(tifier Tifier) string {
+	switch mappedTifier := tifier.(type) {
+	case FunctionCall:
+		return mappedTifier.String()
+	case Anonymitifier:
+		if mappedTifier.Token.Type == token.IDENT {
+			return "'" + mappedTifier.Token.Literal + "'"
+		}
+		if mappedTifier.Token.Type == token.HEX {
+			return "X'" + mappedTifier.Token.Literal + "'"
+		}
+		return mappedTifier.Token.Literal
+	default:
+		return tifier.GetToken().Literal
+	}
+}
+
 // BooleanExpression - TokenType of Expression that represent single boolean value
 //
 // Example:
@@ -83,6 +253,18 @@ func (ls BooleanExpression) GetIdentifiers() []Identifier {
 	return identifiers
 }
 
+// PredicateExpression - TokenType of Expression that represent boolean column used as condition
+//
+// Example:
+// is_active
+type PredicateExpression struct {
+	Value Tifier // name of column
+}
+
+func (ls PredicateExpression) GetIdentifiers() []Identifier {
+	return GetTifierIdentifiers(ls.Value)
+}
+
 // ConditionExpression - TokenType of Expression that represent condition that is comparing value from column to static one
 //
 // Example:
@@ -90,19 +272,17 @@ func (ls BooleanExpression) GetIdentifiers() []Identifier {
 type ConditionExpression struct {
 	Left      Tifier      // name of column
 	Right     Tifier      // value which column should have
-	Condition token.Token // example: token.EQUAL
+	Condition token.Token // example: token.EQUAL or token.LT
+	// Quantifier - optional token.ANY or token.ALL, when it's set Right is an array and condition is checked for
+	// its elements, ex. 'urgent' EQUAL ANY(tags)
+	Quantifier *token.Token
 }
 
 func (ls ConditionExpression) GetIdentifiers() []Identifier {
 	var identifiers []Identifier
 
-	if ls.Left.IsIdentifier() {
-		identifiers = append(identifiers, Identifier{ls.Left.GetToken()})
-	}
-
-	if ls.Right.IsIdentifier() {
-		identifiers = append(identifiers, Identifier{ls.Right.GetToken()})
-	}
+	identifiers = append(identifiers, GetTifierIdentifiers(ls.Left)...)
+	identifiers = append(identifiers, GetTifierIdentifiers(ls.Right)...)
 
 	return identifiers
 }
@@ -112,13 +292,13 @@ func (ls ConditionExpression) GetIdentifiers() []Identifier {
 // Example:
 // colName IN ('value1', 'value2', 'value3')
 type ContainExpression struct {
-	Left     Identifier      // name of column
+	Left     Tifier          // name of column or function call
 	Right    []Anonymitifier // name of column
 	Contains bool            // IN or NOTIN
 }
 
 func (ls ContainExpression) GetIdentifiers() []Identifier {
-	return []Identifier{ls.Left}
+	return GetTifierIdentifiers(ls.Left)
 }
 
 // OperationExpression - TokenType of Expression that represent 2 other Expressions and conditional operation
@@ -143,34 +323,173 @@ func (ls OperationExpression) GetIdentifiers() []Identifier {
 // CreateCommand - Part of Command that represent creation of table
 //
 // Example:
-// CREATE TABLE table1( one TEXT , two INT);
+// CREATE TABLE table1( one TEXT , two INT, PRIMARY KEY (two));
+// CREATE TABLE IF NOT EXISTS table1( one TEXT );
 type CreateCommand struct {
 	Token       token.Token
 	Name        Identifier // name of the table
+	IfNotExists bool       // command is skipped when the table already exists
 	ColumnNames []string
 	ColumnTypes []token.Token
+	// ColumnTypeParameters - optional parameters of column types, ex. precision and scale of DECIMAL(10, 2)
+	ColumnTypeParameters [][]int
+	// ColumnIsArray - true for columns storing arrays of values of their type, ex. TEXT[]
+	ColumnIsArray []bool
+	// ColumnNotNull - true for columns which can't contain NULL, ex. one INT NOT NULL
+	ColumnNotNull []bool
+	// ColumnDefaults - values used when column is omitted in INSERT, nil for columns without default value
+	ColumnDefaults []Tifier
+	// ColumnIdentities - kind of identity of columns declared with GENERATED ... AS IDENTITY, NoIdentity for other
+	// columns
+	ColumnIdentities []Identity
+	// PrimaryKey - names of columns making primary key of the table, it's empty when table has no primary key
+	PrimaryKey []string
+	// Checks - conditions which have to be fulfilled by every row of the table
+	Checks []CheckConstraint
+	// UniqueConstraints - groups of columns which values can't repeat in different rows of the table
+	UniqueConstraints []UniqueConstraint
+	// ForeignKeys - groups of columns which values have to exist in other table
+	ForeignKeys []ForeignKeyConstraint
+}
+
+// CheckConstraint - Condition written in CREATE TABLE which has to be fulfilled by every row of the table
+//
+// Example:
+// CONSTRAINT positive_price CHECK (price > 0)
+type CheckConstraint struct {
+	Name       string // optional name of the constraint
+	ColumnName string // column after which constraint was written, it's empty for constraints of the table
+	Condition  Expression
+}
+
+// UniqueConstraint - Columns written in CREATE TABLE which values can't repeat in different rows of the table
+//
+// Example:
+// CONSTRAINT unique_email UNIQUE NULLS NOT DISTINCT (email)
+type UniqueConstraint struct {
+	Name             string   // optional name of the constraint
+	ColumnNames      []string // columns making key of the constraint
+	NullsNotDistinct bool     // NULL is treated as equal to other NULL, so key with NULL can be used only once
+}
+
+// Identity - Kind of column which values are generated by sequence
+type Identity string
+
+const (
+	NoIdentity        Identity = ""
+	IdentityAlways    Identity = "ALWAYS"     // value can't be provided in INSERT nor UPDATE
+	IdentityByDefault Identity = "BY DEFAULT" // value is generated only when it isn't provided
+)
+
+// ForeignKeyConstraint - Columns written in CREATE TABLE which values have to exist in unique columns of referenced
+// table
+//
+// Example:
+// CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+type ForeignKeyConstraint struct {
+	Name                  string   // optional name of the constraint
+	ColumnNames           []string // referencing columns of the table
+	ReferencedTable       string
+	ReferencedColumnNames []string // empty when primary key of referenced table is used
+	OnDelete              ReferentialAction
+	OnUpdate              ReferentialAction
 }
 
+// ReferentialAction - What happens with referencing rows when referenced row is deleted or its key is updated
+type ReferentialAction string
+
+const (
+	Restrict ReferentialAction = "RESTRICT" // command fails while any row refers to changed key
+	Cascade  ReferentialAction = "CASCADE"  // referencing rows are deleted or get new value of the key
+	SetNull  ReferentialAction = "SET NULL" // referencing columns are set to NULL
+)
+
 func (ls CreateCommand) CommandNode()         {}
 func (ls CreateCommand) TokenLiteral() string { return ls.Token.Literal }
 
+// CreateTypeCommand - Part of Command that represent creation of user-defined ENUM type
+//
+// Example:
+// CREATE TYPE status AS ENUM ('new', 'active', 'closed');
+type CreateTypeCommand struct {
+	Token  token.Token
+	Name   Identifier // name of the type
+	Labels []string   // allowed values in declaration order
+}
+
+func (ls CreateTypeCommand) CommandNode()         {}
+func (ls CreateTypeCommand) TokenLiteral() string { return ls.Token.Literal }
+
+// CreateSequenceCommand - Part of Command that represent creation of sequence generating unique integers, options
+// which aren't provided are nil
+//
+// Example:
+// CREATE SEQUENCE ids INCREMENT BY 10 MINVALUE 1 MAXVALUE 1000 START WITH 100 CYCLE;
+type CreateSequenceCommand struct {
+	Token     token.Token
+	Name      Identifier // name of the sequence
+	Increment *int64
+	MinValue  *int64
+	MaxValue  *int64
+	Start     *int64
+	Cycle     bool // sequence starts again from the beginning after reaching its limit
+}
+
+func (ls CreateSequenceCommand) CommandNode()         {}
+func (ls CreateSequenceCommand) TokenLiteral() string { return ls.Token.Literal }
+
+// IndexMethod - Structure used by index to find rows, ex. HashIndex
+type IndexMethod string
+
+const (
+	// BTreeIndex - index keeping values in order, it's used for equality, IN and range conditions
+	BTreeIndex IndexMethod = "BTREE"
+	// HashIndex - index mapping values to rows, it's used only for equality and IN conditions
+	HashIndex IndexMethod = "HASH"
+)
+
+// CreateIndexCommand - Part of Command that represent creation of index on column of the table
+//
+// Example:
+// CREATE INDEX idx ON table1 USING HASH (one);
+// CREATE INDEX ON table1 (one, two) INCLUDE (three);
+type CreateIndexCommand struct {
+	Token       token.Token
+	Name        *Identifier // name of the index, nil when it's generated from names of table and columns
+	TableName   Identifier
+	ColumnNames []string
+	// IncludedColumnNames - columns listed in INCLUDE, their values are stored in the index, but it isn't ordered by them
+	IncludedColumnNames []string
+	Method              IndexMethod // BTreeIndex when USING isn't provided
+}
+
+func (ls CreateIndexCommand) CommandNode()         {}
+func (ls CreateIndexCommand) TokenLiteral() string { return ls.Token.Literal }
+
 // InsertCommand - Part of Command that represent insertion of values into columns
 //
 // Example:
 // INSERT INTO table1 VALUES('hello', 1);
+// INSERT INTO table1 (two) VALUES(DEFAULT);
 type InsertCommand struct {
-	Token  token.Token
-	Name   Identifier // name of the table
-	Values []token.Token
+	Token token.Token
+	Name  Identifier // name of the table
+	// ColumnNames - optional list of columns matching values, omitted columns get their default values
+	ColumnNames []string
+	// Values - literals, function calls or expressions, ex. GEN_RANDOM_UUID(), DEFAULT keyword is Anonymitifier with
+	// token.DEFAULT
+	Values []Tifier
 }
 
 func (ls InsertCommand) CommandNode()         {}
 func (ls InsertCommand) TokenLiteral() string { return ls.Token.Literal }
 
 // Space - part of SelectCommand which is containing either * or a column name with an optional function aggregating it
+// or scalar function call, in that case ColumnName contains text of the call
 type Space struct {
 	ColumnName    token.Token
 	AggregateFunc *token.Token
+	Function      *FunctionCall
 }
 
 func (space Space) String() string {
@@ -187,6 +506,11 @@ func (space Space) ContainsAggregateFunc() bool {
 	return space.AggregateFunc != nil
 }
 
+// ContainsFunction - return true if space contains scalar Function instead of plain column name
+func (space Space) ContainsFunction() bool {
+	return space.Function != nil
+}
+
 // SelectCommand - Part of Command that represent selecting values from tables
 //
 // Example:
@@ -201,6 +525,7 @@ type SelectCommand struct {
 	LimitCommand   *LimitCommand   // optional
 	OffsetCommand  *OffsetCommand  // optional
 	JoinCommand    *JoinCommand    // optional
+	TableFunction  *FunctionCall   // optional, rows are returned by function instead of table, ex. UNNEST(ARRAY[1, 2])
 }
 
 func (ls SelectCommand) CommandNode()         {}
@@ -213,6 +538,14 @@ func (ls *SelectCommand) AggregateFunctionAppears() bool {
 	}
 	return false
 }
+func (ls *SelectCommand) ScalarFunctionAppears() bool {
+	for _, space := range ls.Space {
+		if space.ContainsFunction() {
+			return true
+		}
+	}
+	return false
+}
 
 // HasWhereCommand - returns true if optional HasWhereCommand is present in SelectCommand
 //
@@ -295,9 +628,9 @@ func (ls SelectCommand) HasJoinCommand() bool {
 // UPDATE table SET col1 TO 2 WHERE column1 NOT 'hi';
 type UpdateCommand struct {
 	Token        token.Token
-	Name         Identifier                    // ex. name of table
-	Changes      map[token.Token]Anonymitifier // column names with new values
-	WhereCommand *WhereCommand                 // optional
+	Name         Identifier             // ex. name of table
+	Changes      map[token.Token]Tifier // column names with new values
+	WhereCommand *WhereCommand          // optional
 }
 
 func (ls UpdateCommand) CommandNode()         {}
@@ -354,27 +687,97 @@ func (ls JoinCommand) ShouldTakeRightSide() bool {
 //
 // Example:
 // DELETE FROM tb1 WHERE two EQUAL 3;
+// DELETE FROM tb1;
 type DeleteCommand struct {
 	Token        token.Token
 	Name         Identifier    // name of the table
-	WhereCommand *WhereCommand // optional
+	WhereCommand *WhereCommand // optional, all rows are deleted without it
 }
 
 func (ls DeleteCommand) CommandNode()         {}
 func (ls DeleteCommand) TokenLiteral() string { return ls.Token.Literal }
 
+// TruncateCommand - Part of Command that represent removing all rows from tables
+//
+// Example:
+// TRUNCATE TABLE tb1, tb2 CASCADE;
+type TruncateCommand struct {
+	Token   token.Token
+	Names   []Identifier // names of the tables
+	Cascade bool         // tables with foreign keys referencing truncated tables are truncated too
+}
+
+func (ls TruncateCommand) CommandNode()         {}
+func (ls TruncateCommand) TokenLiteral() string { return ls.Token.Literal }
+
 // DropCommand - Part of Command that represent dropping table
 //
 // Example:
-// DROP TABLE table;
+// DROP TABLE table CASCADE;
+// DROP TABLE IF EXISTS table;
 type DropCommand struct {
-	Token token.Token
-	Name  Identifier // name of the table
+	Token    token.Token
+	Name     Identifier // name of the table
+	Cascade  bool       // foreign keys of other tables referencing the table are dropped together with it
+	IfExists bool       // command is skipped when the table doesn't exist
 }
 
 func (ls DropCommand) CommandNode()         {}
 func (ls DropCommand) TokenLiteral() string { return ls.Token.Literal }
 
+// AlterTableCommand - Part of Command that represent change of columns or name of the table, every command makes
+// one change
+//
+// Example:
+// ALTER TABLE table1 ADD COLUMN three INT NOT NULL DEFAULT 0;
+// ALTER TABLE table1 DROP COLUMN three CASCADE;
+// ALTER TABLE table1 RENAME COLUMN one TO first;
+// ALTER TABLE table1 RENAME TO table2;
+// ALTER TABLE table1 ALTER COLUMN two TYPE DECIMAL(10, 2);
+type AlterTableCommand struct {
+	Token  token.Token
+	Name   Identifier // name of the table
+	Action AlterTableAction
+	// ColumnName - column which is dropped, renamed or changed, it's empty for AddColumn and RenameTable
+	ColumnName string
+	// NewName - new name of the column for RenameColumn or of the table for RenameTable
+	NewName string
+	// Definition - added column with its constraints written the same way as in CREATE TABLE, it's set only for
+	// AddColumn
+	Definition *CreateCommand
+	// ColumnType - new type of the column for AlterColumnType with its optional parameters, ex. DECIMAL(10, 2)
+	ColumnType     token.Token
+	TypeParameters []int
+	IsArray        bool
+	Cascade        bool // foreign keys of other tables referencing dropped column are dropped together with it
+}
+
+// AlterTableAction - Kind of change made by ALTER TABLE
+type AlterTableAction string
+
+const (
+	AddColumn       AlterTableAction = "ADD COLUMN"
+	DropColumn      AlterTableAction = "DROP COLUMN"
+	RenameColumn    AlterTableAction = "RENAME COLUMN"
+	RenameTable     AlterTableAction = "RENAME TO"
+	AlterColumnType AlterTableAction = "ALTER COLUMN TYPE"
+)
+
+func (ls AlterTableCommand) CommandNode()         {}
+func (ls AlterTableCommand) TokenLiteral() string { return ls.Token.Literal }
+
+// DropIndexCommand - Part of Command that represent dropping index
+//
+// Example:
+// DROP INDEX idx;
+type DropIndexCommand struct {
+	Token token.Token
+	Name  Identifier // name of the index
+}
+
+func (ls DropIndexCommand) CommandNode()         {}
+func (ls DropIndexCommand) TokenLiteral() string { return ls.Token.Literal }
+
 // HasWhereCommand - returns true if optional HasWhereCommand is present in SelectCommand
 //
 // Example:
@@ -404,8 +807,9 @@ func (ls OrderByCommand) TokenLiteral() string { return ls.Token.Literal }
 
 // SortPattern - Represent in which order declared columns should be sorted
 type SortPattern struct {
-	ColumnName token.Token // column name
-	Order      token.Token // ASC or DESC
+	ColumnName token.Token   // column name or text of function call
+	Order      token.Token   // ASC or DESC
+	Function   *FunctionCall // optional, value of function is used for sorting instead of column
 }
 
 // LimitCommand - Part of Command that limits results from SelectCommand
@@ -11,5 +11,7 @@ Flags:
 		Get provided .sql file and read data directly into the program.
 	-stream
 		Use to redirect stdin to stdout
+	-standard-nulls
+		Compare NULL values using SQL three-valued logic
 */
 package main
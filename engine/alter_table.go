@@ -0,0 +1,455 @@
+package engine
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// alterTable - Make change of the table described by ALTER TABLE command, table is left unchanged on error
+func (engine *DbEngine) alterTable(command *ast.AlterTableCommand) error {
+	tableName := command.Name.Token.Literal
+	table, exist := engine.Tables[tableName]
+	if !exist {
+		return &TableDoesNotExistError{tableName}
+	}
+
+	if command.Action == ast.AddColumn {
+		return engine.addColumn(command, table)
+	}
+	if command.Action == ast.RenameTable {
+		return engine.renameTable(tableName, command.NewName)
+	}
+
+	position := slices.IndexFunc(table.Columns, func(column *Column) bool { return column.Name == command.ColumnName })
+	if position < 0 {
+		return &ColumnDoesNotExistError{tableName: tableName, columnName: command.ColumnName}
+	}
+	switch command.Action {
+	case ast.DropColumn:
+		return engine.dropColumn(command, table, position)
+	case ast.RenameColumn:
+		return engine.renameColumn(tableName, table, position, command.NewName)
+	default:
+		return engine.alterColumnType(command, table, position)
+	}
+}
+
+// addColumn - Add column to the table together with its constraints, existing rows get default value of the column
+func (engine *DbEngine) addColumn(command *ast.AlterTableCommand, table *Table) error {
+	tableName := command.Name.Token.Literal
+	definition := command.Definition
+	columnName := definition.ColumnNames[0]
+	if slices.ContainsFunc(table.Columns, func(column *Column) bool { return column.Name == columnName }) {
+		return &ColumnAlreadyExistsError{tableName: tableName, columnName: columnName}
+	}
+	if len(definition.PrimaryKey) > 0 && table.primaryKey != nil {
+		return &MultiplePrimaryKeysError{tableName: tableName}
+	}
+
+	column, sequence, err := engine.getDefinedColumn(definition, 0, make(map[string]bool))
+	if err != nil {
+		return err
+	}
+	usedConstraintNames, err := getDeclaredConstraintNames(definition)
+	if err != nil {
+		return err
+	}
+	// Only declared names are used yet, so generated names of new constraints can't repeat existing ones
+	for _, name := range table.getConstraintNames() {
+		if usedConstraintNames[name] {
+			return &DuplicatedConstraintNameError{constraintName: name, tableName: tableName}
+		}
+		usedConstraintNames[name] = true
+	}
+
+	// Constraints are read from table containing the new column, so they can refer to it
+	previousTable := *table
+	table.Columns = append(slices.Clip(table.Columns), column)
+	err = engine.addColumnConstraints(definition, table, usedConstraintNames)
+	if err != nil {
+		*table = previousTable
+		return err
+	}
+
+	// Sequence has to exist before default values are generated from it
+	if sequence != nil {
+		engine.Sequences[sequence.Name] = sequence
+	}
+	err = engine.fillAddedColumn(tableName, table, column, command.Token.Literal)
+	if err != nil {
+		*table = previousTable
+		if sequence != nil {
+			delete(engine.Sequences, sequence.Name)
+		}
+	}
+	return err
+}
+
+// addColumnConstraints - Add constraints defined together with added column to the table
+func (engine *DbEngine) addColumnConstraints(definition *ast.CreateCommand, table *Table, usedConstraintNames map[string]bool) error {
+	checks, err := engine.getCheckConstraints(definition, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+	uniqueConstraints, err := getUniqueConstraints(definition, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+	foreignKeys, err := engine.getForeignKeys(definition, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+
+	table.checks = append(slices.Clip(table.checks), checks...)
+	table.uniqueConstraints = append(slices.Clip(table.uniqueConstraints), uniqueConstraints...)
+	table.foreignKeys = append(slices.Clip(table.foreignKeys), foreignKeys...)
+	if len(definition.PrimaryKey) > 0 {
+		position := len(table.Columns) - 1
+		table.Columns[position].NotNull = true
+		table.primaryKey = newPrimaryKeyIndex([]int{position})
+	}
+	return nil
+}
+
+// fillAddedColumn - Set value of added column in every row to its default value and check that rows still fulfill
+// all constraints of the table
+func (engine *DbEngine) fillAddedColumn(tableName string, table *Table, column *Column, commandName string) error {
+	for range table.Columns[0].Values {
+		value, err := engine.getDefaultValue(column, commandName)
+		if err != nil {
+			return err
+		}
+		column.Values = append(column.Values, value)
+	}
+	return engine.validateAlteredTable(tableName, table, len(table.Columns)-1, commandName)
+}
+
+// validateAlteredTable - Return error if any row of the table doesn't fulfill constraints of the table after the
+// column with provided position was added or changed, indexes containing this column are filled again
+func (engine *DbEngine) validateAlteredTable(tableName string, table *Table, position int, commandName string) error {
+	for rowIndex := range table.Columns[position].Values {
+		err := table.validateRow(tableName, getRowValues(table, rowIndex), commandName)
+		if err != nil {
+			return err
+		}
+	}
+	err := table.rebuildIndexes(tableName, map[int]bool{position: true})
+	if err != nil {
+		return err
+	}
+	for rowIndex := range table.Columns[position].Values {
+		err = table.validateReferences(engine, tableName, getRowValues(table, rowIndex))
+		if err != nil {
+			return err
+		}
+	}
+	// Rows of other tables can refer to changed values of the column
+	for _, reference := range engine.getReferences(tableName) {
+		if !slices.Contains(reference.foreignKey.referencedIndex.columns, position) {
+			continue
+		}
+		for rowIndex := range reference.table.Columns[0].Values {
+			err = reference.table.validateReferences(engine, reference.tableName, getRowValues(reference.table, rowIndex))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getConstraintNames - Return names of CHECK, UNIQUE and FOREIGN KEY constraints of the table
+func (table *Table) getConstraintNames() []string {
+	names := make([]string, 0, len(table.checks)+len(table.uniqueConstraints)+len(table.foreignKeys))
+	for _, check := range table.checks {
+		names = append(names, check.name)
+	}
+	for _, unique := range table.uniqueConstraints {
+		names = append(names, unique.constraint)
+	}
+	for _, foreignKey := range table.foreignKeys {
+		names = append(names, foreignKey.name)
+	}
+	return names
+}
+
+// dropColumn - Remove column from the table together with constraints and indexes containing it, foreign keys of
+// other tables referring to dropped constraints are removed only with CASCADE
+func (engine *DbEngine) dropColumn(command *ast.AlterTableCommand, table *Table, position int) error {
+	tableName := command.Name.Token.Literal
+	if len(table.Columns) == 1 {
+		return &LastColumnDropError{tableName: tableName, columnName: command.ColumnName}
+	}
+
+	containsColumn := func(columns []int) bool { return slices.Contains(columns, position) }
+	droppedForeignKeys := make(map[*foreignKey]bool)
+	for _, foreignKey := range table.foreignKeys {
+		if containsColumn(foreignKey.columns) {
+			droppedForeignKeys[foreignKey] = true
+		}
+	}
+	for _, reference := range engine.getReferences(tableName) {
+		if droppedForeignKeys[reference.foreignKey] || !containsColumn(reference.foreignKey.referencedIndex.columns) {
+			continue
+		}
+		if !command.Cascade {
+			return &DependentObjectsExistError{tableName: tableName, columnName: command.ColumnName, constraintName: reference.foreignKey.name, referencingTable: reference.tableName}
+		}
+		droppedForeignKeys[reference.foreignKey] = true
+	}
+
+	for _, referencingTable := range engine.Tables {
+		referencingTable.foreignKeys = slices.DeleteFunc(referencingTable.foreignKeys, func(foreignKey *foreignKey) bool { return droppedForeignKeys[foreignKey] })
+	}
+	if table.primaryKey != nil && containsColumn(table.primaryKey.columns) {
+		table.primaryKey = nil
+	}
+	table.uniqueConstraints = slices.DeleteFunc(table.uniqueConstraints, func(index *uniqueIndex) bool { return containsColumn(index.columns) })
+	table.indexes = slices.DeleteFunc(table.indexes, func(index *secondaryIndex) bool { return containsColumn(index.getStoredColumns()) })
+	table.checks = slices.DeleteFunc(table.checks, func(check checkConstraint) bool {
+		return slices.Contains(getIdentifierNames(check.condition.GetIdentifiers()), command.ColumnName)
+	})
+
+	// Following columns are moved one position back
+	for _, index := range table.getIndexes() {
+		shiftColumns(index.columns, position)
+	}
+	for _, foreignKey := range table.foreignKeys {
+		shiftColumns(foreignKey.columns, position)
+	}
+	for _, index := range table.indexes {
+		shiftColumns(index.columns, position)
+		shiftColumns(index.includedColumns, position)
+	}
+	table.Columns = slices.Delete(table.Columns, position, position+1)
+
+	if sequence := engine.getColumnSequence(tableName, command.ColumnName); sequence != nil {
+		delete(engine.Sequences, sequence.Name)
+	}
+	return nil
+}
+
+// shiftColumns - Decrease positions of columns placed after dropped column
+func shiftColumns(columns []int, droppedPosition int) {
+	for i, column := range columns {
+		if column > droppedPosition {
+			columns[i] = column - 1
+		}
+	}
+}
+
+// renameColumn - Change name of the column, CHECK constraints of the table are changed to use the new name
+func (engine *DbEngine) renameColumn(tableName string, table *Table, position int, newName string) error {
+	if slices.ContainsFunc(table.Columns, func(column *Column) bool { return column.Name == newName }) {
+		return &ColumnAlreadyExistsError{tableName: tableName, columnName: newName}
+	}
+	oldName := table.Columns[position].Name
+	table.Columns[position].Name = newName
+	for i, check := range table.checks {
+		table.checks[i].condition = ast.RenameExpressionIdentifiers(check.condition, oldName, newName)
+	}
+	if sequence := engine.getColumnSequence(tableName, oldName); sequence != nil {
+		sequence.OwnerColumn = newName
+	}
+	return nil
+}
+
+// renameTable - Change name of the table, foreign keys and sequences referring to the table are changed too
+func (engine *DbEngine) renameTable(tableName string, newName string) error {
+	if _, exist := engine.Tables[newName]; exist {
+		return &TableAlreadyExistsError{newName}
+	}
+	for _, table := range engine.Tables {
+		for _, foreignKey := range table.foreignKeys {
+			if foreignKey.referencedTable == tableName {
+				foreignKey.referencedTable = newName
+			}
+		}
+	}
+	for _, sequence := range engine.Sequences {
+		if sequence.OwnerTable == tableName {
+			sequence.OwnerTable = newName
+		}
+	}
+	engine.Tables[newName] = engine.Tables[tableName]
+	delete(engine.Tables, tableName)
+	return nil
+}
+
+// alterColumnType - Change type of the column and convert its values to the new type, column is left unchanged if any
+// value can't be converted or if converted values break constraints of the table
+func (engine *DbEngine) alterColumnType(command *ast.AlterTableCommand, table *Table, position int) error {
+	tableName := command.Name.Token.Literal
+	oldColumn := table.Columns[position]
+	column := *oldColumn
+	column.Type, column.TypeParameters, column.IsArray, column.Enum = command.ColumnType, command.TypeParameters, command.IsArray, nil
+	if command.ColumnType.Type == token.IDENT {
+		enum, exist := engine.Types[command.ColumnType.Literal]
+		if !exist {
+			return &TypeDoesNotExistError{command.ColumnType.Literal}
+		}
+		column.Enum = enum
+	}
+	// SERIAL is only a shortcut, so generated values are stored as INT
+	if column.Type.Type == token.SERIAL {
+		column.Type = token.Token{Type: token.INT, Literal: token.INT}
+	}
+
+	err := engine.validateAlteredColumnType(tableName, table, position, &column, command.Token.Literal)
+	if err != nil {
+		return err
+	}
+	column.Values = make([]ValueInterface, 0, len(oldColumn.Values))
+	for _, value := range oldColumn.Values {
+		converted, err := convertToAlteredType(value, &column, command.Token.Literal)
+		if err != nil {
+			return err
+		}
+		column.Values = append(column.Values, converted)
+	}
+
+	table.Columns[position] = &column
+	err = engine.validateAlteredTable(tableName, table, position, command.Token.Literal)
+	if err != nil {
+		table.Columns[position] = oldColumn
+		// Old values were valid, so indexes can be filled without error
+		_ = table.rebuildIndexes(tableName, map[int]bool{position: true})
+	}
+	return err
+}
+
+// validateAlteredColumnType - Return error if the column with new type can't be used by its default value, sequence,
+// indexes or foreign keys
+func (engine *DbEngine) validateAlteredColumnType(tableName string, table *Table, position int, column *Column, commandName string) error {
+	isGenerated := engine.getColumnSequence(tableName, column.Name) != nil
+	if isGenerated && (!isIntegerType(column.Type.Type) || column.IsArray) {
+		return &InvalidIdentityTypeError{columnName: column.Name, columnType: column.getTypeName()}
+	}
+	if !isGenerated {
+		err := engine.validateDefaultValue(column, commandName)
+		if err != nil {
+			return &DefaultValueTypeMismatchError{columnName: column.Name, defaultValue: ast.TifierToString(column.Default), columnType: column.getTypeName()}
+		}
+	}
+
+	for _, index := range table.indexes {
+		if slices.Contains(index.columns, position) && (column.IsArray || column.Type.Type == token.JSON) {
+			return &UnsupportedIndexColumnTypeError{columnName: column.Name, columnType: column.getTypeName()}
+		}
+	}
+
+	// Referencing and referenced columns have to keep the same type
+	for _, foreignKey := range table.foreignKeys {
+		for i, foreignKeyColumn := range foreignKey.columns {
+			if foreignKeyColumn != position {
+				continue
+			}
+			referencedTable := engine.Tables[foreignKey.referencedTable]
+			referencedColumn := referencedTable.Columns[foreignKey.referencedIndex.columns[i]]
+			if referencedTable == table && foreignKey.referencedIndex.columns[i] == position {
+				continue
+			}
+			if !column.hasSameType(referencedColumn) {
+				return getForeignKeyTypeMismatchError(column, referencedColumn)
+			}
+		}
+	}
+	for _, reference := range engine.getReferences(tableName) {
+		for i, referencedColumn := range reference.foreignKey.referencedIndex.columns {
+			referencingColumn := reference.table.Columns[reference.foreignKey.columns[i]]
+			if referencedColumn != position || (reference.table == table && reference.foreignKey.columns[i] == position) {
+				continue
+			}
+			if !referencingColumn.hasSameType(column) {
+				return getForeignKeyTypeMismatchError(referencingColumn, column)
+			}
+		}
+	}
+	return nil
+}
+
+func getForeignKeyTypeMismatchError(column *Column, referencedColumn *Column) error {
+	return &ForeignKeyTypeMismatchError{
+		columnName:           column.Name,
+		columnType:           column.getTypeName(),
+		referencedColumnName: referencedColumn.Name,
+		referencedType:       referencedColumn.getTypeName(),
+	}
+}
+
+// convertToAlteredType - Return value converted to new type of the column the same way as by CAST, elements of
+// arrays are converted one by one and text can be converted to BOOLEAN, ENUM and array types
+func convertToAlteredType(value ValueInterface, column *Column, commandName string) (ValueInterface, error) {
+	if value.GetType() == NullType {
+		return value, nil
+	}
+	invalidCastError := &InvalidCastError{value: value.ToString(), targetType: column.getTypeName()}
+	if column.IsArray {
+		array, isArray := value.(ArrayValue)
+		if !isArray {
+			if value.GetType() != StringType {
+				return nil, invalidCastError
+			}
+			return convertToColumnType(value, column, commandName)
+		}
+		elementColumn := &Column{Name: column.Name, Type: column.Type, TypeParameters: column.TypeParameters, Enum: column.Enum}
+		elements := make([]ValueInterface, 0, len(array.Values))
+		for _, element := range array.Values {
+			converted, err := convertToAlteredType(element, elementColumn, commandName)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, converted)
+		}
+		return ArrayValue{Values: elements}, nil
+	}
+	if value.GetType() == ArrayType {
+		if column.Type.Type != token.TEXT && column.Type.Type != token.VARCHAR {
+			return nil, invalidCastError
+		}
+		return convertToColumnType(StringValue{Value: value.ToString()}, column, commandName)
+	}
+
+	var converted ValueInterface
+	var err error
+	switch {
+	case column.Enum != nil:
+		converted = StringValue{Value: value.ToString()}
+	case column.Type.Type == token.TEXT || column.Type.Type == token.VARCHAR || column.Type.Type == token.CHAR:
+		converted = StringValue{Value: value.ToString()}
+	case column.Type.Type == token.BOOLEAN:
+		converted, err = castToBoolean(value, invalidCastError)
+	case column.Type.Type == token.BLOB:
+		if value.GetType() != BlobType {
+			return nil, invalidCastError
+		}
+		converted = value
+	default:
+		converted, err = cast(token.CAST, []ValueInterface{value, StringValue{Value: column.Type.Literal}})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertToColumnType(converted, column, commandName)
+}
+
+// castToBoolean - Return BOOLEAN value of text TRUE or FALSE written in any case
+func castToBoolean(value ValueInterface, invalidCastError *InvalidCastError) (ValueInterface, error) {
+	if value.GetType() == BooleanType {
+		return value, nil
+	}
+	if value.GetType() != StringType {
+		return nil, invalidCastError
+	}
+	switch strings.ToUpper(strings.TrimSpace(value.ToString())) {
+	case token.TRUE:
+		return BooleanValue{Value: true}, nil
+	case token.FALSE:
+		return BooleanValue{Value: false}, nil
+	default:
+		return nil, invalidCastError
+	}
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+func init() {
+	registerScalarFunction(token.PLUS, scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: arithmeticOperation})
+	registerScalarFunction(token.MINUS, scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: arithmeticOperation})
+	registerScalarFunction(token.ASTERISK, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: arithmeticOperation})
+	registerScalarFunction(token.SLASH, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: arithmeticOperation})
+}
+
+// arithmeticOperation - a + b, a - b, a * b, a / b and unary -a, +a, INT operands are promoted to DECIMAL or FLOAT
+// when the other operand has such type, division of INT values truncates result toward zero, operations on dates
+// and intervals are handled by temporalOperation
+func arithmeticOperation(operator string, arguments []ValueInterface) (ValueInterface, error) {
+	for _, argument := range arguments {
+		if isTemporal(argument) {
+			return temporalOperation(operator, arguments)
+		}
+	}
+	for _, argument := range arguments {
+		if !isNumeric(argument) {
+			return nil, &InvalidFunctionArgumentError{functionName: operator, expectedType: "NUMERIC", actualValue: argument.ToString()}
+		}
+	}
+
+	if len(arguments) == 1 {
+		if operator == token.PLUS {
+			return arguments[0], nil
+		}
+		return negate(operator, arguments[0])
+	}
+	return calculate(operator, arguments[0], arguments[1])
+}
+
+func negate(operator string, value ValueInterface) (ValueInterface, error) {
+	switch number := value.(type) {
+	case IntegerValue:
+		if number.Value == math.MinInt64 {
+			return nil, &IntegerOverflowError{operation: operator}
+		}
+		return IntegerValue{Value: -number.Value}, nil
+	case FloatValue:
+		return FloatValue{Value: -number.Value}, nil
+	default:
+		decimal := toDecimal(value)
+		return DecimalValue{Value: new(big.Int).Neg(decimal.Value), Scale: decimal.Scale}, nil
+	}
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+func init() {
+	registerScalarFunction(token.ARRAY, scalarFunction{minArguments: 0, maxArguments: variadicArguments, propagatesNull: false, evaluate: arrayConstructor})
+	registerScalarFunction(token.LBRACKET, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: arraySubscript})
+	registerScalarFunction("ARRAY_LENGTH", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: arrayLength})
+}
+
+// arrayConstructor - ARRAY[value, ...] returns array of values, all elements except NULL must have the same type,
+// but numbers of different types can be mixed
+func arrayConstructor(_ string, arguments []ValueInterface) (ValueInterface, error) {
+	var firstElement ValueInterface
+	for _, argument := range arguments {
+		if argument.GetType() == NullType {
+			continue
+		}
+		if firstElement == nil {
+			firstElement = argument
+			continue
+		}
+		if getTypeName(firstElement) != getTypeName(argument) && !(isNumeric(firstElement) && isNumeric(argument)) {
+			return nil, &MixedArrayElementTypesError{firstType: getTypeName(firstElement), secondType: getTypeName(argument)}
+		}
+	}
+	return ArrayValue{Values: arguments}, nil
+}
+
+// arraySubscript - array[index] returns element of array, indexes are counted from 1 and NULL is returned when index
+// is out of range
+func arraySubscript(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	array, err := getArrayArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	index, err := getIntegerArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 1 || index > len(array.Values) {
+		return NullValue{}, nil
+	}
+	return array.Values[index-1], nil
+}
+
+// arrayLength - ARRAY_LENGTH(array [, dimension]) returns number of elements of array, NULL is returned for empty
+// array and for dimension other than 1, because only one-dimensional arrays are supported
+func arrayLength(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	array, err := getArrayArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(arguments) == 2 {
+		dimension, err := getIntegerArgument(functionName, arguments[1])
+		if err != nil {
+			return nil, err
+		}
+		if dimension != 1 {
+			return NullValue{}, nil
+		}
+	}
+
+	if len(array.Values) == 0 {
+		return NullValue{}, nil
+	}
+	return IntegerValue{Value: int64(len(array.Values))}, nil
+}
+
+// getArrayArgument - Return array from function argument, text is read in the form of {a,b,NULL}
+func getArrayArgument(functionName string, argument ValueInterface) (ArrayValue, error) {
+	switch value := argument.(type) {
+	case ArrayValue:
+		return value, nil
+	case StringValue:
+		tokens, err := parseArray(value.Value)
+		if err != nil {
+			return ArrayValue{}, err
+		}
+		elements := make([]ValueInterface, 0, len(tokens))
+		for _, element := range tokens {
+			elementValue, err := getInterfaceValue(element)
+			if err != nil {
+				return ArrayValue{}, err
+			}
+			elements = append(elements, elementValue)
+		}
+		return ArrayValue{Values: elements}, nil
+	default:
+		return ArrayValue{}, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.ARRAY, actualValue: argument.ToString()}
+	}
+}
@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"cmp"
+	"log"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// ArrayValue - Implementation of ValueInterface that is containing one-dimensional array of values, elements can be
+// NULL
+type ArrayValue struct {
+	Values []ValueInterface
+}
+
+// ToString - Return array in the form of {a,b,NULL}, elements which could be misread are wrapped with double quotes
+func (value ArrayValue) ToString() string {
+	elements := make([]string, 0, len(value.Values))
+	for _, element := range value.Values {
+		if element.GetType() == NullType {
+			elements = append(elements, token.NULL)
+			continue
+		}
+		elements = append(elements, quoteArrayElementIfNeeded(element.ToString()))
+	}
+	return "{" + strings.Join(elements, ",") + "}"
+}
+
+func (value ArrayValue) GetType() SupportedTypes { return ArrayType }
+
+func (value ArrayValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+
+// Arrays are compared element by element, when all elements are equal shorter array is smaller
+func (value ArrayValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	return compareArrays(value, secondValue) < 0
+}
+
+func (value ArrayValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	return compareArrays(value, secondValue) > 0
+}
+
+// compareArrays - Return -1, 0 or 1 when the first array is smaller, equal or greater than the second one, elements
+// of types which can't be compared are ordered by name of their type
+func compareArrays(first ArrayValue, secondValue ValueInterface) int {
+	second, isArray := secondValue.(ArrayValue)
+	if !isArray {
+		log.Fatal("Can't compare ARRAY with other type")
+	}
+
+	for i := 0; i < min(len(first.Values), len(second.Values)); i++ {
+		left, right := first.Values[i], second.Values[i]
+		switch {
+		case left.IsEqual(right):
+			continue
+		case left.GetType() != NullType && right.GetType() != NullType && !areComparable(left, right):
+			return strings.Compare(getTypeName(left), getTypeName(right))
+		case left.isSmallerThan(right):
+			return -1
+		default:
+			return 1
+		}
+	}
+	return cmp.Compare(len(first.Values), len(second.Values))
+}
+
+// quoteArrayElementIfNeeded - Return element wrapped with double quotes if it's empty, it's equal to NULL or it
+// contains characters with special meaning in array, double quotes and backslashes inside are escaped
+func quoteArrayElementIfNeeded(text string) string {
+	if text != "" && !strings.EqualFold(text, token.NULL) && !strings.ContainsAny(text, "{},\"\\ \t\n\r") {
+		return text
+	}
+	text = strings.ReplaceAll(text, "\\", "\\\\")
+	text = strings.ReplaceAll(text, "\"", "\\\"")
+	return "\"" + text + "\""
+}
+
+// parseArray - Return elements of array written as text, ex. {"a",b,NULL,1}, as tokens: quoted elements are text
+// (token.IDENT), unquoted ones can be NULL, TRUE, FALSE, numbers, X'..' binary values or text
+func parseArray(text string) ([]token.Token, error) {
+	invalidArrayError := &InvalidArrayLiteralError{value: text}
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "{") || !strings.HasSuffix(text, "}") {
+		return nil, invalidArrayError
+	}
+	rest := strings.TrimSpace(text[1 : len(text)-1])
+
+	elements := make([]token.Token, 0)
+	if rest == "" {
+		return elements, nil
+	}
+
+	for {
+		var element token.Token
+		if strings.HasPrefix(rest, "\"") {
+			var builder strings.Builder
+			i := 1
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				builder.WriteByte(rest[i])
+			}
+			if i >= len(rest) {
+				return nil, invalidArrayError
+			}
+			element = token.Token{Type: token.IDENT, Literal: builder.String()}
+			rest = strings.TrimSpace(rest[i+1:])
+		} else {
+			end := strings.IndexByte(rest, ',')
+			if end < 0 {
+				end = len(rest)
+			}
+			literal := strings.TrimSpace(rest[:end])
+			if literal == "" || strings.ContainsAny(literal, "{}\"") {
+				return nil, invalidArrayError
+			}
+			element = getArrayElementToken(literal)
+			rest = rest[end:]
+		}
+		elements = append(elements, element)
+
+		if rest == "" {
+			return elements, nil
+		}
+		if rest[0] != ',' {
+			return nil, invalidArrayError
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+}
+
+// getArrayElementToken - Return token matching unquoted element of array
+func getArrayElementToken(literal string) token.Token {
+	upperLiteral := strings.ToUpper(literal)
+	switch {
+	case upperLiteral == token.NULL || upperLiteral == token.TRUE || upperLiteral == token.FALSE:
+		return token.Token{Type: token.Type(upperLiteral), Literal: upperLiteral}
+	case len(literal) >= 3 && upperLiteral[:2] == "X'" && strings.HasSuffix(literal, "'"):
+		return token.Token{Type: token.HEX, Literal: literal[2 : len(literal)-1]}
+	}
+
+	number := token.Token{Type: token.LITERAL, Literal: literal}
+	if _, err := getInterfaceValue(number); err == nil {
+		return number
+	}
+	return token.Token{Type: token.IDENT, Literal: literal}
+}
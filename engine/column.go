@@ -1,14 +1,40 @@
 package engine
 
 import (
+	"github.com/LissaGreense/GO4SQL/ast"
 	"github.com/LissaGreense/GO4SQL/token"
 )
 
 // Column - part of the Table containing name of Column and values in it
 type Column struct {
-	Name   string
-	Type   token.Token
-	Values []ValueInterface
+	Name string
+	Type token.Token
+	// TypeParameters - optional parameters of the type, ex. precision and scale of DECIMAL(10, 2)
+	TypeParameters []int
+	// Enum - user-defined type of the column, it's set only when Type is name of ENUM type
+	Enum *Enum
+	// IsArray - column stores arrays of values of its type, ex. TEXT[]
+	IsArray bool
+	// NotNull - column can't contain NULL values
+	NotNull bool
+	// Default - value used when column is omitted in INSERT, it's nil when column has no default value
+	Default ast.Tifier
+	// Identity - kind of identity column, values of GENERATED ALWAYS AS IDENTITY column can't be set explicitly
+	Identity ast.Identity
+	Values   []ValueInterface
+}
+
+// hasSameType - Return true if values of both columns have the same type, parameters of the type aren't compared
+func (column *Column) hasSameType(secondColumn *Column) bool {
+	return column.Type.Type == secondColumn.Type.Type && column.Enum == secondColumn.Enum && column.IsArray == secondColumn.IsArray
+}
+
+// getTypeName - Return name of the type of the column, ex. INT or TEXT[]
+func (column *Column) getTypeName() string {
+	if column.IsArray {
+		return column.Type.Literal + "[]"
+	}
+	return column.Type.Literal
 }
 
 func extractColumnContent(columns []*Column, wantedColumnNames *[]string, tableName string) (*Table, error) {
@@ -28,9 +54,12 @@ func extractColumnContent(columns []*Column, wantedColumnNames *[]string, tableN
 
 	for i := range mappedIndexes {
 		selectedTable.Columns = append(selectedTable.Columns, &Column{
-			Name:   columns[mappedIndexes[i]].Name,
-			Type:   columns[mappedIndexes[i]].Type,
-			Values: make([]ValueInterface, 0),
+			Name:           columns[mappedIndexes[i]].Name,
+			Type:           columns[mappedIndexes[i]].Type,
+			TypeParameters: columns[mappedIndexes[i]].TypeParameters,
+			Enum:           columns[mappedIndexes[i]].Enum,
+			IsArray:        columns[mappedIndexes[i]].IsArray,
+			Values:         make([]ValueInterface, 0),
 		})
 	}
 	if len(columns) == 0 {
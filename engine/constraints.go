@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// checkConstraint - Condition declared with CHECK which has to be fulfilled by every row of the table
+type checkConstraint struct {
+	name      string
+	condition ast.Expression
+}
+
+// getDeclaredConstraintNames - Return names given to constraints in CREATE TABLE, error is returned when any name is
+// used more than once
+func getDeclaredConstraintNames(command *ast.CreateCommand) (map[string]bool, error) {
+	names := make([]string, 0, len(command.Checks)+len(command.UniqueConstraints)+len(command.ForeignKeys))
+	for _, check := range command.Checks {
+		names = append(names, check.Name)
+	}
+	for _, unique := range command.UniqueConstraints {
+		names = append(names, unique.Name)
+	}
+	for _, foreignKey := range command.ForeignKeys {
+		names = append(names, foreignKey.Name)
+	}
+
+	usedNames := make(map[string]bool)
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if usedNames[name] {
+			return nil, &DuplicatedConstraintNameError{constraintName: name, tableName: command.Name.Token.Literal}
+		}
+		usedNames[name] = true
+	}
+	return usedNames, nil
+}
+
+// getCheckConstraints - Return CHECK constraints of the table, constraints without name are named the same way as in
+// PostgreSQL, ex. tbl_one_check for constraint of column one and tbl_check for constraint of the table
+func (engine *DbEngine) getCheckConstraints(command *ast.CreateCommand, table *Table, usedNames map[string]bool) ([]checkConstraint, error) {
+	tableName := command.Name.Token.Literal
+	checks := make([]checkConstraint, 0, len(command.Checks))
+	for _, check := range command.Checks {
+		missingColumnName := engine.getMissingColumnName(getIdentifierNames(check.Condition.GetIdentifiers()), table)
+		if missingColumnName != "" {
+			return nil, &ColumnDoesNotExistError{tableName: tableName, columnName: missingColumnName}
+		}
+
+		name := check.Name
+		if name == "" {
+			baseName := tableName
+			if check.ColumnName != "" {
+				baseName += "_" + check.ColumnName
+			}
+			name = getUnusedConstraintName(baseName+"_check", usedNames)
+		}
+		checks = append(checks, checkConstraint{name: name, condition: check.Condition})
+	}
+	return checks, nil
+}
+
+// getUniqueConstraints - Return indexes of UNIQUE constraints of the table, constraints without name are named the
+// same way as in PostgreSQL, ex. tbl_one_two_key for constraint of columns one and two
+func getUniqueConstraints(command *ast.CreateCommand, table *Table, usedNames map[string]bool) ([]*uniqueIndex, error) {
+	tableName := command.Name.Token.Literal
+	indexes := make([]*uniqueIndex, 0, len(command.UniqueConstraints))
+	for _, unique := range command.UniqueConstraints {
+		columns, err := getKeyColumns(table, unique.ColumnNames, tableName, token.UNIQUE)
+		if err != nil {
+			return nil, err
+		}
+
+		name := unique.Name
+		if name == "" {
+			name = getUnusedConstraintName(tableName+"_"+strings.Join(unique.ColumnNames, "_")+"_key", usedNames)
+		}
+		indexes = append(indexes, newUniqueIndex(name, columns, !unique.NullsNotDistinct))
+	}
+	return indexes, nil
+}
+
+// getUnusedConstraintName - Return generated name of constraint and mark it as used, number is added when name is
+// already used
+func getUnusedConstraintName(baseName string, usedNames map[string]bool) string {
+	name := baseName
+	for i := 1; usedNames[name]; i++ {
+		name = baseName + strconv.Itoa(i)
+	}
+	usedNames[name] = true
+	return name
+}
+
+// validateDefaultValue - Return error if default value of the column refers to other columns or if it can't be
+// converted to type of the column
+func (engine *DbEngine) validateDefaultValue(column *Column, commandName string) error {
+	if column.Default == nil {
+		return nil
+	}
+	if len(ast.GetTifierIdentifiers(column.Default)) > 0 {
+		return &InvalidDefaultValueError{columnName: column.Name}
+	}
+	// Evaluation would change state of the engine, ex. NEXTVAL would skip a value of the sequence
+	if callsEngineFunction(column.Default) {
+		return nil
+	}
+	_, err := engine.getDefaultValue(column, commandName)
+	return err
+}
+
+// getDefaultValue - Return value used when column is omitted in INSERT or set to DEFAULT, it's NULL when column has
+// no default value, expression is evaluated every time, so ex. NOW() returns current time
+func (engine *DbEngine) getDefaultValue(column *Column, commandName string) (ValueInterface, error) {
+	if column.Default == nil {
+		return NullValue{}, nil
+	}
+	// Literal is validated the same way as value written in INSERT
+	if literal, isLiteral := column.Default.(ast.Anonymitifier); isLiteral {
+		return getColumnValue(literal.Token, column, commandName)
+	}
+	value, err := engine.getTifierValue(column.Default, map[string]ValueInterface{})
+	if err != nil {
+		return nil, err
+	}
+	return convertToColumnType(value, column, commandName)
+}
+
+// isDefaultKeyword - Return true if new value of column is DEFAULT keyword
+func isDefaultKeyword(tifier ast.Tifier) bool {
+	anonymitifier, isAnonymitifier := tifier.(ast.Anonymitifier)
+	return isAnonymitifier && anonymitifier.Token.Type == token.DEFAULT
+}
+
+// validateRow - Return error if the row has NULL in NOT NULL column or if it doesn't fulfill CHECK constraint of the
+// table, values of the row are ordered the same way as columns
+func (table *Table) validateRow(tableName string, row []ValueInterface, commandName string) error {
+	for i, column := range table.Columns {
+		if column.NotNull && row[i].GetType() == NullType {
+			return &NotNullViolationError{columnName: column.Name, tableName: tableName}
+		}
+	}
+	if len(table.checks) == 0 {
+		return nil
+	}
+
+	rowMap := make(map[string]ValueInterface, len(table.Columns))
+	for i, column := range table.Columns {
+		rowMap[column.Name] = row[i]
+	}
+	// CHECK is violated only when condition is FALSE, comparison with NULL is UNKNOWN like in SQL standard
+	checkEngine := &DbEngine{StandardNullSemantics: true}
+	for _, check := range table.checks {
+		result, err := checkEngine.evaluateExpression(rowMap, check.condition, commandName)
+		if err != nil {
+			return err
+		}
+		if result == logicalFalse {
+			return &CheckViolationError{constraintName: check.name, tableName: tableName}
+		}
+	}
+	return nil
+}
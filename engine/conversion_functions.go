@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"math"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+func init() {
+	registerScalarFunction(token.CAST, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: cast})
+	registerScalarFunction(token.TYPECAST, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: cast})
+}
+
+// cast - CAST(value AS type) and value::type convert value to the type, second argument contains name of the type,
+// FLOAT and DECIMAL values are rounded half away from zero when converted to SMALLINT, INT or BIGINT
+func cast(_ string, arguments []ValueInterface) (ValueInterface, error) {
+	value := arguments[0]
+	targetType := arguments[1].ToString()
+	invalidCastError := &InvalidCastError{value: value.ToString(), targetType: targetType}
+
+	switch targetType {
+	case token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL:
+		converted, isConverted := convertTemporal(value, targetType)
+		if !isConverted {
+			return nil, invalidCastError
+		}
+		return converted, nil
+	case token.JSON:
+		return castToJson(value, invalidCastError)
+	case token.UUID:
+		if value.GetType() == UuidType {
+			return value, nil
+		}
+		if value.GetType() != StringType {
+			return nil, invalidCastError
+		}
+		converted, err := parseUuid(strings.TrimSpace(value.ToString()))
+		if err != nil {
+			return nil, invalidCastError
+		}
+		return converted, nil
+	}
+
+	if value.GetType() == StringType {
+		if targetType == token.TEXT {
+			return value, nil
+		}
+		converted, err := getInterfaceValue(token.Token{Type: token.LITERAL, Literal: strings.TrimSpace(value.ToString())})
+		if err != nil || (isIntegerType(token.Type(targetType)) && converted.GetType() != IntType) {
+			return nil, invalidCastError
+		}
+		value = converted
+	}
+
+	switch targetType {
+	case token.TEXT:
+		return StringValue{Value: value.ToString()}, nil
+	case token.SMALLINT, token.INT, token.BIGINT:
+		integer, err := castToInteger(value, invalidCastError)
+		if err != nil || !isInIntegerRange(integer.Value, token.Type(targetType)) {
+			return nil, invalidCastError
+		}
+		return integer, nil
+	case token.FLOAT, token.REAL:
+		return FloatValue{Value: toFloat(value)}, nil
+	case token.DECIMAL:
+		if value.GetType() == FloatType && (math.IsInf(toFloat(value), 0) || math.IsNaN(toFloat(value))) {
+			return nil, invalidCastError
+		}
+		return toDecimal(value), nil
+	default:
+		return nil, invalidCastError
+	}
+}
+
+func castToInteger(value ValueInterface, invalidCastError *InvalidCastError) (IntegerValue, error) {
+	switch number := value.(type) {
+	case IntegerValue:
+		return number, nil
+	case FloatValue:
+		rounded := math.Round(number.Value)
+		if math.IsNaN(rounded) || rounded < math.MinInt64 || rounded >= math.MaxInt64 {
+			return IntegerValue{}, invalidCastError
+		}
+		return IntegerValue{Value: int64(rounded)}, nil
+	case DecimalValue:
+		integer, fits := decimalToInteger(number)
+		if !fits {
+			return IntegerValue{}, invalidCastError
+		}
+		return IntegerValue{Value: integer}, nil
+	default:
+		return IntegerValue{}, invalidCastError
+	}
+}
+
+// castToJson - Return text parsed as JSON document, numbers and booleans are converted to JSON scalars
+func castToJson(value ValueInterface, invalidCastError *InvalidCastError) (ValueInterface, error) {
+	switch value.GetType() {
+	case JsonType:
+		return value, nil
+	case StringType, IntType, FloatType, DecimalType, BooleanType:
+		if value.GetType() == FloatType && (math.IsInf(toFloat(value), 0) || math.IsNaN(toFloat(value))) {
+			return nil, invalidCastError
+		}
+		text := value.ToString()
+		if value.GetType() == BooleanType {
+			text = strings.ToLower(text)
+		}
+		converted, err := parseJson(text)
+		if err != nil {
+			return nil, invalidCastError
+		}
+		return converted, nil
+	default:
+		return nil, invalidCastError
+	}
+}
+
+func isIntegerType(typeName token.Type) bool {
+	return typeName == token.SMALLINT || typeName == token.INT || typeName == token.BIGINT
+}
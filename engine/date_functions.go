@@ -0,0 +1,404 @@
+package engine
+
+import (
+	"strings"
+	"time"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+const microsecondsInDay = int64(24 * time.Hour / time.Microsecond)
+
+func init() {
+	registerScalarFunction("NOW", scalarFunction{minArguments: 0, maxArguments: 0, propagatesNull: true, evaluate: now})
+	registerScalarFunction("DATE_TRUNC", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: dateTrunc})
+	registerScalarFunction(token.EXTRACT, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: extract})
+	registerScalarFunction("DATE_PART", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: extract})
+	registerScalarFunction("DATE_ADD", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: dateAdd})
+	registerScalarFunction("DATE_SUB", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: dateAdd})
+}
+
+// now - NOW() returns current date and time as TIMESTAMPTZ
+func now(_ string, _ []ValueInterface) (ValueInterface, error) {
+	return TimestampValue{Value: time.Now().UTC().Truncate(time.Microsecond), WithTimeZone: true}, nil
+}
+
+// dateTrunc - DATE_TRUNC(unit, value) returns DATE or TIMESTAMP with all fields less significant than unit set to
+// zero (or one for day and month), ex. DATE_TRUNC('month', TIMESTAMP '2024-03-15 10:30:00') returns 2024-03-01
+// 00:00:00, weeks start on Monday
+func dateTrunc(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	unit := getUnitArgument(arguments[0])
+	value, err := getPointInTimeArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, err
+	}
+	pointInTime, _ := toPointInTime(value)
+
+	year, month, day := pointInTime.Date()
+	hour, minute, second := pointInTime.Clock()
+	nanosecond := pointInTime.Nanosecond()
+	switch unit {
+	case "microsecond":
+		nanosecond -= nanosecond % int(time.Microsecond)
+	case "millisecond":
+		nanosecond -= nanosecond % int(time.Millisecond)
+	case "second":
+		nanosecond = 0
+	case "minute":
+		second, nanosecond = 0, 0
+	case "hour":
+		minute, second, nanosecond = 0, 0, 0
+	case "day":
+		hour, minute, second, nanosecond = 0, 0, 0, 0
+	case "week":
+		day -= (int(pointInTime.Weekday()) + 6) % 7
+		hour, minute, second, nanosecond = 0, 0, 0, 0
+	case "month", "quarter", "year", "decade", "century", "millennium":
+		day, hour, minute, second, nanosecond = 1, 0, 0, 0, 0
+		month, year = truncateMonthAndYear(unit, month, year)
+	default:
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "unit of time", actualValue: arguments[0].ToString()}
+	}
+
+	truncated := time.Date(year, month, day, hour, minute, second, nanosecond, time.UTC)
+	if value.GetType() == DateType {
+		return DateValue{Value: truncated}, nil
+	}
+	return TimestampValue{Value: truncated, WithTimeZone: value.(TimestampValue).WithTimeZone}, nil
+}
+
+// truncateMonthAndYear - Return first month of quarter or January of first year of decade, century or millennium,
+// centuries and millenniums start with year ending with 1, ex. 2001
+func truncateMonthAndYear(unit string, month time.Month, year int) (time.Month, int) {
+	switch unit {
+	case "month":
+		return month, year
+	case "quarter":
+		return (month-1)/3*3 + 1, year
+	case "year":
+		return time.January, year
+	case "decade":
+		return time.January, year - year%10
+	case "century":
+		return time.January, (year-1)/100*100 + 1
+	default:
+		return time.January, (year-1)/1000*1000 + 1
+	}
+}
+
+// extract - EXTRACT(field FROM value) and DATE_PART(field, value) return INT value of the field of DATE, TIME,
+// TIMESTAMP or INTERVAL, available fields: year, quarter, month, week (ISO 8601), day, dow (Sunday is 0), isodow
+// (Sunday is 7), doy, hour, minute, second, millisecond, microsecond, decade, century, millennium and epoch
+// (number of seconds since 1970-01-01 00:00:00 UTC, midnight or length of interval)
+func extract(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	unit := getUnitArgument(arguments[0])
+	value := arguments[1]
+	if value.GetType() == StringType {
+		var err error
+		value, err = parseTemporal(value.ToString(), token.TIMESTAMP)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var field int
+	isValidField := false
+	switch temporal := value.(type) {
+	case IntervalValue:
+		field, isValidField = extractFromInterval(temporal, unit)
+	case TimeValue:
+		field, isValidField = extractFromClock(temporal.Value, unit)
+		if unit == "epoch" {
+			field, isValidField = int(temporal.Value.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC))/time.Second), true
+		}
+	case DateValue, TimestampValue:
+		pointInTime, _ := toPointInTime(temporal)
+		field, isValidField = extractFromDate(pointInTime, unit)
+		if !isValidField {
+			field, isValidField = extractFromClock(pointInTime, unit)
+		}
+	default:
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "DATE, TIME, TIMESTAMP or INTERVAL", actualValue: value.ToString()}
+	}
+
+	if !isValidField {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "unit of time", actualValue: arguments[0].ToString()}
+	}
+	return IntegerValue{Value: int64(field)}, nil
+}
+
+func extractFromDate(pointInTime time.Time, unit string) (int, bool) {
+	year := pointInTime.Year()
+	switch unit {
+	case "year":
+		return year, true
+	case "quarter":
+		return (int(pointInTime.Month())-1)/3 + 1, true
+	case "month":
+		return int(pointInTime.Month()), true
+	case "week":
+		_, week := pointInTime.ISOWeek()
+		return week, true
+	case "day":
+		return pointInTime.Day(), true
+	case "dow":
+		return int(pointInTime.Weekday()), true
+	case "isodow":
+		return (int(pointInTime.Weekday())+6)%7 + 1, true
+	case "doy":
+		return pointInTime.YearDay(), true
+	case "decade":
+		return year / 10, true
+	case "century":
+		return (year + 99) / 100, true
+	case "millennium":
+		return (year + 999) / 1000, true
+	case "epoch":
+		return int(pointInTime.Unix()), true
+	default:
+		return 0, false
+	}
+}
+
+func extractFromClock(pointInTime time.Time, unit string) (int, bool) {
+	switch unit {
+	case "hour":
+		return pointInTime.Hour(), true
+	case "minute":
+		return pointInTime.Minute(), true
+	case "second":
+		return pointInTime.Second(), true
+	case "millisecond":
+		return pointInTime.Second()*1000 + pointInTime.Nanosecond()/int(time.Millisecond), true
+	case "microsecond":
+		return pointInTime.Second()*1000000 + pointInTime.Nanosecond()/int(time.Microsecond), true
+	default:
+		return 0, false
+	}
+}
+
+// extractFromInterval - Return field of interval, epoch assumes that year has 365.25 days and month has 30 days
+func extractFromInterval(interval IntervalValue, unit string) (int, bool) {
+	switch unit {
+	case "year":
+		return interval.Months / 12, true
+	case "month":
+		return interval.Months % 12, true
+	case "day":
+		return interval.Days, true
+	case "hour":
+		return int(interval.Duration / time.Hour), true
+	case "minute":
+		return int(interval.Duration % time.Hour / time.Minute), true
+	case "second":
+		return int(interval.Duration % time.Minute / time.Second), true
+	case "millisecond":
+		return int(interval.Duration % time.Minute / time.Millisecond), true
+	case "microsecond":
+		return int(interval.Duration % time.Minute / time.Microsecond), true
+	case "epoch":
+		secondsInDay := 24 * 60 * 60
+		years, months := interval.Months/12, interval.Months%12
+		return years*secondsInDay*36525/100 + (months*30+interval.Days)*secondsInDay + int(interval.Duration/time.Second), true
+	default:
+		return 0, false
+	}
+}
+
+// dateAdd - DATE_ADD(value, interval) and DATE_SUB(value, interval) return value moved forward or backward by the
+// interval, ex. DATE_ADD(DATE '2024-01-31', INTERVAL '1 month') returns 2024-02-29
+func dateAdd(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	value, err := getPointInTimeArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	interval, isInterval := arguments[1].(IntervalValue)
+	if arguments[1].GetType() == StringType {
+		interval, isInterval = parseInterval(arguments[1].ToString())
+	}
+	if !isInterval {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.INTERVAL, actualValue: arguments[1].ToString()}
+	}
+
+	if strings.ToUpper(functionName) == "DATE_SUB" {
+		interval = interval.negate()
+	}
+	return addInterval(value, interval), nil
+}
+
+// getUnitArgument - Return name of field or unit of time in singular lower-case form, ex. 'Days' is changed to 'day'
+func getUnitArgument(argument ValueInterface) string {
+	unit := strings.ToLower(strings.TrimSpace(argument.ToString()))
+	if unit == "centuries" {
+		return "century"
+	}
+	if unit == "millennia" {
+		return "millennium"
+	}
+	return strings.TrimSuffix(unit, "s")
+}
+
+// getPointInTimeArgument - Return DATE or TIMESTAMP argument of function, text is read as TIMESTAMP
+func getPointInTimeArgument(functionName string, argument ValueInterface) (ValueInterface, error) {
+	if argument.GetType() == StringType {
+		return parseTemporal(argument.ToString(), token.TIMESTAMP)
+	}
+	if argument.GetType() != DateType && argument.GetType() != TimestampType {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "DATE or TIMESTAMP", actualValue: argument.ToString()}
+	}
+	return argument, nil
+}
+
+// temporalOperation - Arithmetic operators for DATE, TIME, TIMESTAMP and INTERVAL values:
+//   - DATE or TIMESTAMP + INTERVAL moves value by interval, DATE stays DATE if interval doesn't contain time of day
+//   - DATE + INT adds number of days, DATE + TIME returns TIMESTAMP
+//   - DATE - DATE returns INT number of days, TIMESTAMP - TIMESTAMP returns INTERVAL
+//   - TIME + INTERVAL moves time of the day and wraps around midnight, TIME - TIME returns INTERVAL
+//   - INTERVAL can be added to, subtracted from, negated and multiplied by INT
+func temporalOperation(operator string, arguments []ValueInterface) (ValueInterface, error) {
+	if len(arguments) == 1 {
+		interval, isInterval := arguments[0].(IntervalValue)
+		if !isInterval {
+			return nil, &UnsupportedOperandTypesError{operator: operator, types: []string{getTypeName(arguments[0])}}
+		}
+		if operator == token.MINUS {
+			return interval.negate(), nil
+		}
+		return interval, nil
+	}
+
+	left, right := arguments[0], arguments[1]
+	// addition and multiplication are commutative, so operands are ordered to reduce number of cases
+	if (operator == token.PLUS || operator == token.ASTERISK) && getOperandRank(left) < getOperandRank(right) {
+		left, right = right, left
+	}
+
+	result, isSupported := calculateTemporal(operator, left, right)
+	if !isSupported {
+		return nil, &UnsupportedOperandTypesError{operator: operator, types: []string{getTypeName(arguments[0]), getTypeName(arguments[1])}}
+	}
+	return result, nil
+}
+
+// getOperandRank - Return rank deciding which operand of addition or multiplication should be the left one, ex.
+// in INTERVAL '1 day' + DATE '2024-01-31' operands are swapped
+func getOperandRank(value ValueInterface) int {
+	switch value.GetType() {
+	case DateType, TimestampType:
+		return 3
+	case TimeType:
+		return 2
+	case IntervalType:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func calculateTemporal(operator string, left ValueInterface, right ValueInterface) (ValueInterface, bool) {
+	switch operator {
+	case token.PLUS, token.MINUS:
+		sign := 1
+		if operator == token.MINUS {
+			sign = -1
+		}
+		return addOrSubtractTemporal(sign, left, right)
+	case token.ASTERISK:
+		interval, isInterval := left.(IntervalValue)
+		multiplier, isInteger := right.(IntegerValue)
+		if !isInterval || !isInteger {
+			return nil, false
+		}
+		return IntervalValue{Months: interval.Months * int(multiplier.Value), Days: interval.Days * int(multiplier.Value),
+			Duration: interval.Duration * time.Duration(multiplier.Value)}, true
+	default:
+		return nil, false
+	}
+}
+
+func addOrSubtractTemporal(sign int, left ValueInterface, right ValueInterface) (ValueInterface, bool) {
+	switch second := right.(type) {
+	case IntervalValue:
+		if sign < 0 {
+			second = second.negate()
+		}
+		switch first := left.(type) {
+		case DateValue, TimestampValue:
+			return addInterval(first, second), true
+		case TimeValue:
+			return TimeValue{Value: addToClock(first.Value, second.Duration)}, true
+		case IntervalValue:
+			return IntervalValue{Months: first.Months + second.Months, Days: first.Days + second.Days,
+				Duration: first.Duration + second.Duration}, true
+		}
+	case IntegerValue:
+		if first, isDate := left.(DateValue); isDate {
+			return DateValue{Value: first.Value.AddDate(0, 0, sign*int(second.Value))}, true
+		}
+	case TimeValue:
+		if first, isDate := left.(DateValue); isDate && sign > 0 {
+			clock := second.Value.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC))
+			return TimestampValue{Value: first.Value.Add(clock)}, true
+		}
+		if first, isTime := left.(TimeValue); isTime && sign < 0 {
+			return IntervalValue{Duration: first.Value.Sub(second.Value)}, true
+		}
+	case DateValue, TimestampValue:
+		if sign > 0 {
+			return nil, false
+		}
+		if first, isDate := left.(DateValue); isDate && right.GetType() == DateType {
+			return IntegerValue{Value: (first.Value.Unix() - right.(DateValue).Value.Unix()) / (24 * 60 * 60)}, true
+		}
+		first, isPointInTime := toPointInTime(left)
+		secondPointInTime, _ := toPointInTime(right)
+		if !isPointInTime || left.GetType() == TimeType {
+			return nil, false
+		}
+		return differenceOfTimestamps(first, secondPointInTime), true
+	}
+	return nil, false
+}
+
+// differenceOfTimestamps - Return interval between timestamps in days and time of the day, days and time have the
+// same sign, ex. -1 days -01:00:00
+func differenceOfTimestamps(first time.Time, second time.Time) IntervalValue {
+	microseconds := (first.Unix()-second.Unix())*int64(time.Second/time.Microsecond) +
+		int64(first.Nanosecond()-second.Nanosecond())/int64(time.Microsecond)
+	return IntervalValue{Days: int(microseconds / microsecondsInDay),
+		Duration: time.Duration(microseconds%microsecondsInDay) * time.Microsecond}
+}
+
+// addInterval - Return DATE or TIMESTAMP moved by the interval, adding months keeps the day of month unless the
+// month is too short, ex. 2024-01-31 + 1 month is 2024-02-29, DATE becomes TIMESTAMP if interval contains time
+func addInterval(value ValueInterface, interval IntervalValue) ValueInterface {
+	pointInTime, _ := toPointInTime(value)
+
+	year, month, day := pointInTime.Date()
+	hour, minute, second := pointInTime.Clock()
+	firstDayOfMonth := time.Date(year, month+time.Month(interval.Months), 1, 0, 0, 0, 0, time.UTC)
+	lastDayOfMonth := firstDayOfMonth.AddDate(0, 1, -1).Day()
+	shifted := time.Date(firstDayOfMonth.Year(), firstDayOfMonth.Month(), min(day, lastDayOfMonth), hour, minute,
+		second, pointInTime.Nanosecond(), time.UTC)
+	shifted = shifted.AddDate(0, 0, interval.Days).Add(interval.Duration)
+
+	if timestamp, isTimestamp := value.(TimestampValue); isTimestamp {
+		return TimestampValue{Value: shifted, WithTimeZone: timestamp.WithTimeZone}
+	}
+	if interval.Duration != 0 {
+		return TimestampValue{Value: shifted}
+	}
+	return DateValue{Value: shifted}
+}
+
+// addToClock - Return time of the day moved by duration, result wraps around midnight
+func addToClock(clock time.Time, duration time.Duration) time.Time {
+	day := 24 * time.Hour
+	sinceMidnight := (clock.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)) + duration%day + day) % day
+	return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(sinceMidnight)
+}
+
+func (value IntervalValue) negate() IntervalValue {
+	return IntervalValue{Months: -value.Months, Days: -value.Days, Duration: -value.Duration}
+}
@@ -3,8 +3,10 @@ package engine
 import (
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/LissaGreense/GO4SQL/ast"
 	"github.com/LissaGreense/GO4SQL/token"
@@ -12,19 +14,34 @@ import (
 
 type DbEngine struct {
 	Tables Tables
+	// Types - user-defined types created with CREATE TYPE, columns refer to them by name
+	Types Types
+	// StandardNullSemantics - when set, conditions follow SQL three-valued logic, so comparison with NULL is UNKNOWN
+	// and aggregate functions ignore NULL values
+	StandardNullSemantics bool
+	// Sequences - sequences created with CREATE SEQUENCE and the ones generating values of SERIAL and identity columns
+	Sequences Sequences
+	// mutex - commands are evaluated one by one, so clients connected in socket mode can't interleave their changes
+	mutex sync.Mutex
 }
 type Tables map[string]*Table
+type Types map[string]*Enum
+type Sequences map[string]*Sequence
 
 // New Return new DbEngine struct
 func New() *DbEngine {
 	engine := &DbEngine{}
 	engine.Tables = make(Tables)
+	engine.Types = make(Types)
+	engine.Sequences = make(Sequences)
 
 	return engine
 }
 
 // Evaluate - it takes sequences, map them to specific implementation and then process it in SQL engine
 func (engine *DbEngine) Evaluate(sequences *ast.Sequence) (string, error) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
 	commands := sequences.Commands
 
 	result := ""
@@ -42,18 +59,43 @@ func (engine *DbEngine) Evaluate(sequences *ast.Sequence) (string, error) {
 		case *ast.JoinCommand:
 			continue
 		case *ast.CreateCommand:
+			if _, exist := engine.Tables[mappedCommand.Name.Token.Literal]; exist && mappedCommand.IfNotExists {
+				result += "Table '" + mappedCommand.Name.GetToken().Literal + "' already exists, skipping\n"
+				continue
+			}
 			err := engine.createTable(mappedCommand)
 			if err != nil {
 				return "", err
 			}
 			result += "Table '" + mappedCommand.Name.GetToken().Literal + "' has been created\n"
 			continue
+		case *ast.CreateTypeCommand:
+			err := engine.createType(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Type '" + mappedCommand.Name.GetToken().Literal + "' has been created\n"
+			continue
+		case *ast.CreateIndexCommand:
+			indexName, err := engine.createIndex(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Index '" + indexName + "' has been created\n"
+			continue
+		case *ast.CreateSequenceCommand:
+			err := engine.createSequence(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Sequence '" + mappedCommand.Name.GetToken().Literal + "' has been created\n"
+			continue
 		case *ast.InsertCommand:
 			err := engine.insertIntoTable(mappedCommand)
 			if err != nil {
 				return "", err
 			}
-			result += "Data Inserted\n"
+			result += "Data Inserted" + getAffectedRowsMessage(1) + "\n"
 			continue
 		case *ast.SelectCommand:
 			selectOutput, err := engine.getSelectResponse(mappedCommand)
@@ -63,25 +105,52 @@ func (engine *DbEngine) Evaluate(sequences *ast.Sequence) (string, error) {
 			result += selectOutput.ToString() + "\n"
 			continue
 		case *ast.DeleteCommand:
-			deleteCommand := command.(*ast.DeleteCommand)
-			if deleteCommand.HasWhereCommand() {
-				err := engine.deleteFromTable(mappedCommand, deleteCommand.WhereCommand)
-				if err != nil {
-					return "", err
-				}
+			deletedRowsCount, err := engine.deleteFromTable(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Data from '" + mappedCommand.Name.GetToken().Literal + "' has been deleted" + getAffectedRowsMessage(deletedRowsCount) + "\n"
+			continue
+		case *ast.TruncateCommand:
+			truncatedTables, err := engine.truncateTables(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			for _, truncatedTable := range truncatedTables {
+				result += "Table: '" + truncatedTable.name + "' has been truncated" + getAffectedRowsMessage(truncatedTable.rowsCount) + "\n"
 			}
-			result += "Data from '" + mappedCommand.Name.GetToken().Literal + "' has been deleted\n"
 			continue
 		case *ast.DropCommand:
-			engine.dropTable(mappedCommand)
+			if _, exist := engine.Tables[mappedCommand.Name.Token.Literal]; !exist && mappedCommand.IfExists {
+				result += "Table: '" + mappedCommand.Name.GetToken().Literal + "' doesn't exist, skipping\n"
+				continue
+			}
+			err := engine.dropTable(mappedCommand)
+			if err != nil {
+				return "", err
+			}
 			result += "Table: '" + mappedCommand.Name.GetToken().Literal + "' has been dropped\n"
 			continue
+		case *ast.AlterTableCommand:
+			err := engine.alterTable(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Table: '" + mappedCommand.Name.GetToken().Literal + "' has been altered\n"
+			continue
+		case *ast.DropIndexCommand:
+			err := engine.dropIndex(mappedCommand)
+			if err != nil {
+				return "", err
+			}
+			result += "Index: '" + mappedCommand.Name.GetToken().Literal + "' has been dropped\n"
+			continue
 		case *ast.UpdateCommand:
-			err := engine.updateTable(mappedCommand)
+			updatedRowsCount, err := engine.updateTable(mappedCommand)
 			if err != nil {
 				return "", err
 			}
-			result += "Table: '" + mappedCommand.Name.GetToken().Literal + "' has been updated\n"
+			result += "Table: '" + mappedCommand.Name.GetToken().Literal + "' has been updated" + getAffectedRowsMessage(updatedRowsCount) + "\n"
 			continue
 		default:
 			return "", &UnsupportedCommandTypeFromParserError{variable: fmt.Sprintf("%s", command)}
@@ -94,6 +163,8 @@ func (engine *DbEngine) Evaluate(sequences *ast.Sequence) (string, error) {
 // getSelectResponse - Returns Select response basing on ast.OrderByCommand and ast.WhereCommand included in this Select
 func (engine *DbEngine) getSelectResponse(selectCommand *ast.SelectCommand) (*Table, error) {
 	var table *Table
+	// indexedTable - rows filtered and sorted with index, it's nil when index can't be used
+	var indexedTable *Table
 	var err error
 
 	if selectCommand.HasJoinCommand() {
@@ -102,6 +173,11 @@ func (engine *DbEngine) getSelectResponse(selectCommand *ast.SelectCommand) (*Ta
 		if err != nil {
 			return nil, err
 		}
+	} else if selectCommand.TableFunction != nil {
+		table, err = engine.getUnnestTable(*selectCommand.TableFunction, selectCommand.Name.Token.Literal)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		var exist bool
 		table, exist = engine.Tables[selectCommand.Name.Token.Literal]
@@ -109,9 +185,18 @@ func (engine *DbEngine) getSelectResponse(selectCommand *ast.SelectCommand) (*Ta
 		if !exist {
 			return nil, &TableDoesNotExistError{selectCommand.Name.Token.Literal}
 		}
+		indexedTable, err = engine.selectWithIndex(selectCommand, table)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if selectCommand.HasWhereCommand() {
+	if indexedTable != nil {
+		table, err = engine.selectFromProvidedTable(selectCommand, indexedTable)
+		if err != nil {
+			return nil, err
+		}
+	} else if selectCommand.HasWhereCommand() {
 		whereCommand := selectCommand.WhereCommand
 		if selectCommand.HasOrderByCommand() {
 			orderByCommand := selectCommand.OrderByCommand
@@ -148,6 +233,31 @@ func (engine *DbEngine) getSelectResponse(selectCommand *ast.SelectCommand) (*Ta
 	return table, nil
 }
 
+// getUnnestTable - Return table with single column containing elements of array passed to UNNEST(array) in FROM,
+// column has the same name as the table
+func (engine *DbEngine) getUnnestTable(functionCall ast.FunctionCall, name string) (*Table, error) {
+	if len(functionCall.Arguments) != 1 {
+		return nil, &InvalidNumberOfFunctionArgumentsError{functionName: functionCall.Name.Literal, minNumber: 1, maxNumber: 1,
+			actualNumber: len(functionCall.Arguments)}
+	}
+	value, err := engine.getTifierValue(functionCall.Arguments[0], map[string]ValueInterface{})
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]ValueInterface, 0)
+	if value.GetType() != NullType {
+		array, err := getArrayArgument(functionCall.Name.Literal, value)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, array.Values...)
+	}
+
+	column := &Column{Name: name, Type: getColumnTypeOfValues(elements), Values: elements}
+	return &Table{Columns: []*Column{column}}, nil
+}
+
 // createTable - initialize new table in engine with specified name
 func (engine *DbEngine) createTable(command *ast.CreateCommand) error {
 	_, exist := engine.Tables[command.Name.Token.Literal]
@@ -156,29 +266,136 @@ func (engine *DbEngine) createTable(command *ast.CreateCommand) error {
 		return &TableAlreadyExistsError{command.Name.Token.Literal}
 	}
 
-	engine.Tables[command.Name.Token.Literal] = &Table{Columns: []*Column{}}
-	for i, columnName := range command.ColumnNames {
-		engine.Tables[command.Name.Token.Literal].Columns = append(engine.Tables[command.Name.Token.Literal].Columns,
-			&Column{
-				Type:   command.ColumnTypes[i],
-				Values: make([]ValueInterface, 0),
-				Name:   columnName,
-			})
+	table := &Table{Columns: []*Column{}}
+	// Sequences of SERIAL and identity columns are registered only when whole table is created
+	ownedSequences := make([]*Sequence, 0)
+	reservedSequenceNames := make(map[string]bool)
+	for i := range command.ColumnNames {
+		column, sequence, err := engine.getDefinedColumn(command, i, reservedSequenceNames)
+		if err != nil {
+			return err
+		}
+		table.Columns = append(table.Columns, column)
+		if sequence != nil {
+			ownedSequences = append(ownedSequences, sequence)
+		}
+	}
+
+	usedConstraintNames, err := getDeclaredConstraintNames(command)
+	if err != nil {
+		return err
+	}
+	checks, err := engine.getCheckConstraints(command, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+	table.checks = checks
+
+	if len(command.PrimaryKey) > 0 {
+		primaryKeyColumns, err := getKeyColumns(table, command.PrimaryKey, command.Name.Token.Literal, token.PRIMARY+" "+token.KEY)
+		if err != nil {
+			return err
+		}
+		// Columns of primary key can't contain NULL
+		for _, position := range primaryKeyColumns {
+			table.Columns[position].NotNull = true
+		}
+		table.primaryKey = newPrimaryKeyIndex(primaryKeyColumns)
+	}
+
+	uniqueConstraints, err := getUniqueConstraints(command, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+	table.uniqueConstraints = uniqueConstraints
+
+	foreignKeys, err := engine.getForeignKeys(command, table, usedConstraintNames)
+	if err != nil {
+		return err
+	}
+	table.foreignKeys = foreignKeys
+
+	engine.Tables[command.Name.Token.Literal] = table
+	for _, sequence := range ownedSequences {
+		engine.Sequences[sequence.Name] = sequence
 	}
 	return nil
 }
 
-func (engine *DbEngine) updateTable(command *ast.UpdateCommand) error {
+// getDefinedColumn - Return column defined in the command at provided position, sequence generating values of the
+// column is returned when it's SERIAL or identity column, otherwise it's nil
+func (engine *DbEngine) getDefinedColumn(command *ast.CreateCommand, i int, reservedSequenceNames map[string]bool) (*Column, *Sequence, error) {
+	var enum *Enum
+	if command.ColumnTypes[i].Type == token.IDENT {
+		var exist bool
+		enum, exist = engine.Types[command.ColumnTypes[i].Literal]
+		if !exist {
+			return nil, nil, &TypeDoesNotExistError{command.ColumnTypes[i].Literal}
+		}
+	}
+	column := &Column{
+		Type:           command.ColumnTypes[i],
+		TypeParameters: command.ColumnTypeParameters[i],
+		Enum:           enum,
+		IsArray:        command.ColumnIsArray[i],
+		NotNull:        command.ColumnNotNull[i],
+		Default:        command.ColumnDefaults[i],
+		Values:         make([]ValueInterface, 0),
+		Name:           command.ColumnNames[i],
+	}
+	if command.ColumnTypes[i].Type == token.SERIAL || command.ColumnIdentities[i] != ast.NoIdentity {
+		sequence, err := engine.getOwnedSequence(command.Name.Token.Literal, column, command.ColumnIdentities[i], reservedSequenceNames)
+		if err != nil {
+			return nil, nil, err
+		}
+		return column, sequence, nil
+	}
+	return column, nil, engine.validateDefaultValue(column, command.Token.Literal)
+}
+
+// getKeyColumns - Return positions of columns with provided names, every column can be listed only once
+func getKeyColumns(table *Table, columnNames []string, tableName string, constraint string) ([]int, error) {
+	positions := make([]int, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		position := slices.IndexFunc(table.Columns, func(column *Column) bool { return column.Name == columnName })
+		if position < 0 {
+			return nil, &ColumnDoesNotExistError{tableName: tableName, columnName: columnName}
+		}
+		if slices.Contains(positions, position) {
+			return nil, &DuplicatedKeyColumnError{columnName: columnName, constraint: constraint}
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// createType - register new ENUM type in engine with specified name and labels
+func (engine *DbEngine) createType(command *ast.CreateTypeCommand) error {
+	_, exist := engine.Types[command.Name.Token.Literal]
+	if exist {
+		return &TypeAlreadyExistsError{command.Name.Token.Literal}
+	}
+
+	enum, err := newEnum(command.Name.Token.Literal, command.Labels)
+	if err != nil {
+		return err
+	}
+	engine.Types[command.Name.Token.Literal] = enum
+	return nil
+}
+
+// updateTable - Update rows of table that match condition of the command, number of updated rows is returned
+func (engine *DbEngine) updateTable(command *ast.UpdateCommand) (int, error) {
 	table, exist := engine.Tables[command.Name.Token.Literal]
 
 	if !exist {
-		return &TableDoesNotExistError{command.Name.Token.Literal}
+		return 0, &TableDoesNotExistError{command.Name.Token.Literal}
 	}
 
 	columns := table.Columns
 
 	// TODO: This could be optimized
-	mappedChanges := make(map[int]ast.Anonymitifier)
+	mappedChanges := make(map[int]ast.Tifier)
 	for updatedCol, newValue := range command.Changes {
 		for colIndex := 0; colIndex < len(columns); colIndex++ {
 			if columns[colIndex].Name == updatedCol.Literal {
@@ -186,32 +403,67 @@ func (engine *DbEngine) updateTable(command *ast.UpdateCommand) error {
 				break
 			}
 			if colIndex == len(columns)-1 {
-				return &ColumnDoesNotExistError{tableName: command.Name.GetToken().Literal, columnName: updatedCol.Literal}
+				return 0, &ColumnDoesNotExistError{tableName: command.Name.GetToken().Literal, columnName: updatedCol.Literal}
 			}
 		}
+
+		missingColumnName := engine.getMissingColumnName(getIdentifierNames(ast.GetTifierIdentifiers(newValue)), table)
+		if missingColumnName != "" {
+			return 0, &ColumnDoesNotExistError{tableName: command.Name.GetToken().Literal, columnName: missingColumnName}
+		}
 	}
 
-	numberOfRows := len(columns[0].Values)
-	for rowIndex := 0; rowIndex < numberOfRows; rowIndex++ {
+	rowIndexes := engine.getCandidateRows(table, nil)
+	if command.HasWhereCommand() {
+		rowIndexes = engine.getCandidateRows(table, command.WhereCommand.Expression)
+	}
+	updatedRows := make(map[int]map[int]ValueInterface)
+	for _, rowIndex := range rowIndexes {
+		row := getRow(table, rowIndex)
 		if command.HasWhereCommand() {
-			fulfilledFilters, err := isFulfillingFilters(getRow(table, rowIndex), command.WhereCommand.Expression, command.WhereCommand.Token.Literal)
+			fulfilledFilters, err := engine.isFulfillingFilters(row, command.WhereCommand.Expression, command.WhereCommand.Token.Literal)
 			if err != nil {
-				return err
+				return 0, err
 			}
 			if !fulfilledFilters {
 				continue
 			}
 		}
+		// All new values are evaluated before assignment, so every change sees the row as it was before update
+		newValues := make(map[int]ValueInterface)
 		for colIndex, value := range mappedChanges {
-			interfaceValue, err := getInterfaceValue(value.GetToken())
+			interfaceValue, err := engine.getUpdatedValue(value, row, columns[colIndex], command.Token.Literal)
 			if err != nil {
-				return err
+				return 0, err
 			}
-			table.Columns[colIndex].Values[rowIndex] = interfaceValue
+			newValues[colIndex] = interfaceValue
 		}
+		updatedRows[rowIndex] = newValues
 	}
 
-	return nil
+	// Failed update can't leave tables partially updated, also the ones changed by actions of foreign keys
+	changes := make(changeLog, 0)
+	err := engine.updateRows(command.Name.Token.Literal, table, updatedRows, command.Token.Literal, &changes)
+	if err != nil {
+		changes.revert()
+		return 0, err
+	}
+	return len(updatedRows), nil
+}
+
+// getUpdatedValue - Return new value of the column calculated for the row, DEFAULT keyword sets default value
+func (engine *DbEngine) getUpdatedValue(value ast.Tifier, row map[string]ValueInterface, column *Column, commandName string) (ValueInterface, error) {
+	if isDefaultKeyword(value) {
+		return engine.getDefaultValue(column, commandName)
+	}
+	if column.Identity == ast.IdentityAlways {
+		return nil, &IdentityColumnValueError{columnName: column.Name, commandName: commandName}
+	}
+	interfaceValue, err := engine.getTifierValue(value, row)
+	if err != nil {
+		return nil, err
+	}
+	return convertToColumnType(interfaceValue, column, commandName)
 }
 
 // insertIntoTable - Insert row of values into the table
@@ -223,22 +475,102 @@ func (engine *DbEngine) insertIntoTable(command *ast.InsertCommand) error {
 
 	columns := table.Columns
 
-	if len(command.Values) != len(columns) {
-		return &InvalidNumberOfParametersError{expectedNumber: len(columns), actualNumber: len(command.Values), commandName: command.Token.Literal}
+	values, err := engine.getInsertedValues(command, table)
+	if err != nil {
+		return err
 	}
-
+	err = table.validateRow(command.Name.Token.Literal, values, command.Token.Literal)
+	if err != nil {
+		return err
+	}
+	err = table.validateKeys(command.Name.Token.Literal, values)
+	if err != nil {
+		return err
+	}
+	err = table.validateReferences(engine, command.Name.Token.Literal, values)
+	if err != nil {
+		return err
+	}
+	table.addKeys(values, len(columns[0].Values))
+	// Values are added after validation of the whole row, so failed insert doesn't leave incomplete row
 	for i := range columns {
-		expectedToken := tokenMapper(columns[i].Type.Type)
-		if (expectedToken != command.Values[i].Type) && (command.Values[i].Type != token.NULL) {
-			return &InvalidValueTypeError{expectedType: string(expectedToken), actualType: string(command.Values[i].Type), commandName: command.Token.Literal}
+		columns[i].Values = append(columns[i].Values, values[i])
+	}
+	return nil
+}
+
+// getInsertedValues - Return values of inserted row ordered the same way as columns of the table, columns omitted in
+// INSERT or set to DEFAULT get their default values
+func (engine *DbEngine) getInsertedValues(command *ast.InsertCommand, table *Table) ([]ValueInterface, error) {
+	columns := table.Columns
+
+	positions := make([]int, 0, len(columns))
+	if command.ColumnNames == nil {
+		for i := range columns {
+			positions = append(positions, i)
 		}
-		interfaceValue, err := getInterfaceValue(command.Values[i])
+	} else {
+		for _, columnName := range command.ColumnNames {
+			position := slices.IndexFunc(columns, func(column *Column) bool { return column.Name == columnName })
+			if position < 0 {
+				return nil, &ColumnDoesNotExistError{tableName: command.Name.Token.Literal, columnName: columnName}
+			}
+			if slices.Contains(positions, position) {
+				return nil, &DuplicatedColumnError{columnName: columnName, commandName: command.Token.Literal}
+			}
+			positions = append(positions, position)
+		}
+	}
+
+	if len(command.Values) != len(positions) {
+		return nil, &InvalidNumberOfParametersError{expectedNumber: len(positions), actualNumber: len(command.Values), commandName: command.Token.Literal}
+	}
+
+	values := make([]ValueInterface, len(columns))
+	for i, position := range positions {
+		if isDefaultKeyword(command.Values[i]) {
+			continue
+		}
+		if columns[position].Identity == ast.IdentityAlways {
+			return nil, &IdentityColumnValueError{columnName: columns[position].Name, commandName: command.Token.Literal}
+		}
+		interfaceValue, err := engine.getInsertedValue(command.Values[i], columns[position], command)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		columns[i].Values = append(columns[i].Values, interfaceValue)
+		values[position] = interfaceValue
 	}
-	return nil
+	for i, value := range values {
+		if value != nil {
+			continue
+		}
+		defaultValue, err := engine.getDefaultValue(columns[i], command.Token.Literal)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = defaultValue
+	}
+	return values, nil
+}
+
+// getInsertedValue - Return value written in VALUES converted to the type of column, literals have to match type of
+// the column and other expressions are evaluated like in UPDATE, but they can't refer to columns
+func (engine *DbEngine) getInsertedValue(value ast.Tifier, column *Column, command *ast.InsertCommand) (ValueInterface, error) {
+	switch value.(type) {
+	case ast.Anonymitifier, ast.Identifier:
+		// Word written without apostrophes is text, the same as in quotes
+		return getColumnValue(value.GetToken(), column, command.Token.Literal)
+	}
+
+	identifiers := ast.GetTifierIdentifiers(value)
+	if len(identifiers) > 0 {
+		return nil, &ColumnDoesNotExistError{tableName: command.Name.Token.Literal, columnName: identifiers[0].Token.Literal}
+	}
+	interfaceValue, err := engine.getTifierValue(value, map[string]ValueInterface{})
+	if err != nil {
+		return nil, err
+	}
+	return convertToColumnType(interfaceValue, column, command.Token.Literal)
 }
 
 func (engine *DbEngine) selectFromProvidedTable(command *ast.SelectCommand, table *Table) (*Table, error) {
@@ -260,6 +592,8 @@ func (engine *DbEngine) selectFromProvidedTable(command *ast.SelectCommand, tabl
 				if len(columns) > 0 {
 					columnValues = columns[0].Values
 				}
+			} else if currentSpace.ContainsFunction() {
+				columnValues, err = engine.getValuesOfSpaceFunction(currentSpace, table, command.Name.GetToken().Literal)
 			} else {
 				columnValues, err = getValuesOfColumn(currentSpace.ColumnName.Literal, columns)
 			}
@@ -271,15 +605,18 @@ func (engine *DbEngine) selectFromProvidedTable(command *ast.SelectCommand, tabl
 			if currentSpace.ContainsAggregateFunc() {
 				columnName = fmt.Sprintf("%s(%s)", currentSpace.AggregateFunc.Literal,
 					currentSpace.ColumnName.Literal)
-				columnType = evaluateColumnTypeOfAggregateFunc(currentSpace)
-				aggregatedValue, aggregateErr := aggregateColumnContent(currentSpace, columnValues)
+				aggregatedValue, aggregateErr := engine.aggregateColumnContent(currentSpace, columnValues)
 				if aggregateErr != nil {
 					return nil, aggregateErr
 				}
+				columnType = evaluateColumnTypeOfAggregateFunc(currentSpace, aggregatedValue)
 				value = append(value, aggregatedValue)
 			} else {
 				columnName = currentSpace.ColumnName.Literal
 				columnType = currentSpace.ColumnName
+				if currentSpace.ContainsFunction() {
+					columnType = getColumnTypeOfValues(columnValues)
+				}
 				value = append(value, columnValues[0])
 			}
 
@@ -290,6 +627,8 @@ func (engine *DbEngine) selectFromProvidedTable(command *ast.SelectCommand, tabl
 			})
 		}
 		return selectedTable, nil
+	} else if command.ScalarFunctionAppears() {
+		return engine.selectSpacesWithFunctions(command, table)
 	} else if command.Space[0].ColumnName.Type == token.ASTERISK {
 		for i := 0; i < len(columns); i++ {
 			wantedColumnNames = append(wantedColumnNames, columns[i].Name)
@@ -303,6 +642,50 @@ func (engine *DbEngine) selectFromProvidedTable(command *ast.SelectCommand, tabl
 	}
 }
 
+// selectSpacesWithFunctions - Return Table with column for every selected space, values of functions are
+// calculated for every row of provided table
+func (engine *DbEngine) selectSpacesWithFunctions(command *ast.SelectCommand, table *Table) (*Table, error) {
+	tableName := command.Name.GetToken().Literal
+	selectedTable := &Table{Columns: make([]*Column, 0)}
+	selectedColumnNames := make(map[string]bool)
+
+	for _, space := range command.Space {
+		if selectedColumnNames[space.ColumnName.Literal] {
+			continue
+		}
+		selectedColumnNames[space.ColumnName.Literal] = true
+
+		if !space.ContainsFunction() {
+			columnContent, err := extractColumnContent(table.Columns, &[]string{space.ColumnName.Literal}, tableName)
+			if err != nil {
+				return nil, err
+			}
+			selectedTable.Columns = append(selectedTable.Columns, columnContent.Columns[0])
+			continue
+		}
+
+		values, err := engine.getValuesOfSpaceFunction(space, table, tableName)
+		if err != nil {
+			return nil, err
+		}
+		selectedTable.Columns = append(selectedTable.Columns, &Column{
+			Name:   space.ColumnName.Literal,
+			Type:   getColumnTypeOfValues(values),
+			Values: values,
+		})
+	}
+	return selectedTable, nil
+}
+
+// getValuesOfSpaceFunction - Return values of function from space evaluated for every row of the table
+func (engine *DbEngine) getValuesOfSpaceFunction(space ast.Space, table *Table, tableName string) ([]ValueInterface, error) {
+	missingColumnName := engine.getMissingColumnName(getIdentifierNames(space.Function.GetIdentifiers()), table)
+	if missingColumnName != "" {
+		return nil, &ColumnDoesNotExistError{tableName: tableName, columnName: missingColumnName}
+	}
+	return engine.getValuesOfFunction(*space.Function, table)
+}
+
 func getValuesOfColumn(columnName string, columns []*Column) ([]ValueInterface, error) {
 	wantedColumnName := []string{columnName}
 	columnContent, err := extractColumnContent(columns, &wantedColumnName, "")
@@ -312,20 +695,95 @@ func getValuesOfColumn(columnName string, columns []*Column) ([]ValueInterface,
 	return columnContent.Columns[0].Values, nil
 }
 
-func evaluateColumnTypeOfAggregateFunc(space ast.Space) token.Token {
+func evaluateColumnTypeOfAggregateFunc(space ast.Space, aggregatedValue ValueInterface) token.Token {
 	if space.AggregateFunc.Type == token.MIN ||
 		space.AggregateFunc.Type == token.MAX {
 		return space.ColumnName
 	}
+	if space.AggregateFunc.Type == token.BOOL_AND ||
+		space.AggregateFunc.Type == token.BOOL_OR {
+		return token.Token{Type: token.BOOLEAN, Literal: token.BOOLEAN}
+	}
+	if isNumeric(aggregatedValue) {
+		return getColumnTypeOfValue(aggregatedValue)
+	}
 	return token.Token{Type: token.INT, Literal: "INT"}
 }
 
-func aggregateColumnContent(space ast.Space, columnValues []ValueInterface) (ValueInterface, error) {
+// getBooleanAggregate - Return TRUE if all (BOOL_AND) or any (BOOL_OR) of not NULL values is TRUE, NULL is returned
+// when there are only NULL values
+func getBooleanAggregate(values []ValueInterface, aggregateFunc *token.Token) (ValueInterface, error) {
+	var result ValueInterface = NullValue{}
+	for _, value := range values {
+		if value.GetType() == NullType {
+			continue
+		}
+		booleanValue, isBoolean := value.(BooleanValue)
+		if !isBoolean {
+			return nil, &InvalidFunctionArgumentError{functionName: aggregateFunc.Literal, expectedType: token.BOOLEAN, actualValue: value.ToString()}
+		}
+		if result.GetType() == NullType {
+			result = booleanValue
+			continue
+		}
+		if aggregateFunc.Type == token.BOOL_AND {
+			result = BooleanValue{Value: result.(BooleanValue).Value && booleanValue.Value}
+		} else {
+			result = BooleanValue{Value: result.(BooleanValue).Value || booleanValue.Value}
+		}
+	}
+	return result, nil
+}
+
+// getSumOfNumbers - Return sum of not NULL values and their count, INT values are promoted to DECIMAL or FLOAT in the
+// same way as in arithmetic operators
+func getSumOfNumbers(values []ValueInterface, functionName string) (ValueInterface, int, error) {
+	var sum ValueInterface = NullValue{}
+	count := 0
+	for _, value := range values {
+		if value.GetType() == NullType {
+			continue
+		}
+		if !isNumeric(value) {
+			return nil, 0, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "NUMERIC", actualValue: value.ToString()}
+		}
+		count++
+		if sum.GetType() == NullType {
+			sum = value
+			continue
+		}
+		var err error
+		sum, err = calculate(token.PLUS, sum, value)
+		if _, isFloatOverflow := err.(*FloatOverflowError); isFloatOverflow {
+			return nil, 0, &FloatOverflowError{operation: functionName}
+		}
+		if err != nil {
+			return nil, 0, &IntegerOverflowError{operation: functionName}
+		}
+	}
+	return sum, count, nil
+}
+
+// getAverage - Return sum divided by count, INT and DECIMAL values are divided precisely and trailing zeros are
+// removed down to the scale of sum, ex. average of 1 and 2 is 1.5
+func getAverage(sum ValueInterface, count int, functionName string) (ValueInterface, error) {
+	if sum.GetType() == FloatType {
+		return FloatValue{Value: sum.(FloatValue).Value / float64(count)}, nil
+	}
+	decimalSum := toDecimal(sum)
+	average, err := divideDecimals(functionName, decimalSum, toDecimal(IntegerValue{Value: int64(count)}), max(divisionScale, decimalSum.Scale))
+	if err != nil {
+		return nil, err
+	}
+	return average.trimTrailingZeros(decimalSum.Scale), nil
+}
+
+func (engine *DbEngine) aggregateColumnContent(space ast.Space, columnValues []ValueInterface) (ValueInterface, error) {
 	if space.AggregateFunc.Type == token.COUNT {
 		if space.ColumnName.Type == token.ASTERISK {
-			return IntegerValue{Value: len(columnValues)}, nil
+			return IntegerValue{Value: int64(len(columnValues))}, nil
 		}
-		count := 0
+		count := int64(0)
 		for _, value := range columnValues {
 			if value.GetType() != NullType {
 				count++
@@ -333,10 +791,15 @@ func aggregateColumnContent(space ast.Space, columnValues []ValueInterface) (Val
 		}
 		return IntegerValue{Value: count}, nil
 	}
+	if engine.StandardNullSemantics {
+		columnValues = withoutNullValues(columnValues)
+	}
 	if len(columnValues) == 0 {
 		return NullValue{}, nil
 	}
 	switch space.AggregateFunc.Type {
+	case token.BOOL_AND, token.BOOL_OR:
+		return getBooleanAggregate(columnValues, space.AggregateFunc)
 	case token.MAX:
 		maxValue, err := getMax(columnValues)
 		if err != nil {
@@ -352,63 +815,90 @@ func aggregateColumnContent(space ast.Space, columnValues []ValueInterface) (Val
 	case token.SUM:
 		if columnValues[0].GetType() == StringType {
 			return IntegerValue{Value: 0}, nil
-		} else {
-			sum := 0
-			for _, value := range columnValues {
-				if value.GetType() != NullType {
-					num, err := strconv.Atoi(value.ToString())
-					if err != nil {
-						return nil, err
-					}
-					sum += num
-				}
-			}
-			return IntegerValue{Value: sum}, nil
 		}
+		sum, _, err := getSumOfNumbers(columnValues, space.AggregateFunc.Literal)
+		return sum, err
 	default:
 		if columnValues[0].GetType() == StringType {
 			return IntegerValue{Value: 0}, nil
-		} else {
-			sum := 0
-			for _, value := range columnValues {
-				num, err := strconv.Atoi(value.ToString())
-				if err != nil {
-					return nil, err
-				}
-				sum += num
-			}
-			return IntegerValue{Value: sum / len(columnValues)}, nil
 		}
+		sum, count, err := getSumOfNumbers(columnValues, space.AggregateFunc.Literal)
+		if err != nil || count == 0 {
+			return sum, err
+		}
+		return getAverage(sum, count, space.AggregateFunc.Literal)
 	}
 }
 
-// deleteFromTable - Delete all rows of data from table that match given condition
-func (engine *DbEngine) deleteFromTable(deleteCommand *ast.DeleteCommand, whereCommand *ast.WhereCommand) error {
+// deleteFromTable - Delete all rows of data from table that match condition of the command, all rows are deleted
+// when command has no condition, number of deleted rows is returned, rows removed by actions of foreign keys aren't
+// counted
+func (engine *DbEngine) deleteFromTable(deleteCommand *ast.DeleteCommand) (int, error) {
 	table, exist := engine.Tables[deleteCommand.Name.Token.Literal]
 
 	if !exist {
-		return &TableDoesNotExistError{deleteCommand.Name.Token.Literal}
+		return 0, &TableDoesNotExistError{deleteCommand.Name.Token.Literal}
 	}
 
-	newTable, err := engine.getFilteredTable(table, whereCommand, true, deleteCommand.Name.Token.Literal)
+	var expression ast.Expression
+	if deleteCommand.HasWhereCommand() {
+		expression = deleteCommand.WhereCommand.Expression
+		missingColumnName := engine.getMissingColumnName(getIdentifierNames(expression.GetIdentifiers()), table)
+		if missingColumnName != "" {
+			return 0, &ColumnDoesNotExistError{tableName: deleteCommand.Name.Token.Literal, columnName: missingColumnName}
+		}
+	}
 
+	deletedRows := make(map[int]bool)
+	for _, rowIndex := range engine.getCandidateRows(table, expression) {
+		if deleteCommand.HasWhereCommand() {
+			fulfilledFilters, err := engine.isFulfillingFilters(getRow(table, rowIndex), expression, deleteCommand.WhereCommand.Token.Literal)
+			if err != nil {
+				return 0, err
+			}
+			if !fulfilledFilters {
+				continue
+			}
+		}
+		deletedRows[rowIndex] = true
+	}
+
+	// Failed delete can't leave tables partially changed, also the ones changed by actions of foreign keys
+	changes := make(changeLog, 0)
+	err := engine.deleteRows(deleteCommand.Name.Token.Literal, table, deletedRows, &changes)
 	if err != nil {
-		return err
+		changes.revert()
+		return 0, err
 	}
-	engine.Tables[deleteCommand.Name.Token.Literal] = newTable
+	return len(deletedRows), nil
+}
 
-	return nil
+// getAffectedRowsMessage - Return number of rows changed by the command shown in its response, ex. " (2 rows affected)"
+func getAffectedRowsMessage(rowsCount int) string {
+	if rowsCount == 1 {
+		return " (1 row affected)"
+	}
+	return " (" + strconv.Itoa(rowsCount) + " rows affected)"
 }
 
-// dropTable - Drop table with given name
-func (engine *DbEngine) dropTable(dropCommand *ast.DropCommand) {
+// dropTable - Drop table with given name, foreign keys referring to it are dropped only with CASCADE
+func (engine *DbEngine) dropTable(dropCommand *ast.DropCommand) error {
+	if _, exist := engine.Tables[dropCommand.Name.Token.Literal]; !exist {
+		return &TableDoesNotExistError{dropCommand.Name.Token.Literal}
+	}
+	err := engine.dropReferences(dropCommand)
+	if err != nil {
+		return err
+	}
 	delete(engine.Tables, dropCommand.Name.GetToken().Literal)
+	engine.dropOwnedSequences(dropCommand.Name.GetToken().Literal)
+	return nil
 }
 
 // selectFromTableWithWhere - Return Table containing all values requested by SelectCommand and filtered by WhereCommand
 func (engine *DbEngine) selectFromTableWithWhere(selectCommand *ast.SelectCommand, whereCommand *ast.WhereCommand, table *Table) (*Table, error) {
 	if len(table.Columns) == 0 || len(table.Columns[0].Values) == 0 {
-		return engine.selectFromProvidedTable(selectCommand, &Table{Columns: []*Column{}})
+		return engine.selectFromProvidedTable(selectCommand, getCopyOfTableWithoutRows(table))
 	}
 
 	filteredTable, err := engine.getFilteredTable(table, whereCommand, false, selectCommand.Name.GetToken().Literal)
@@ -458,7 +948,11 @@ func (engine *DbEngine) getSortedTable(orderByCommand *ast.OrderByCommand, table
 
 	columnNames := make([]string, 0)
 	for _, sortPattern := range sortPatterns {
-		columnNames = append(columnNames, sortPattern.ColumnName.Literal)
+		if sortPattern.Function != nil {
+			columnNames = append(columnNames, getIdentifierNames(sortPattern.Function.GetIdentifiers())...)
+		} else {
+			columnNames = append(columnNames, sortPattern.ColumnName.Literal)
+		}
 	}
 
 	missingColName := engine.getMissingColumnName(columnNames, table)
@@ -471,26 +965,36 @@ func (engine *DbEngine) getSortedTable(orderByCommand *ast.OrderByCommand, table
 
 	rows := MapTableToRows(table).rows
 
-	sort.Slice(rows, func(i, j int) bool {
-		howDeepWeSort := 0
-		sortingType := sortPatterns[howDeepWeSort].Order.Type
-		columnToSort := sortPatterns[howDeepWeSort].ColumnName.Literal
-
-		for rows[i][columnToSort].IsEqual(rows[j][columnToSort]) {
-			howDeepWeSort++
-			sortingType = sortPatterns[howDeepWeSort].Order.Type
-
-			if howDeepWeSort >= len(orderByCommand.SortPatterns) {
-				return true
+	// Values of functions are stored in rows under the text of function call, so they are calculated only once
+	for _, sortPattern := range sortPatterns {
+		if sortPattern.Function == nil {
+			continue
+		}
+		for _, row := range rows {
+			value, err := engine.callScalarFunction(*sortPattern.Function, row)
+			if err != nil {
+				return nil, err
 			}
-			columnToSort = sortPatterns[howDeepWeSort].ColumnName.Literal
+			row[sortPattern.ColumnName.Literal] = value
 		}
-
-		if sortingType == token.DESC {
-			return rows[i][columnToSort].isGreaterThan(rows[j][columnToSort])
+		err := validateSortedValues(rows, sortPattern.ColumnName.Literal, orderByCommand.Token.Literal)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return rows[i][columnToSort].isSmallerThan(rows[j][columnToSort])
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, sortPattern := range sortPatterns {
+			columnToSort := sortPattern.ColumnName.Literal
+			if rows[i][columnToSort].IsEqual(rows[j][columnToSort]) {
+				continue
+			}
+			if sortPattern.Order.Type == token.DESC {
+				return rows[i][columnToSort].isGreaterThan(rows[j][columnToSort])
+			}
+			return rows[i][columnToSort].isSmallerThan(rows[j][columnToSort])
+		}
+		return false
 	})
 
 	for _, row := range rows {
@@ -502,6 +1006,26 @@ func (engine *DbEngine) getSortedTable(orderByCommand *ast.OrderByCommand, table
 	return copyOfTable, nil
 }
 
+// validateSortedValues - Return error if values calculated by function for sorting can't be compared with each
+// other, ex. ->> operator returns values of different types for different rows
+func validateSortedValues(rows []map[string]ValueInterface, columnName string, commandName string) error {
+	var firstValue ValueInterface
+	for _, row := range rows {
+		value := row[columnName]
+		if value.GetType() == NullType {
+			continue
+		}
+		if firstValue == nil {
+			firstValue = value
+			continue
+		}
+		if !areComparable(firstValue, value) {
+			return &IncomparableValuesError{leftType: getTypeName(firstValue), rightType: getTypeName(value), commandName: commandName}
+		}
+	}
+	return nil
+}
+
 func (engine *DbEngine) getMissingColumnName(columnNames []string, table *Table) string {
 	for _, columnName := range columnNames {
 		exists := false
@@ -521,18 +1045,20 @@ func (engine *DbEngine) getMissingColumnName(columnNames []string, table *Table)
 func (engine *DbEngine) getFilteredTable(table *Table, whereCommand *ast.WhereCommand, negation bool, tableName string) (*Table, error) {
 	filteredTable := getCopyOfTableWithoutRows(table)
 
-	identifiers := whereCommand.Expression.GetIdentifiers()
-	columnNames := make([]string, 0)
-	for _, identifier := range identifiers {
-		columnNames = append(columnNames, identifier.Token.Literal)
-	}
+	columnNames := getIdentifierNames(whereCommand.Expression.GetIdentifiers())
 	missingColumnName := engine.getMissingColumnName(columnNames, table)
 	if missingColumnName != "" {
 		return nil, &ColumnDoesNotExistError{tableName: tableName, columnName: missingColumnName}
 	}
 
-	for _, row := range MapTableToRows(table).rows {
-		fulfilledFilters, err := isFulfillingFilters(row, whereCommand.Expression, whereCommand.Token.Literal)
+	// Rows found in indexes are the only ones which can fulfill the condition, but negated condition needs all rows
+	rowIndexes := engine.getCandidateRows(table, nil)
+	if !negation {
+		rowIndexes = engine.getCandidateRows(table, whereCommand.Expression)
+	}
+	for _, rowIndex := range rowIndexes {
+		row := getRow(table, rowIndex)
+		fulfilledFilters, err := engine.isFulfillingFilters(row, whereCommand.Expression, whereCommand.Token.Literal)
 		if err != nil {
 			return nil, err
 		}
@@ -578,7 +1104,7 @@ func (engine *DbEngine) joinTables(joinCommand *ast.JoinCommand, leftTableName s
 			joinedRowRight := getRow(rightTableWithAddedPrefix, rightRowIndex)
 			maps.Copy(joinedRowRight, joinedRowLeft)
 
-			fulfilledFilters, err := isFulfillingFilters(joinedRowRight, joinCommand.Expression, joinCommand.Token.Literal)
+			fulfilledFilters, err := engine.isFulfillingFilters(joinedRowRight, joinCommand.Expression, joinCommand.Token.Literal)
 			if err != nil {
 				return nil, err
 			}
@@ -616,9 +1142,12 @@ func addColumnsWithPrefix(finalTable *Table, columnsToAdd []*Column, prefix stri
 	for _, column := range columnsToAdd {
 		finalTable.Columns = append(finalTable.Columns,
 			&Column{
-				Type:   column.Type,
-				Values: make([]ValueInterface, 0),
-				Name:   prefix + column.Name,
+				Type:           column.Type,
+				TypeParameters: column.TypeParameters,
+				Enum:           column.Enum,
+				IsArray:        column.IsArray,
+				Values:         make([]ValueInterface, 0),
+				Name:           prefix + column.Name,
 			})
 	}
 }
@@ -661,111 +1190,271 @@ func getCopyOfTableWithoutRows(table *Table) *Table {
 	for _, column := range table.Columns {
 		filteredTable.Columns = append(filteredTable.Columns,
 			&Column{
-				Type:   column.Type,
-				Values: make([]ValueInterface, 0),
-				Name:   column.Name,
+				Type:           column.Type,
+				TypeParameters: column.TypeParameters,
+				Enum:           column.Enum,
+				IsArray:        column.IsArray,
+				Values:         make([]ValueInterface, 0),
+				Name:           column.Name,
 			})
 	}
 	return filteredTable
 }
 
-func isFulfillingFilters(row map[string]ValueInterface, expressionTree ast.Expression, commandName string) (bool, error) {
+// isFulfillingFilters - Return true only if expression evaluated for the row is TRUE (neither FALSE nor UNKNOWN)
+func (engine *DbEngine) isFulfillingFilters(row map[string]ValueInterface, expressionTree ast.Expression, commandName string) (bool, error) {
+	result, err := engine.evaluateExpression(row, expressionTree, commandName)
+	if err != nil {
+		return false, err
+	}
+	return result == logicalTrue, nil
+}
+
+func (engine *DbEngine) evaluateExpression(row map[string]ValueInterface, expressionTree ast.Expression, commandName string) (logicalValue, error) {
 	switch mappedExpression := expressionTree.(type) {
 	case *ast.OperationExpression:
-		return processOperationExpression(row, mappedExpression, commandName)
+		return engine.processOperationExpression(row, mappedExpression, commandName)
 	case *ast.BooleanExpression:
 		return processBooleanExpression(mappedExpression)
+	case *ast.PredicateExpression:
+		return engine.processPredicateExpression(row, mappedExpression, commandName)
 	case *ast.ConditionExpression:
-		return processConditionExpression(row, mappedExpression, commandName)
+		return engine.processConditionExpression(row, mappedExpression, commandName)
 	case *ast.ContainExpression:
-		return processContainExpression(row, mappedExpression)
+		return engine.processContainExpression(row, mappedExpression)
 
 	default:
-		return false, &UnsupportedExpressionTypeError{commandName: commandName, variable: fmt.Sprintf("%s", mappedExpression)}
+		return logicalFalse, &UnsupportedExpressionTypeError{commandName: commandName, variable: fmt.Sprintf("%s", mappedExpression)}
 	}
 }
 
-func processConditionExpression(row map[string]ValueInterface, conditionExpression *ast.ConditionExpression, commandName string) (bool, error) {
-	valueLeft, err := getTifierValue(conditionExpression.Left, row)
+func (engine *DbEngine) processConditionExpression(row map[string]ValueInterface, conditionExpression *ast.ConditionExpression, commandName string) (logicalValue, error) {
+	valueLeft, err := engine.getTifierValue(conditionExpression.Left, row)
 	if err != nil {
-		return false, err
+		return logicalFalse, err
 	}
 
-	valueRight, err := getTifierValue(conditionExpression.Right, row)
+	valueRight, err := engine.getTifierValue(conditionExpression.Right, row)
 	if err != nil {
-		return false, err
+		return logicalFalse, err
 	}
 
-	switch conditionExpression.Condition.Type {
+	if conditionExpression.Quantifier != nil {
+		return engine.processQuantifiedCondition(valueLeft, valueRight, conditionExpression, commandName)
+	}
+	return engine.compareWithCondition(valueLeft, valueRight, conditionExpression.Condition, commandName)
+}
+
+// processQuantifiedCondition - Return result of condition with ANY or ALL, ANY is TRUE when condition is fulfilled by
+// any element of array and ALL when it's fulfilled by all of them, so ANY of empty array is FALSE and ALL is TRUE
+func (engine *DbEngine) processQuantifiedCondition(valueLeft ValueInterface, valueRight ValueInterface, conditionExpression *ast.ConditionExpression, commandName string) (logicalValue, error) {
+	if valueRight.GetType() == NullType {
+		if engine.StandardNullSemantics {
+			return logicalUnknown, nil
+		}
+		return logicalFalse, nil
+	}
+	array, err := getArrayArgument(conditionExpression.Quantifier.Literal, valueRight)
+	if err != nil {
+		return logicalFalse, err
+	}
+
+	isAny := conditionExpression.Quantifier.Type == token.ANY
+	result := toLogicalValue(!isAny)
+	for _, element := range array.Values {
+		elementResult, err := engine.compareWithCondition(valueLeft, element, conditionExpression.Condition, commandName)
+		if err != nil {
+			return logicalFalse, err
+		}
+		if isAny {
+			result = result.or(elementResult)
+		} else {
+			result = result.and(elementResult)
+		}
+	}
+	return result, nil
+}
+
+// compareWithCondition - Return result of comparison of two values with EQUAL, NOT or comparison operator
+func (engine *DbEngine) compareWithCondition(valueLeft ValueInterface, valueRight ValueInterface, condition token.Token, commandName string) (logicalValue, error) {
+	if engine.StandardNullSemantics && (valueLeft.GetType() == NullType || valueRight.GetType() == NullType) {
+		return logicalUnknown, nil
+	}
+
+	valueLeft, valueRight, err := coerceText(valueLeft, valueRight)
+	if err != nil {
+		return logicalFalse, err
+	}
+
+	switch condition.Type {
 	case token.EQUAL:
-		return valueLeft.IsEqual(valueRight), nil
+		return toLogicalValue(valueLeft.IsEqual(valueRight)), nil
 	case token.NOT:
-		return !(valueLeft.IsEqual(valueRight)), nil
+		return toLogicalValue(!(valueLeft.IsEqual(valueRight))), nil
+	case token.LT, token.GT, token.LTE, token.GTE:
+		return compareValues(valueLeft, valueRight, condition.Type, commandName)
 	default:
-		return false, &UnsupportedConditionalTokenError{variable: conditionExpression.Condition.Literal, commandName: commandName}
+		return logicalFalse, &UnsupportedConditionalTokenError{variable: condition.Literal, commandName: commandName}
+	}
+}
+
+// compareValues - Return result of <, >, <= or >= operator, NULL is never smaller nor greater than other value when
+// legacy NULL semantics are used
+func compareValues(valueLeft ValueInterface, valueRight ValueInterface, operator token.Type, commandName string) (logicalValue, error) {
+	if valueLeft.GetType() == NullType || valueRight.GetType() == NullType {
+		return logicalFalse, nil
+	}
+	if !areComparable(valueLeft, valueRight) {
+		return logicalFalse, &IncomparableValuesError{leftType: getTypeName(valueLeft), rightType: getTypeName(valueRight), commandName: commandName}
+	}
+
+	switch operator {
+	case token.LT:
+		return toLogicalValue(valueLeft.isSmallerThan(valueRight)), nil
+	case token.GT:
+		return toLogicalValue(valueLeft.isGreaterThan(valueRight)), nil
+	case token.LTE:
+		return toLogicalValue(!valueLeft.isGreaterThan(valueRight)), nil
+	default:
+		return toLogicalValue(!valueLeft.isSmallerThan(valueRight)), nil
+	}
+}
+
+// areComparable - Return true if values can be ordered, numbers can be compared regardless of their type, DATE
+// can be compared with TIMESTAMP and ENUM values only with values of the same ENUM type
+func areComparable(first ValueInterface, second ValueInterface) bool {
+	if isNumeric(first) && isNumeric(second) {
+		return true
+	}
+	if isTemporal(first) && isTemporal(second) {
+		_, isComparable := compareTemporals(first, second)
+		return isComparable
+	}
+	if first.GetType() == EnumType && second.GetType() == EnumType {
+		return first.(EnumValue).Enum == second.(EnumValue).Enum
+	}
+	return first.GetType() == second.GetType()
+}
+
+// coerceText - Return values with text converted to the type of the other value if it's DATE, TIME, TIMESTAMP,
+// INTERVAL, UUID or ENUM, so column can be compared with literal like '2024-01-31'
+func coerceText(first ValueInterface, second ValueInterface) (ValueInterface, ValueInterface, error) {
+	var err error
+	if first.GetType() == StringType && second.GetType() != StringType {
+		second, first, err = coerceText(second, first)
+		return first, second, err
+	}
+	if second.GetType() != StringType {
+		return first, second, nil
 	}
+
+	switch {
+	case isTemporal(first):
+		second, err = parseTemporal(second.ToString(), getTypeName(first))
+	case first.GetType() == UuidType:
+		second, err = parseUuid(second.ToString())
+	case first.GetType() == EnumType:
+		second, err = first.(EnumValue).Enum.getValue(second.ToString())
+	}
+	return first, second, err
 }
 
-func processContainExpression(row map[string]ValueInterface, containExpression *ast.ContainExpression) (bool, error) {
-	valueLeft, err := getTifierValue(containExpression.Left, row)
+func (engine *DbEngine) processContainExpression(row map[string]ValueInterface, containExpression *ast.ContainExpression) (logicalValue, error) {
+	valueLeft, err := engine.getTifierValue(containExpression.Left, row)
 	if err != nil {
-		return false, err
+		return logicalFalse, err
 	}
 
-	result, err := ifValueInterfaceInArray(containExpression.Right, valueLeft)
+	result, err := engine.ifValueInterfaceInArray(containExpression.Right, valueLeft)
 
 	if containExpression.Contains {
 		return result, err
 	}
 
-	return !result, err
+	return result.not(), err
 }
 
-func ifValueInterfaceInArray(array []ast.Anonymitifier, valueLeft ValueInterface) (bool, error) {
+// ifValueInterfaceInArray - Check if value is on the list, with standard NULL semantics the result is UNKNOWN
+// when value wasn't found, but either value itself or any element of the list is NULL
+func (engine *DbEngine) ifValueInterfaceInArray(array []ast.Anonymitifier, valueLeft ValueInterface) (logicalValue, error) {
+	if engine.StandardNullSemantics && valueLeft.GetType() == NullType {
+		return logicalUnknown, nil
+	}
+
+	result := logicalFalse
 	for _, expectedValue := range array {
 		value, err := getInterfaceValue(expectedValue.Token)
 		if err != nil {
-			return false, err
+			return logicalFalse, err
+		}
+		if engine.StandardNullSemantics && value.GetType() == NullType {
+			result = logicalUnknown
+			continue
+		}
+		valueLeft, value, err = coerceText(valueLeft, value)
+		if err != nil {
+			return logicalFalse, err
 		}
 		if value.IsEqual(valueLeft) {
-			return true, nil
+			return logicalTrue, nil
 		}
 	}
-	return false, nil
+	return result, nil
 }
 
-func processOperationExpression(row map[string]ValueInterface, operationExpression *ast.OperationExpression, commandName string) (bool, error) {
+func (engine *DbEngine) processOperationExpression(row map[string]ValueInterface, operationExpression *ast.OperationExpression, commandName string) (logicalValue, error) {
 	if operationExpression.Operation.Type == token.AND {
-		left, err := isFulfillingFilters(row, operationExpression.Left, commandName)
-		if !left {
+		left, err := engine.evaluateExpression(row, operationExpression.Left, commandName)
+		if left == logicalFalse {
 			return left, err
 		}
-		right, err := isFulfillingFilters(row, operationExpression.Right, commandName)
+		right, err := engine.evaluateExpression(row, operationExpression.Right, commandName)
 
-		return left && right, err
+		return left.and(right), err
 	}
 
 	if operationExpression.Operation.Type == token.OR {
-		left, err := isFulfillingFilters(row, operationExpression.Left, commandName)
-		if left {
+		left, err := engine.evaluateExpression(row, operationExpression.Left, commandName)
+		if left == logicalTrue {
 			return left, err
 		}
-		right, err := isFulfillingFilters(row, operationExpression.Right, commandName)
+		right, err := engine.evaluateExpression(row, operationExpression.Right, commandName)
 
-		return left || right, err
+		return left.or(right), err
 	}
 
-	return false, &UnsupportedOperationTokenError{operationExpression.Operation.Literal}
+	return logicalFalse, &UnsupportedOperationTokenError{operationExpression.Operation.Literal}
 }
 
-func processBooleanExpression(booleanExpression *ast.BooleanExpression) (bool, error) {
+func processBooleanExpression(booleanExpression *ast.BooleanExpression) (logicalValue, error) {
 	if booleanExpression.Boolean.Literal == token.TRUE {
-		return true, nil
+		return logicalTrue, nil
+	}
+	return logicalFalse, nil
+}
+
+// processPredicateExpression - Return value of boolean column, NULL is UNKNOWN with standard NULL semantics and FALSE
+// otherwise
+func (engine *DbEngine) processPredicateExpression(row map[string]ValueInterface, predicateExpression *ast.PredicateExpression, commandName string) (logicalValue, error) {
+	value, err := engine.getTifierValue(predicateExpression.Value, row)
+	if err != nil {
+		return logicalFalse, err
+	}
+
+	switch mappedValue := value.(type) {
+	case BooleanValue:
+		return toLogicalValue(mappedValue.Value), nil
+	case NullValue:
+		if engine.StandardNullSemantics {
+			return logicalUnknown, nil
+		}
+		return logicalFalse, nil
+	default:
+		return logicalFalse, &InvalidValueTypeError{expectedType: token.BOOLEAN, actualType: getTypeName(value), commandName: commandName}
 	}
-	return false, nil
 }
 
-func getTifierValue(tifier ast.Tifier, row map[string]ValueInterface) (ValueInterface, error) {
+func (engine *DbEngine) getTifierValue(tifier ast.Tifier, row map[string]ValueInterface) (ValueInterface, error) {
 	switch mappedTifier := tifier.(type) {
 	case ast.Identifier:
 		value, ok := row[mappedTifier.GetToken().Literal]
@@ -775,6 +1464,8 @@ func getTifierValue(tifier ast.Tifier, row map[string]ValueInterface) (ValueInte
 		return value, nil
 	case ast.Anonymitifier:
 		return getInterfaceValue(mappedTifier.GetToken())
+	case ast.FunctionCall:
+		return engine.callScalarFunction(mappedTifier, row)
 	default:
 		return nil, &UnsupportedValueType{tifier.GetToken().Literal}
 	}
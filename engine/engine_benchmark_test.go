@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"strconv"
+	"testing"
+)
+
+const benchmarkRowsCount = 10000
+
+// getBenchmarkEngine - Return engine with table of benchmarkRowsCount rows and optional indexes on its columns
+func getBenchmarkEngine(b *testing.B, indexes string) *DbEngine {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( id INT, name TEXT, price INT );" + indexes))
+	if err != nil {
+		b.Fatalf("Unexpected error: %s", err)
+	}
+	for i := 0; i < benchmarkRowsCount; i++ {
+		id := strconv.Itoa(i)
+		_, err = engine.Evaluate(getSequences("INSERT INTO tbl VALUES( " + id + ", 'name" + id + "', " + strconv.Itoa(i%100) + " );"))
+		if err != nil {
+			b.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	return engine
+}
+
+func runBenchmarkQuery(b *testing.B, engine *DbEngine, query string) {
+	sequences := getSequences(query)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := engine.Evaluate(sequences)
+		if err != nil {
+			b.Fatalf("Unexpected error: %s", err)
+		}
+	}
+}
+
+func BenchmarkSelectEqualWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "SELECT * FROM tbl WHERE name EQUAL 'name5000';")
+}
+
+func BenchmarkSelectEqualWithHashIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl USING HASH (name);"), "SELECT * FROM tbl WHERE name EQUAL 'name5000';")
+}
+
+func BenchmarkSelectInWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "SELECT * FROM tbl WHERE id IN (10, 500, 9000);")
+}
+
+func BenchmarkSelectInWithBTreeIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl (id);"), "SELECT * FROM tbl WHERE id IN (10, 500, 9000);")
+}
+
+func BenchmarkSelectRangeWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "SELECT * FROM tbl WHERE id >= 100 AND id < 200;")
+}
+
+func BenchmarkSelectRangeWithBTreeIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl (id);"), "SELECT * FROM tbl WHERE id >= 100 AND id < 200;")
+}
+
+func BenchmarkUpdateWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "UPDATE tbl SET price TO 7 WHERE name EQUAL 'name5000';")
+}
+
+func BenchmarkUpdateWithHashIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl USING HASH (name);"), "UPDATE tbl SET price TO 7 WHERE name EQUAL 'name5000';")
+}
+
+func BenchmarkSelectOrderByWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "SELECT * FROM tbl WHERE price EQUAL 7 ORDER BY id DESC;")
+}
+
+func BenchmarkSelectOrderByWithCompositeIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl (price, id);"), "SELECT * FROM tbl WHERE price EQUAL 7 ORDER BY id DESC;")
+}
+
+func BenchmarkSelectCoveredColumnsWithoutIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, ""), "SELECT id, name FROM tbl WHERE price EQUAL 7 AND id < 5000;")
+}
+
+func BenchmarkSelectCoveredColumnsWithCoveringIndex(b *testing.B) {
+	runBenchmarkQuery(b, getBenchmarkEngine(b, "CREATE INDEX ON tbl (price, id) INCLUDE (name);"), "SELECT id, name FROM tbl WHERE price EQUAL 7 AND id < 5000;")
+}
@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"slices"
+
+	"github.com/LissaGreense/GO4SQL/ast"
 	"github.com/LissaGreense/GO4SQL/lexer"
 	"github.com/LissaGreense/GO4SQL/parser"
 	"github.com/LissaGreense/GO4SQL/token"
@@ -22,6 +25,18 @@ func TestEngineCreateCommandErrorHandling(t *testing.T) {
 	runEngineErrorHandlingSuite(t, tests)
 }
 
+func TestEngineDropCommandErrorHandling(t *testing.T) {
+	missingTable := TableDoesNotExistError{"table1"}
+
+	tests := []errorHandlingTestSuite{
+		{"DROP TABLE table1;", missingTable.Error()},
+		{"CREATE TABLE table1( one INT ); DROP TABLE table1; DROP TABLE table1;", missingTable.Error()},
+		{"DROP TABLE IF EXISTS table1; INSERT INTO table1 VALUES( 1 );", missingTable.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
 func TestEngineInsertCommandErrorHandling(t *testing.T) {
 	tableDoNotExistError := TableDoesNotExistError{"table1"}
 	invalidNumberOfParametersError := InvalidNumberOfParametersError{expectedNumber: 2, actualNumber: 1, commandName: token.INSERT}
@@ -35,6 +50,20 @@ func TestEngineInsertCommandErrorHandling(t *testing.T) {
 	runEngineErrorHandlingSuite(t, tests)
 }
 
+func TestEngineInsertExpressionErrorHandling(t *testing.T) {
+	columnInValues := ColumnDoesNotExistError{tableName: "tbl", columnName: "one"}
+	identityAlways := IdentityColumnValueError{columnName: "id", commandName: token.INSERT}
+	missingFunction := FunctionDoesNotExistError{functionName: "REVERSE"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl( one INT ); INSERT INTO tbl VALUES( one + 1 );", columnInValues.Error()},
+		{"CREATE TABLE tbl( id INT GENERATED ALWAYS AS IDENTITY ); INSERT INTO tbl VALUES( 1 + 1 );", identityAlways.Error()},
+		{"CREATE TABLE tbl( one TEXT ); INSERT INTO tbl VALUES( REVERSE('a') );", missingFunction.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
 func TestEngineSelectCommandErrorHandling(t *testing.T) {
 	noTableDoesNotExist := TableDoesNotExistError{"tb1"}
 	columnDoesNotExist := ColumnDoesNotExistError{tableName: "tbl", columnName: "two"}
@@ -57,6 +86,21 @@ func TestEngineDeleteCommandErrorHandling(t *testing.T) {
 	runEngineErrorHandlingSuite(t, tests)
 }
 
+func TestEngineTruncateCommandErrorHandling(t *testing.T) {
+	tables := "CREATE TABLE users( id INT PRIMARY KEY ); CREATE TABLE orders( user_id INT REFERENCES users ); INSERT INTO users VALUES( 1 );"
+	missingTable := TableDoesNotExistError{"clients"}
+	referencedTable := TruncatedTableReferencedError{tableName: "users", constraintName: "orders_user_id_fkey", referencingTable: "orders"}
+
+	tests := []errorHandlingTestSuite{
+		{tables + "TRUNCATE TABLE clients;", missingTable.Error()},
+		{tables + "TRUNCATE TABLE users, clients;", missingTable.Error()},
+		{tables + "TRUNCATE TABLE users;", referencedTable.Error()},
+		{tables + "TRUNCATE TABLE orders; TRUNCATE TABLE users;", referencedTable.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
 func TestEngineWhereCommandErrorHandling(t *testing.T) {
 	columnDoesNotExist := ColumnDoesNotExistError{tableName: "tbl", columnName: "two"}
 
@@ -103,6 +147,681 @@ func TestEngineFullJoinErrorHandling(t *testing.T) {
 	runEngineErrorHandlingSuite(t, tests)
 }
 
+func TestEngineScalarFunctionErrorHandling(t *testing.T) {
+	functionDoesNotExist := FunctionDoesNotExistError{functionName: "REVERSE"}
+	tooFewArguments := InvalidNumberOfFunctionArgumentsError{functionName: "SUBSTR", minNumber: 2, maxNumber: 3, actualNumber: 1}
+	tooManyArguments := InvalidNumberOfFunctionArgumentsError{functionName: "UPPER", minNumber: 1, maxNumber: 1, actualNumber: 2}
+	noArguments := InvalidNumberOfFunctionArgumentsError{functionName: "CONCAT", minNumber: 1, maxNumber: variadicArguments, actualNumber: 0}
+	invalidArgument := InvalidFunctionArgumentError{functionName: "SUBSTR", expectedType: "INT", actualValue: "hello"}
+	invalidPart := InvalidFunctionArgumentError{functionName: "SPLIT_PART", expectedType: "non-zero INT", actualValue: "0"}
+	tooLongPadding := InvalidFunctionArgumentError{functionName: "LPAD", expectedType: "length not greater than 10485760", actualValue: "2147483647"}
+	paddingAboveLimit := InvalidFunctionArgumentError{functionName: "RPAD", expectedType: "length not greater than 10485760", actualValue: "10485761"}
+	columnDoesNotExist := ColumnDoesNotExistError{tableName: "tbl", columnName: "two"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT REVERSE(one) FROM tbl;", functionDoesNotExist.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT SUBSTR(one) FROM tbl;", tooFewArguments.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT * FROM tbl WHERE UPPER(one, one) EQUAL 'A';", tooManyArguments.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT * FROM tbl ORDER BY CONCAT() ASC;", noArguments.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); UPDATE tbl SET one TO SUBSTR(one, one);", invalidArgument.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT SPLIT_PART(one, 'l', 0) FROM tbl;", invalidPart.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT LPAD(one, 2147483647, 'x') FROM tbl;", tooLongPadding.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT RPAD(one, 10485761) FROM tbl;", paddingAboveLimit.Error()},
+		{"CREATE TABLE tbl(one TEXT); SELECT UPPER(two) FROM tbl;", columnDoesNotExist.Error()},
+		{"CREATE TABLE tbl(one TEXT); UPDATE tbl SET one TO LOWER(two);", columnDoesNotExist.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineNullFunctionErrorHandling(t *testing.T) {
+	table := "CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); INSERT INTO tbl VALUES(NULL);"
+	mixedCoalesce := InvalidFunctionArgumentError{functionName: "COALESCE", expectedType: "INT", actualValue: "none"}
+	mixedIfNull := InvalidFunctionArgumentError{functionName: "IFNULL", expectedType: "INT", actualValue: "none"}
+	mixedSortedValues := IncomparableValuesError{leftType: "INT", rightType: "TEXT", commandName: "ORDER"}
+
+	tests := []errorHandlingTestSuite{
+		{table + "SELECT COALESCE(one, 'none') FROM tbl ORDER BY COALESCE(one, 'none') ASC;", mixedCoalesce.Error()},
+		{table + "SELECT * FROM tbl ORDER BY IFNULL(one, 'none') DESC;", mixedIfNull.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); INSERT INTO tbl VALUES(1, NULL); INSERT INTO tbl VALUES(NULL, 'a');" +
+			"SELECT * FROM tbl ORDER BY COALESCE(one, two) ASC;", mixedSortedValues.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineNumericFunctionErrorHandling(t *testing.T) {
+	absOverflow := IntegerOverflowError{operation: "ABS"}
+	powerOverflow := IntegerOverflowError{operation: "POWER"}
+	roundOverflow := IntegerOverflowError{operation: "ROUND"}
+	divisionByZero := DivisionByZeroError{operation: "MOD"}
+	zeroToNegativePower := DivisionByZeroError{operation: "POWER"}
+	fractionalExponent := InvalidFunctionArgumentError{functionName: "POWER", expectedType: "INT exponent for negative base", actualValue: "0.5"}
+	powerFloatOverflow := FloatOverflowError{operation: "POWER"}
+	powerDecimalOverflow := DecimalOverflowError{operation: "POWER"}
+	tooManyDigits := InvalidFunctionArgumentError{functionName: "ROUND", expectedType: "digits between -1000 and 1000", actualValue: "1000000000"}
+	tooFewDigits := InvalidFunctionArgumentError{functionName: "ROUND", expectedType: "digits between -1000 and 1000", actualValue: "-1000000000"}
+	negativeSquareRoot := InvalidFunctionArgumentError{functionName: "SQRT", expectedType: "non-negative NUMERIC", actualValue: "-4"}
+	textArgument := InvalidFunctionArgumentError{functionName: "ABS", expectedType: "NUMERIC", actualValue: "hello"}
+	mixedTypes := InvalidFunctionArgumentError{functionName: "GREATEST", expectedType: "INT", actualValue: "hello"}
+	randomWithArgument := InvalidNumberOfFunctionArgumentsError{functionName: "RANDOM", minNumber: 0, maxNumber: 0, actualNumber: 1}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(-9223372036854775808); SELECT ABS(one) FROM tbl;", absOverflow.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(2); SELECT POWER(one, 63) FROM tbl;", powerOverflow.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775807); SELECT ROUND(one, -1) FROM tbl;", roundOverflow.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(2); SELECT * FROM tbl WHERE MOD(one, 0) EQUAL 1;", divisionByZero.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(-1); UPDATE tbl SET one TO POWER(0, one);", zeroToNegativePower.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(-4); SELECT POWER(one, 0.5) FROM tbl;", fractionalExponent.Error()},
+		{"CREATE TABLE tbl(one FLOAT); INSERT INTO tbl VALUES(1e300); SELECT POWER(one, 2) FROM tbl;", powerFloatOverflow.Error()},
+		{"CREATE TABLE tbl(one DECIMAL(10, 2)); INSERT INTO tbl VALUES(1.5); SELECT POWER(one, 1000000) FROM tbl;", powerDecimalOverflow.Error()},
+		{"CREATE TABLE tbl(one DECIMAL); INSERT INTO tbl VALUES(12); SELECT POWER(one, 1000000) FROM tbl;", powerDecimalOverflow.Error()},
+		{"CREATE TABLE tbl(one DECIMAL(10, 2)); INSERT INTO tbl VALUES(1.5); SELECT ROUND(one, 1000000000) FROM tbl;", tooManyDigits.Error()},
+		{"CREATE TABLE tbl(one DECIMAL(10, 2)); INSERT INTO tbl VALUES(1.5); SELECT ROUND(one, -1000000000) FROM tbl;", tooFewDigits.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(-4); SELECT SQRT(one) FROM tbl;", negativeSquareRoot.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT ABS(one) FROM tbl;", textArgument.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT GREATEST(1, one) FROM tbl;", mixedTypes.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT RANDOM(one) FROM tbl;", randomWithArgument.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineTypeConversionErrorHandling(t *testing.T) {
+	invalidNumber := InvalidCastError{value: "12a", targetType: token.INT}
+	outOfRange := InvalidCastError{value: "99999999999999999999", targetType: token.INT}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('12a'); SELECT CAST(one AS INT) FROM tbl;", invalidNumber.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('12a'); SELECT * FROM tbl WHERE one::INT EQUAL 12;", invalidNumber.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('99999999999999999999'); SELECT one::INT FROM tbl;", outOfRange.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineNumericTypesErrorHandling(t *testing.T) {
+	decimalIntoInt := InvalidValueTypeError{expectedType: token.INT, actualType: token.DECIMAL, commandName: token.INSERT}
+	floatIntoInt := InvalidValueTypeError{expectedType: token.INT, actualType: token.FLOAT, commandName: token.UPDATE}
+	decimalOverflow := NumericFieldOverflowError{columnName: "price", precision: 5, scale: 2}
+	integerOverflow := IntegerOverflowError{operation: "*"}
+	floatOverflow := FloatOverflowError{operation: "*"}
+	integerDivisionByZero := DivisionByZeroError{operation: "/"}
+	decimalDivisionByZero := DivisionByZeroError{operation: "/"}
+	textOperand := InvalidFunctionArgumentError{functionName: "+", expectedType: "NUMERIC", actualValue: "hello"}
+	sumOverflow := IntegerOverflowError{operation: "SUM"}
+	literalOutOfRange := InvalidNumericLiteralError{literal: "1e400", outOfRange: true}
+	invalidLiteral := InvalidNumericLiteralError{literal: "1.2.3"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1.5);", decimalIntoInt.Error()},
+		{"CREATE TABLE tbl(one FLOAT); INSERT INTO tbl VALUES(1e400);", literalOutOfRange.Error()},
+		{"CREATE TABLE tbl(one DECIMAL); INSERT INTO tbl VALUES(1); SELECT one FROM tbl WHERE one EQUAL 1.2.3;", invalidLiteral.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); UPDATE tbl SET one TO one * 1e2;", floatIntoInt.Error()},
+		{"CREATE TABLE tbl(price DECIMAL(5, 2)); INSERT INTO tbl VALUES(1000);", decimalOverflow.Error()},
+		{"CREATE TABLE tbl(price DECIMAL(5, 2)); INSERT INTO tbl VALUES(999.995);", decimalOverflow.Error()},
+		{"CREATE TABLE tbl(price DECIMAL(5, 2)); INSERT INTO tbl VALUES(1); UPDATE tbl SET price TO price * 1000;", decimalOverflow.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775807); SELECT one * 2 FROM tbl;", integerOverflow.Error()},
+		{"CREATE TABLE tbl(one FLOAT); INSERT INTO tbl VALUES(1e308); SELECT one * 10 FROM tbl;", floatOverflow.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(0); SELECT one FROM tbl WHERE 1 / one EQUAL 1;", integerDivisionByZero.Error()},
+		{"CREATE TABLE tbl(one DECIMAL); INSERT INTO tbl VALUES(0.00); UPDATE tbl SET one TO 1.5 / one;", decimalDivisionByZero.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT one + 1 FROM tbl;", textOperand.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775807); INSERT INTO tbl VALUES(1); SELECT SUM(one) FROM tbl;", sumOverflow.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineBooleanTypeErrorHandling(t *testing.T) {
+	integerIntoBoolean := InvalidValueTypeError{expectedType: token.BOOLEAN, actualType: token.LITERAL, commandName: token.INSERT}
+	booleanIntoText := InvalidValueTypeError{expectedType: token.IDENT, actualType: token.TRUE, commandName: token.INSERT}
+	textPredicate := InvalidValueTypeError{expectedType: token.BOOLEAN, actualType: token.TEXT, commandName: token.WHERE}
+	integerBoolAnd := InvalidFunctionArgumentError{functionName: token.BOOL_AND, expectedType: token.BOOLEAN, actualValue: "1"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one BOOLEAN); INSERT INTO tbl VALUES(1);", integerIntoBoolean.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES(TRUE);", booleanIntoText.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('hello'); SELECT * FROM tbl WHERE one;", textPredicate.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT BOOL_AND(one) FROM tbl;", integerBoolAnd.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineTemporalTypesErrorHandling(t *testing.T) {
+	invalidDate := InvalidTemporalValueError{value: "2024-02-30", typeName: token.DATE}
+	invalidDateFormat := InvalidTemporalValueError{value: "31.01.2024", typeName: token.DATE}
+	invalidInterval := InvalidTemporalValueError{value: "1 fortnight", typeName: token.INTERVAL}
+	integerIntoDate := InvalidValueTypeError{expectedType: token.IDENT, actualType: token.LITERAL, commandName: token.INSERT}
+	integerUpdateOfDate := InvalidValueTypeError{expectedType: token.DATE, actualType: token.INT, commandName: token.UPDATE}
+	textComparedWithInteger := IncomparableValuesError{leftType: token.TEXT, rightType: token.INT, commandName: token.WHERE}
+	dateComparedWithTime := IncomparableValuesError{leftType: token.DATE, rightType: token.TIME, commandName: token.WHERE}
+	addedDates := UnsupportedOperandTypesError{operator: token.PLUS, types: []string{token.DATE, token.DATE}}
+	negatedTimestamp := UnsupportedOperandTypesError{operator: token.MINUS, types: []string{token.TIMESTAMP}}
+	invalidTruncUnit := InvalidFunctionArgumentError{functionName: "DATE_TRUNC", expectedType: "unit of time", actualValue: "fortnight"}
+	invalidExtractField := InvalidFunctionArgumentError{functionName: token.EXTRACT, expectedType: "unit of time", actualValue: "dow"}
+	extractFromInteger := InvalidFunctionArgumentError{functionName: token.EXTRACT, expectedType: "DATE, TIME, TIMESTAMP or INTERVAL", actualValue: "1"}
+	dateAddWithoutInterval := InvalidFunctionArgumentError{functionName: "DATE_ADD", expectedType: token.INTERVAL, actualValue: "1"}
+	invalidCast := InvalidCastError{value: "2024-01-31", targetType: token.TIME}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-02-30');", invalidDate.Error()},
+		{"CREATE TABLE tbl(one INTERVAL); INSERT INTO tbl VALUES('1 fortnight');", invalidInterval.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES(20240131);", integerIntoDate.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); UPDATE tbl SET one TO 5;", integerUpdateOfDate.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); SELECT * FROM tbl WHERE one EQUAL '31.01.2024';", invalidDateFormat.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('a'); SELECT * FROM tbl WHERE one > 5;", textComparedWithInteger.Error()},
+		{"CREATE TABLE tbl(one DATE, two TIME); INSERT INTO tbl VALUES('2024-01-31', '10:00'); SELECT * FROM tbl WHERE one < two;", dateComparedWithTime.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); SELECT one + one FROM tbl;", addedDates.Error()},
+		{"CREATE TABLE tbl(one TIMESTAMP); INSERT INTO tbl VALUES('2024-01-31'); SELECT -one FROM tbl;", negatedTimestamp.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); SELECT DATE_TRUNC('fortnight', one) FROM tbl;", invalidTruncUnit.Error()},
+		{"CREATE TABLE tbl(one INTERVAL); INSERT INTO tbl VALUES('1 day'); SELECT EXTRACT(dow FROM one) FROM tbl;", invalidExtractField.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT EXTRACT(year FROM one) FROM tbl;", extractFromInteger.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); SELECT DATE_ADD(one, 1) FROM tbl;", dateAddWithoutInterval.Error()},
+		{"CREATE TABLE tbl(one DATE); INSERT INTO tbl VALUES('2024-01-31'); SELECT one::TIME FROM tbl;", invalidCast.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineIntegerTypesErrorHandling(t *testing.T) {
+	smallintOutOfRange := ValueOutOfRangeError{value: "32768", columnName: "one", columnType: token.SMALLINT}
+	intOutOfRange := ValueOutOfRangeError{value: "-2147483649", columnName: "one", columnType: token.INT}
+	bigintOutOfRange := ValueOutOfRangeError{value: "9223372036854775808", columnName: "one", columnType: token.BIGINT}
+	updateOutOfRange := ValueOutOfRangeError{value: "40000", columnName: "one", columnType: token.SMALLINT}
+	decimalIntoSmallint := InvalidValueTypeError{expectedType: token.SMALLINT, actualType: token.DECIMAL, commandName: token.INSERT}
+	invalidSmallintCast := InvalidCastError{value: "100000", targetType: token.SMALLINT}
+	bigintOverflow := IntegerOverflowError{operation: "+"}
+	sumOverflow := IntegerOverflowError{operation: "SUM"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one SMALLINT); INSERT INTO tbl VALUES(32768);", smallintOutOfRange.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(-2147483649);", intOutOfRange.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775808);", bigintOutOfRange.Error()},
+		{"CREATE TABLE tbl(one SMALLINT); INSERT INTO tbl VALUES(20000); UPDATE tbl SET one TO one * 2;", updateOutOfRange.Error()},
+		{"CREATE TABLE tbl(one SMALLINT); INSERT INTO tbl VALUES(1.5);", decimalIntoSmallint.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(100000); SELECT one::SMALLINT FROM tbl;", invalidSmallintCast.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775807); SELECT one + 1 FROM tbl;", bigintOverflow.Error()},
+		{"CREATE TABLE tbl(one BIGINT); INSERT INTO tbl VALUES(9223372036854775000); INSERT INTO tbl VALUES(9223372036854775000); SELECT SUM(one) FROM tbl;", sumOverflow.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineCharacterTypesErrorHandling(t *testing.T) {
+	varcharTooLong := ValueTooLongError{length: 6, columnName: "name", columnType: token.VARCHAR, maxLength: 5}
+	charTooLong := ValueTooLongError{length: 3, columnName: "code", columnType: token.CHAR, maxLength: 2}
+	defaultCharTooLong := ValueTooLongError{length: 2, columnName: "code", columnType: token.CHAR, maxLength: 1}
+	updateTooLong := ValueTooLongError{length: 8, columnName: "name", columnType: token.VARCHAR, maxLength: 5}
+	integerIntoVarchar := InvalidValueTypeError{expectedType: token.IDENT, actualType: token.LITERAL, commandName: token.INSERT}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(name VARCHAR(5)); INSERT INTO tbl VALUES('Joanna');", varcharTooLong.Error()},
+		{"CREATE TABLE tbl(code CHAR(2)); INSERT INTO tbl VALUES('abc');", charTooLong.Error()},
+		{"CREATE TABLE tbl(code CHAR); INSERT INTO tbl VALUES('ab');", defaultCharTooLong.Error()},
+		{"CREATE TABLE tbl(name VARCHAR(5)); INSERT INTO tbl VALUES('Anna'); UPDATE tbl SET name TO name || 'name';", updateTooLong.Error()},
+		{"CREATE TABLE tbl(name VARCHAR(5)); INSERT INTO tbl VALUES(5);", integerIntoVarchar.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineBlobTypeErrorHandling(t *testing.T) {
+	oddNumberOfDigits := InvalidHexLiteralError{literal: "ABC"}
+	notHexadecimal := InvalidHexLiteralError{literal: "ZZ"}
+	textIntoBlob := InvalidValueTypeError{expectedType: token.HEX, actualType: token.IDENT, commandName: token.INSERT}
+	textUpdateOfBlob := InvalidValueTypeError{expectedType: token.BLOB, actualType: token.TEXT, commandName: token.UPDATE}
+	blobComparedWithText := IncomparableValuesError{leftType: token.BLOB, rightType: token.TEXT, commandName: token.WHERE}
+	upperOfBlob := InvalidFunctionArgumentError{functionName: "UPPER", expectedType: token.TEXT, actualValue: "\\xab"}
+	blobPadding := InvalidFunctionArgumentError{functionName: "LPAD", expectedType: token.TEXT, actualValue: "\\xab"}
+	textJoinedWithBlob := InvalidFunctionArgumentError{functionName: "CONCAT", expectedType: token.TEXT, actualValue: "\\xab"}
+	blobJoinedWithText := InvalidFunctionArgumentError{functionName: "||", expectedType: token.BLOB, actualValue: "ab"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'ABC');", oddNumberOfDigits.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'ZZ');", notHexadecimal.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES('ab');", textIntoBlob.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); UPDATE tbl SET one TO 'ab';", textUpdateOfBlob.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); SELECT * FROM tbl WHERE one > 'ab';", blobComparedWithText.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); SELECT UPPER(one) FROM tbl;", upperOfBlob.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); SELECT LPAD('a', 3, one) FROM tbl;", blobPadding.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); SELECT CONCAT(NULL, 'ab', one) FROM tbl;", textJoinedWithBlob.Error()},
+		{"CREATE TABLE tbl(one BLOB); INSERT INTO tbl VALUES(X'AB'); SELECT one || 'ab' FROM tbl;", blobJoinedWithText.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineJsonTypeErrorHandling(t *testing.T) {
+	invalidJson := InvalidJsonError{value: "{name: 1}"}
+	trailingCharacters := InvalidJsonError{value: "[1] 2"}
+	integerIntoJson := InvalidValueTypeError{expectedType: token.IDENT, actualType: token.LITERAL, commandName: token.INSERT}
+	integerUpdateOfJson := InvalidValueTypeError{expectedType: token.JSON, actualType: token.INT, commandName: token.UPDATE}
+	invalidPath := InvalidJsonPathError{path: "user.name"}
+	negativeIndexInPath := InvalidJsonPathError{path: "$.tags[-1]"}
+	arrayLengthOfObject := InvalidFunctionArgumentError{functionName: "JSON_ARRAY_LENGTH", expectedType: "JSON array", actualValue: `{"a":1}`}
+	arrowOnInteger := InvalidFunctionArgumentError{functionName: token.ARROW, expectedType: token.JSON, actualValue: "1"}
+	arrowWithBooleanKey := InvalidFunctionArgumentError{functionName: token.DOUBLE_ARROW, expectedType: "TEXT or INT", actualValue: token.TRUE}
+	invalidCast := InvalidCastError{value: "not json", targetType: token.JSON}
+	sortedNumberAndText := IncomparableValuesError{leftType: token.INT, rightType: token.TEXT, commandName: token.ORDER}
+	sortedBooleanAndNumber := IncomparableValuesError{leftType: token.BOOLEAN, rightType: token.DECIMAL, commandName: token.ORDER}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{name: 1}');", invalidJson.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('[1] 2');", trailingCharacters.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES(1);", integerIntoJson.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('1'); UPDATE tbl SET one TO 2;", integerUpdateOfJson.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{}'); SELECT JSON_EXTRACT(one, 'user.name') FROM tbl;", invalidPath.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{}'); SELECT JSON_EXTRACT(one, '$.tags[-1]') FROM tbl;", negativeIndexInPath.Error()},
+		{`CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{"a": 1}'); SELECT JSON_ARRAY_LENGTH(one) FROM tbl;`, arrayLengthOfObject.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT one->'a' FROM tbl;", arrowOnInteger.Error()},
+		{"CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{}'); SELECT one->>TRUE FROM tbl;", arrowWithBooleanKey.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('not json'); SELECT one::JSON FROM tbl;", invalidCast.Error()},
+		{`CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{"k": 1}'); INSERT INTO tbl VALUES('{"k": "x"}'); SELECT * FROM tbl ORDER BY one->>'k' ASC;`, sortedNumberAndText.Error()},
+		{`CREATE TABLE tbl(one JSON); INSERT INTO tbl VALUES('{"k": true}'); INSERT INTO tbl VALUES('{}'); INSERT INTO tbl VALUES('{"k": 1.5}'); SELECT * FROM tbl ORDER BY one->>'k' DESC;`, sortedBooleanAndNumber.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineUuidTypeErrorHandling(t *testing.T) {
+	tooShort := InvalidUuidError{value: "a0eebc99-9c0b-4ef8-bb6d"}
+	misplacedHyphens := InvalidUuidError{value: "a0eebc999-c0b-4ef8-bb6d-6bb9bd380a11"}
+	notHexadecimal := InvalidUuidError{value: "g0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"}
+	integerIntoUuid := InvalidValueTypeError{expectedType: token.IDENT, actualType: token.LITERAL, commandName: token.INSERT}
+	integerUpdateOfUuid := InvalidValueTypeError{expectedType: token.UUID, actualType: token.INT, commandName: token.UPDATE}
+	invalidCast := InvalidCastError{value: "1", targetType: token.UUID}
+	invalidComparison := InvalidUuidError{value: "abc"}
+	randomUuidWithArgument := InvalidNumberOfFunctionArgumentsError{functionName: "GEN_RANDOM_UUID", minNumber: 0, maxNumber: 0, actualNumber: 1}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES('a0eebc99-9c0b-4ef8-bb6d');", tooShort.Error()},
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES('a0eebc999-c0b-4ef8-bb6d-6bb9bd380a11');", misplacedHyphens.Error()},
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES('g0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11');", notHexadecimal.Error()},
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES(1);", integerIntoUuid.Error()},
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES('a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11'); UPDATE tbl SET one TO 2;", integerUpdateOfUuid.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT one::UUID FROM tbl;", invalidCast.Error()},
+		{"CREATE TABLE tbl(one UUID); INSERT INTO tbl VALUES('a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11'); SELECT * FROM tbl WHERE one EQUAL 'abc';", invalidComparison.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT GEN_RANDOM_UUID(one) FROM tbl;", randomUuidWithArgument.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineEnumTypeErrorHandling(t *testing.T) {
+	typeAlreadyExists := TypeAlreadyExistsError{typeName: "status"}
+	typeDoesNotExist := TypeDoesNotExistError{typeName: "status"}
+	duplicatedLabel := DuplicatedEnumLabelError{label: "new", typeName: "status"}
+	invalidInsert := InvalidEnumValueError{value: "deleted", typeName: "status"}
+	invalidUpdate := InvalidEnumValueError{value: "New", typeName: "status"}
+	invalidComparison := InvalidEnumValueError{value: "old", typeName: "status"}
+	integerUpdateOfEnum := InvalidValueTypeError{expectedType: "status", actualType: token.INT, commandName: token.UPDATE}
+	differentEnums := IncomparableValuesError{leftType: "first", rightType: "second", commandName: token.WHERE}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TYPE status AS ENUM ('new'); CREATE TYPE status AS ENUM ('old');", typeAlreadyExists.Error()},
+		{"CREATE TABLE tbl(one status);", typeDoesNotExist.Error()},
+		{"CREATE TYPE status AS ENUM ('new', 'active', 'new');", duplicatedLabel.Error()},
+		{"CREATE TYPE status AS ENUM ('new'); CREATE TABLE tbl(one status); INSERT INTO tbl VALUES('deleted');", invalidInsert.Error()},
+		{"CREATE TYPE status AS ENUM ('new'); CREATE TABLE tbl(one status); INSERT INTO tbl VALUES('new'); UPDATE tbl SET one TO 'New';", invalidUpdate.Error()},
+		{"CREATE TYPE status AS ENUM ('new'); CREATE TABLE tbl(one status); INSERT INTO tbl VALUES('new'); SELECT * FROM tbl WHERE one EQUAL 'old';", invalidComparison.Error()},
+		{"CREATE TYPE status AS ENUM ('new'); CREATE TABLE tbl(one status, two INT); INSERT INTO tbl VALUES('new', 1); UPDATE tbl SET one TO two;", integerUpdateOfEnum.Error()},
+		{"CREATE TYPE first AS ENUM ('a', 'b'); CREATE TYPE second AS ENUM ('b', 'a'); CREATE TABLE tbl(x first, y second);" +
+			"INSERT INTO tbl VALUES('a', 'a'); SELECT * FROM tbl WHERE x < y;", differentEnums.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineArrayTypeErrorHandling(t *testing.T) {
+	invalidArrayLiteral := InvalidArrayLiteralError{value: "{a,b"}
+	mixedElementTypes := MixedArrayElementTypesError{firstType: token.INT, secondType: token.TEXT}
+	textArrayInIntArray := InvalidValueTypeError{expectedType: token.LITERAL, actualType: token.IDENT, commandName: token.INSERT}
+	subscriptOfNonArray := InvalidFunctionArgumentError{functionName: token.LBRACKET, expectedType: token.ARRAY, actualValue: "1"}
+	unnestOfNonArray := InvalidFunctionArgumentError{functionName: token.UNNEST, expectedType: token.ARRAY, actualValue: "1"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one TEXT[]); INSERT INTO tbl VALUES('{a,b');", invalidArrayLiteral.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT ARRAY[1, 'a'] FROM tbl;", mixedElementTypes.Error()},
+		{"CREATE TABLE tbl(one INT[]); INSERT INTO tbl VALUES(ARRAY['a']);", textArrayInIntArray.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT one[1] FROM tbl;", subscriptOfNonArray.Error()},
+		{"SELECT * FROM UNNEST(1);", unnestOfNonArray.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEnginePrimaryKeyErrorHandling(t *testing.T) {
+	duplicatedInsert := DuplicateKeyError{constraint: "PRIMARY KEY", tableName: "tbl", key: "(one)=(1)"}
+	duplicatedCompositeKey := DuplicateKeyError{constraint: "PRIMARY KEY", tableName: "tbl", key: "(one, two)=(1, a)"}
+	duplicatedUpdate := DuplicateKeyError{constraint: "PRIMARY KEY", tableName: "tbl", key: "(one)=(2)"}
+	equalDecimals := DuplicateKeyError{constraint: "PRIMARY KEY", tableName: "tbl", key: "(one)=(1.50)"}
+	nullInPrimaryKey := NotNullViolationError{columnName: "two", tableName: "tbl"}
+	missingColumn := ColumnDoesNotExistError{tableName: "tbl", columnName: "three"}
+	repeatedColumn := DuplicatedKeyColumnError{columnName: "one", constraint: "PRIMARY KEY"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one INT PRIMARY KEY); INSERT INTO tbl VALUES(1); INSERT INTO tbl VALUES(1);", duplicatedInsert.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT, PRIMARY KEY (one, two)); INSERT INTO tbl VALUES(1, 'a'); INSERT INTO tbl VALUES(1, 'a');", duplicatedCompositeKey.Error()},
+		{"CREATE TABLE tbl(one INT PRIMARY KEY); INSERT INTO tbl VALUES(1); INSERT INTO tbl VALUES(2); UPDATE tbl SET one TO 2;", duplicatedUpdate.Error()},
+		{"CREATE TABLE tbl(one DECIMAL PRIMARY KEY); INSERT INTO tbl VALUES(1.5); INSERT INTO tbl VALUES(1.50);", equalDecimals.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT, PRIMARY KEY (one, two)); INSERT INTO tbl VALUES(1, NULL);", nullInPrimaryKey.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT PRIMARY KEY); INSERT INTO tbl VALUES(1, 'a'); UPDATE tbl SET two TO NULL;", nullInPrimaryKey.Error()},
+		{"CREATE TABLE tbl(one INT, PRIMARY KEY (three));", missingColumn.Error()},
+		{"CREATE TABLE tbl(one INT, PRIMARY KEY (one, one));", repeatedColumn.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineUniqueConstraintErrorHandling(t *testing.T) {
+	duplicatedInsert := UniqueViolationError{constraintName: "tbl_one_key", tableName: "tbl", key: "(one)=(1)"}
+	duplicatedCompositeKey := UniqueViolationError{constraintName: "tbl_one_two_key", tableName: "tbl", key: "(one, two)=(1, a)"}
+	duplicatedNull := UniqueViolationError{constraintName: "tbl_two_key", tableName: "tbl", key: "(two)=(NULL)"}
+	duplicatedNamed := UniqueViolationError{constraintName: "unique_one", tableName: "tbl", key: "(one)=(2)"}
+	secondConstraint := UniqueViolationError{constraintName: "tbl_one_key1", tableName: "tbl", key: "(one)=(NULL)"}
+	duplicatedConstraintName := DuplicatedConstraintNameError{constraintName: "named", tableName: "tbl"}
+	missingColumn := ColumnDoesNotExistError{tableName: "tbl", columnName: "three"}
+	repeatedColumn := DuplicatedKeyColumnError{columnName: "one", constraint: "UNIQUE"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one INT UNIQUE); INSERT INTO tbl VALUES(1); INSERT INTO tbl VALUES(1);", duplicatedInsert.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT, UNIQUE (one, two)); INSERT INTO tbl VALUES(1, 'a'); INSERT INTO tbl VALUES(1, 'a');", duplicatedCompositeKey.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT UNIQUE NULLS NOT DISTINCT); INSERT INTO tbl VALUES(1, NULL); INSERT INTO tbl VALUES(2, NULL);", duplicatedNull.Error()},
+		{"CREATE TABLE tbl(one INT CONSTRAINT unique_one UNIQUE); INSERT INTO tbl VALUES(1); INSERT INTO tbl VALUES(2); UPDATE tbl SET one TO 2;", duplicatedNamed.Error()},
+		{"CREATE TABLE tbl(one INT UNIQUE, UNIQUE NULLS NOT DISTINCT (one)); INSERT INTO tbl VALUES(NULL); DELETE FROM tbl WHERE one EQUAL 2; INSERT INTO tbl VALUES(NULL);", secondConstraint.Error()},
+		{"CREATE TABLE tbl(one INT CONSTRAINT named UNIQUE, CONSTRAINT named CHECK (one > 0));", duplicatedConstraintName.Error()},
+		{"CREATE TABLE tbl(one INT, UNIQUE (three));", missingColumn.Error()},
+		{"CREATE TABLE tbl(one INT, UNIQUE (one, one));", repeatedColumn.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineForeignKeyErrorHandling(t *testing.T) {
+	parents := "CREATE TABLE users(id INT PRIMARY KEY, email TEXT UNIQUE, name TEXT); INSERT INTO users VALUES(1, 'a', 'x');"
+	missingParentOnInsert := ForeignKeyViolationError{constraintName: "orders_user_id_fkey", tableName: "orders", referencedTable: "users", referencedKey: "(id)=(2)"}
+	missingParentOnUpdate := ForeignKeyViolationError{constraintName: "fk_email", tableName: "orders", referencedTable: "users", referencedKey: "(email)=(b)"}
+	restrictedDelete := ReferencedKeyError{tableName: "users", constraintName: "orders_user_id_fkey", referencingTable: "orders", key: "(id)=(1)"}
+	restrictedUpdate := ReferencedKeyError{tableName: "users", constraintName: "orders_user_id_fkey", referencingTable: "orders", key: "(id)=(1)"}
+	setNullInNotNull := NotNullViolationError{columnName: "user_id", tableName: "orders"}
+	referencedDrop := DependentObjectsExistError{tableName: "users", constraintName: "orders_user_id_fkey", referencingTable: "orders"}
+	missingTable := TableDoesNotExistError{tableName: "clients"}
+	missingColumn := ColumnDoesNotExistError{tableName: "users", columnName: "login"}
+	notUniqueColumn := MissingUniqueConstraintError{tableName: "users"}
+	noPrimaryKey := MissingUniqueConstraintError{tableName: "logs"}
+	differentNumberOfColumns := InvalidNumberOfReferencedColumnsError{constraintName: "orders_user_id_fkey"}
+	differentTypes := ForeignKeyTypeMismatchError{columnName: "user_id", columnType: "TEXT", referencedColumnName: "id", referencedType: "INT"}
+
+	tests := []errorHandlingTestSuite{
+		{parents + "CREATE TABLE orders(id INT, user_id INT REFERENCES users); INSERT INTO orders VALUES(1, 2);", missingParentOnInsert.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_email TEXT, CONSTRAINT fk_email FOREIGN KEY (user_email) REFERENCES users (email)); INSERT INTO orders VALUES(1, 'a'); UPDATE orders SET user_email TO 'b';", missingParentOnUpdate.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT REFERENCES users); INSERT INTO orders VALUES(1, 1); DELETE FROM users WHERE id EQUAL 1;", restrictedDelete.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT REFERENCES users ON DELETE CASCADE); INSERT INTO orders VALUES(1, 1); UPDATE users SET id TO 2;", restrictedUpdate.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT NOT NULL REFERENCES users ON DELETE SET NULL); INSERT INTO orders VALUES(1, 1); DELETE FROM users WHERE id EQUAL 1;", setNullInNotNull.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT REFERENCES users); DROP TABLE users;", referencedDrop.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT REFERENCES clients);", missingTable.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_login TEXT REFERENCES users (login));", missingColumn.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_name TEXT REFERENCES users (name));", notUniqueColumn.Error()},
+		{"CREATE TABLE logs(id INT); CREATE TABLE orders(id INT, log_id INT REFERENCES logs);", noPrimaryKey.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id INT, FOREIGN KEY (user_id) REFERENCES users (id, email));", differentNumberOfColumns.Error()},
+		{parents + "CREATE TABLE orders(id INT, user_id TEXT REFERENCES users);", differentTypes.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineSequenceErrorHandling(t *testing.T) {
+	sequenceAlreadyExists := SequenceAlreadyExistsError{sequenceName: "seq"}
+	sequenceDoesNotExist := SequenceDoesNotExistError{sequenceName: "missing"}
+	zeroIncrement := InvalidSequenceOptionsError{sequenceName: "seq", description: "INCREMENT must not be zero"}
+	minValueNotLessThanMaxValue := InvalidSequenceOptionsError{sequenceName: "seq", description: "MINVALUE (5) must be less than MAXVALUE (5)"}
+	startOutOfRange := InvalidSequenceOptionsError{sequenceName: "seq", description: "START value (0) must be between MINVALUE and MAXVALUE"}
+	maximumReached := SequenceLimitError{sequenceName: "seq", limit: "maximum", value: 2}
+	minimumReached := SequenceLimitError{sequenceName: "seq", limit: "minimum", value: -1}
+	currentValueNotDefined := SequenceValueNotDefinedError{sequenceName: "seq"}
+	setValueOutOfRange := SequenceValueOutOfRangeError{sequenceName: "seq", value: 10, minValue: 1, maxValue: 5}
+	invalidSetValueArgument := InvalidFunctionArgumentError{functionName: "SETVAL", expectedType: token.INT, actualValue: "a"}
+	textIdentity := InvalidIdentityTypeError{columnName: "id", columnType: "TEXT"}
+	serialWithDefault := MultipleDefaultValuesError{columnName: "id"}
+	insertIntoAlwaysIdentity := IdentityColumnValueError{columnName: "id", commandName: token.INSERT}
+	updateOfAlwaysIdentity := IdentityColumnValueError{columnName: "id", commandName: token.UPDATE}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE SEQUENCE seq; CREATE SEQUENCE seq;", sequenceAlreadyExists.Error()},
+		{"CREATE TABLE tbl(one INT DEFAULT NEXTVAL('missing')); INSERT INTO tbl VALUES(DEFAULT);", sequenceDoesNotExist.Error()},
+		{"CREATE SEQUENCE seq INCREMENT BY 0;", zeroIncrement.Error()},
+		{"CREATE SEQUENCE seq MINVALUE 5 MAXVALUE 5;", minValueNotLessThanMaxValue.Error()},
+		{"CREATE SEQUENCE seq START WITH 0;", startOutOfRange.Error()},
+		{"CREATE SEQUENCE seq MAXVALUE 2; CREATE TABLE tbl(one INT DEFAULT NEXTVAL('seq')); INSERT INTO tbl VALUES(DEFAULT); INSERT INTO tbl VALUES(DEFAULT); INSERT INTO tbl VALUES(DEFAULT);", maximumReached.Error()},
+		{"CREATE SEQUENCE seq INCREMENT -1 MAXVALUE 0 MINVALUE -1; CREATE TABLE tbl(one INT DEFAULT NEXTVAL('seq')); INSERT INTO tbl VALUES(DEFAULT); INSERT INTO tbl VALUES(DEFAULT); INSERT INTO tbl VALUES(DEFAULT);", minimumReached.Error()},
+		{"CREATE SEQUENCE seq; CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT CURRVAL('seq') FROM tbl;", currentValueNotDefined.Error()},
+		{"CREATE SEQUENCE seq MAXVALUE 5; CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT SETVAL('seq', 10) FROM tbl;", setValueOutOfRange.Error()},
+		{"CREATE SEQUENCE seq; CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); SELECT SETVAL('seq', 'a') FROM tbl;", invalidSetValueArgument.Error()},
+		{"CREATE TABLE tbl(id TEXT GENERATED ALWAYS AS IDENTITY);", textIdentity.Error()},
+		{"CREATE TABLE tbl(id SERIAL DEFAULT 1);", serialWithDefault.Error()},
+		{"CREATE TABLE tbl(id INT GENERATED ALWAYS AS IDENTITY, name TEXT); INSERT INTO tbl VALUES(1, 'a');", insertIntoAlwaysIdentity.Error()},
+		{"CREATE TABLE tbl(id INT GENERATED ALWAYS AS IDENTITY, name TEXT); INSERT INTO tbl (name) VALUES('a'); UPDATE tbl SET id TO 5;", updateOfAlwaysIdentity.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineFailedCreateTableDoesNotCreateSequences(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl(id SERIAL, code TEXT GENERATED ALWAYS AS IDENTITY);"))
+	if err == nil {
+		t.Fatalf("Create should fail because identity column has TEXT type")
+	}
+	if len(engine.Sequences) != 0 {
+		t.Errorf("Failed create shouldn't leave any sequences, got: %v", engine.Sequences)
+	}
+}
+
+func TestEngineIndexErrorHandling(t *testing.T) {
+	indexAlreadyExists := IndexAlreadyExistsError{indexName: "idx"}
+	indexDoesNotExist := IndexDoesNotExistError{indexName: "idx"}
+	missingTable := TableDoesNotExistError{tableName: "users"}
+	missingColumn := ColumnDoesNotExistError{tableName: "tbl", columnName: "two"}
+	jsonColumn := UnsupportedIndexColumnTypeError{columnName: "data", columnType: "JSON"}
+	arrayColumn := UnsupportedIndexColumnTypeError{columnName: "tags", columnType: "TEXT[]"}
+	duplicatedColumn := DuplicatedKeyColumnError{columnName: "one", constraint: "index"}
+	missingIncludedColumn := ColumnDoesNotExistError{tableName: "tbl", columnName: "two"}
+	hashWithInclude := UnsupportedIndexIncludeError{method: "HASH"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one INT); CREATE TABLE tbl2(one INT); CREATE INDEX idx ON tbl (one); CREATE INDEX idx ON tbl2 (one);", indexAlreadyExists.Error()},
+		{"CREATE TABLE tbl(one INT); DROP INDEX idx;", indexDoesNotExist.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE INDEX idx ON tbl (one); DROP TABLE tbl; DROP INDEX idx;", indexDoesNotExist.Error()},
+		{"CREATE INDEX idx ON users (one);", missingTable.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE INDEX idx ON tbl (two);", missingColumn.Error()},
+		{"CREATE TABLE tbl(data JSON); CREATE INDEX idx ON tbl (data);", jsonColumn.Error()},
+		{"CREATE TABLE tbl(tags TEXT[]); CREATE INDEX idx ON tbl USING HASH (tags);", arrayColumn.Error()},
+		{"CREATE TABLE tbl(one INT, tags TEXT[]); CREATE INDEX idx ON tbl (one, tags);", arrayColumn.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE INDEX idx ON tbl (one, one);", duplicatedColumn.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE INDEX idx ON tbl (one) INCLUDE (one);", duplicatedColumn.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE INDEX idx ON tbl (one) INCLUDE (two);", missingIncludedColumn.Error()},
+		{"CREATE TABLE tbl(one INT, two INT); CREATE INDEX idx ON tbl USING HASH (one) INCLUDE (two);", hashWithInclude.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineAlterTableErrorHandling(t *testing.T) {
+	missingTable := TableDoesNotExistError{tableName: "users"}
+	missingColumn := ColumnDoesNotExistError{tableName: "tbl", columnName: "three"}
+	addedColumnExists := ColumnAlreadyExistsError{tableName: "tbl", columnName: "two"}
+	renamedColumnExists := ColumnAlreadyExistsError{tableName: "tbl", columnName: "one"}
+	renamedTableExists := TableAlreadyExistsError{tableName: "tbl2"}
+	lastColumn := LastColumnDropError{tableName: "tbl2", columnName: "one"}
+	multiplePrimaryKeys := MultiplePrimaryKeysError{tableName: "tbl"}
+	duplicatedConstraint := DuplicatedConstraintNameError{constraintName: "tbl_one_key", tableName: "tbl"}
+	notNullWithoutDefault := NotNullViolationError{columnName: "three", tableName: "tbl"}
+	dependentForeignKey := DependentObjectsExistError{tableName: "tbl", columnName: "one", constraintName: "tbl2_one_fkey", referencingTable: "tbl2"}
+	invalidCast := InvalidCastError{value: "a", targetType: "INT"}
+	tooLongValue := ValueTooLongError{length: 2, columnName: "one", columnType: "VARCHAR", maxLength: 1}
+	identityType := InvalidIdentityTypeError{columnName: "id", columnType: "TEXT"}
+	indexedJson := UnsupportedIndexColumnTypeError{columnName: "two", columnType: "JSON"}
+	foreignKeyType := ForeignKeyTypeMismatchError{columnName: "one", columnType: "INT", referencedColumnName: "one", referencedType: "BIGINT"}
+	duplicatedKey := UniqueViolationError{constraintName: "tbl_two_key", tableName: "tbl", key: "(two)=(1.0)"}
+	integerDefaultOfText := DefaultValueTypeMismatchError{columnName: "one", defaultValue: "5", columnType: "TEXT"}
+	tooLongDefault := DefaultValueTypeMismatchError{columnName: "one", defaultValue: "'ab'", columnType: "VARCHAR"}
+
+	tests := []errorHandlingTestSuite{
+		{"ALTER TABLE users DROP COLUMN one;", missingTable.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); ALTER TABLE tbl DROP COLUMN three;", missingColumn.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); ALTER TABLE tbl ALTER COLUMN three TYPE INT;", missingColumn.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); ALTER TABLE tbl ADD COLUMN two INT;", addedColumnExists.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); ALTER TABLE tbl RENAME COLUMN two TO one;", renamedColumnExists.Error()},
+		{"CREATE TABLE tbl(one INT); CREATE TABLE tbl2(one INT); ALTER TABLE tbl RENAME TO tbl2;", renamedTableExists.Error()},
+		{"CREATE TABLE tbl2(one INT); ALTER TABLE tbl2 DROP COLUMN one;", lastColumn.Error()},
+		{"CREATE TABLE tbl(one INT PRIMARY KEY); ALTER TABLE tbl ADD COLUMN two INT PRIMARY KEY;", multiplePrimaryKeys.Error()},
+		{"CREATE TABLE tbl(one INT UNIQUE); ALTER TABLE tbl ADD COLUMN two INT CONSTRAINT tbl_one_key UNIQUE;", duplicatedConstraint.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl VALUES(1); ALTER TABLE tbl ADD COLUMN three INT NOT NULL;", notNullWithoutDefault.Error()},
+		{"CREATE TABLE tbl(one INT PRIMARY KEY, two INT); CREATE TABLE tbl2(one INT REFERENCES tbl); ALTER TABLE tbl DROP COLUMN one;", dependentForeignKey.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('a'); ALTER TABLE tbl ALTER COLUMN one TYPE INT;", invalidCast.Error()},
+		{"CREATE TABLE tbl(one TEXT); INSERT INTO tbl VALUES('ab'); ALTER TABLE tbl ALTER COLUMN one TYPE VARCHAR(1);", tooLongValue.Error()},
+		{"CREATE TABLE tbl(id SERIAL); ALTER TABLE tbl ALTER COLUMN id TYPE TEXT;", identityType.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT); CREATE INDEX ON tbl (two); ALTER TABLE tbl ALTER COLUMN two TYPE JSON;", indexedJson.Error()},
+		{"CREATE TABLE tbl2(one INT PRIMARY KEY); CREATE TABLE tbl(one INT REFERENCES tbl2); ALTER TABLE tbl2 ALTER COLUMN one TYPE BIGINT;", foreignKeyType.Error()},
+		{"CREATE TABLE tbl(one INT, two DECIMAL UNIQUE); INSERT INTO tbl VALUES(1, 1.04); INSERT INTO tbl VALUES(2, 0.95); ALTER TABLE tbl ALTER COLUMN two TYPE DECIMAL(2, 1);", duplicatedKey.Error()},
+		{"CREATE TABLE tbl(one INT DEFAULT 5); INSERT INTO tbl VALUES(1); ALTER TABLE tbl ALTER COLUMN one TYPE TEXT;", integerDefaultOfText.Error()},
+		{"CREATE TABLE tbl(one TEXT DEFAULT 'ab'); ALTER TABLE tbl ALTER COLUMN one TYPE VARCHAR(1);", tooLongDefault.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineFailedUpdateDoesNotChangeSecondaryIndexes(t *testing.T) {
+	input := "CREATE TABLE tbl(id INT PRIMARY KEY, name TEXT); CREATE INDEX ON tbl USING HASH (name); CREATE INDEX ON tbl (name);" +
+		"INSERT INTO tbl VALUES(1, 'a'); INSERT INTO tbl VALUES(2, 'b');" +
+		"UPDATE tbl SET id TO 1, name TO 'c' WHERE id EQUAL 2;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err == nil {
+		t.Fatalf("Update should fail because of duplicated primary key")
+	}
+
+	for _, condition := range []string{"name EQUAL 'b'", "name >= 'b'"} {
+		sequences := getSequences("SELECT * FROM tbl WHERE " + condition + ";")
+		whereCommand := sequences.Commands[0].(*ast.SelectCommand).WhereCommand
+		rows := engine.getCandidateRows(engine.Tables["tbl"], whereCommand.Expression)
+		if !slices.Equal(rows, []int{1}) {
+			t.Errorf("Indexes should return row 1 for %q after failed update, got: %v", condition, rows)
+		}
+	}
+}
+
+func TestEngineFailedCascadeDoesNotChangeTables(t *testing.T) {
+	input := "CREATE TABLE users(id INT PRIMARY KEY); CREATE TABLE orders(id INT PRIMARY KEY, user_id INT REFERENCES users ON DELETE CASCADE);" +
+		"CREATE TABLE payments(id INT, order_id INT REFERENCES orders);" +
+		"INSERT INTO users VALUES(1); INSERT INTO users VALUES(2); INSERT INTO orders VALUES(10, 1); INSERT INTO orders VALUES(20, 2);" +
+		"INSERT INTO payments VALUES(1, 20);" +
+		"DELETE FROM users WHERE id > 0;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err == nil {
+		t.Fatalf("Delete should fail because payment refers to deleted order")
+	}
+
+	for tableName, expectedRows := range map[string]int{"users": 2, "orders": 2, "payments": 1} {
+		table := engine.Tables[tableName]
+		if len(table.Columns[0].Values) != expectedRows {
+			t.Errorf("Table %s should have %d rows after failed delete, got: %d", tableName, expectedRows, len(table.Columns[0].Values))
+		}
+		if table.primaryKey != nil && len(table.primaryKey.rows) != expectedRows {
+			t.Errorf("Index of table %s should have %d keys after failed delete, got: %v", tableName, expectedRows, table.primaryKey.rows)
+		}
+	}
+}
+
+func TestEngineColumnConstraintsErrorHandling(t *testing.T) {
+	nullInsertedIntoNotNull := NotNullViolationError{columnName: "two", tableName: "tbl"}
+	columnCheckViolation := CheckViolationError{constraintName: "tbl_one_check", tableName: "tbl"}
+	secondTableCheckViolation := CheckViolationError{constraintName: "tbl_check1", tableName: "tbl"}
+	namedCheckViolation := CheckViolationError{constraintName: "positive", tableName: "tbl"}
+	duplicatedConstraintName := DuplicatedConstraintNameError{constraintName: "positive", tableName: "tbl"}
+	columnInDefault := InvalidDefaultValueError{columnName: "two"}
+	invalidDefaultType := InvalidValueTypeError{expectedType: token.LITERAL, actualType: token.IDENT, commandName: token.CREATE}
+	missingColumnInCheck := ColumnDoesNotExistError{tableName: "tbl", columnName: "three"}
+	missingColumnInInsert := ColumnDoesNotExistError{tableName: "tbl", columnName: "three"}
+	duplicatedColumnInInsert := DuplicatedColumnError{columnName: "one", commandName: token.INSERT}
+	tooManyValues := InvalidNumberOfParametersError{expectedNumber: 1, actualNumber: 2, commandName: token.INSERT}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl(one INT, two TEXT NOT NULL); INSERT INTO tbl (one) VALUES(1);", nullInsertedIntoNotNull.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT NOT NULL); INSERT INTO tbl VALUES(1, 'a'); UPDATE tbl SET two TO NULL;", nullInsertedIntoNotNull.Error()},
+		{"CREATE TABLE tbl(one INT, two TEXT NOT NULL DEFAULT NULL); INSERT INTO tbl VALUES(1, DEFAULT);", nullInsertedIntoNotNull.Error()},
+		{"CREATE TABLE tbl(one INT CHECK (one > 0)); INSERT INTO tbl VALUES(0);", columnCheckViolation.Error()},
+		{"CREATE TABLE tbl(one INT, CHECK (one > 0), CHECK (one < 10)); INSERT INTO tbl VALUES(1); UPDATE tbl SET one TO one + 10;", secondTableCheckViolation.Error()},
+		{"CREATE TABLE tbl(one INT, two INT, CONSTRAINT positive CHECK (one > 0 AND two > 0)); INSERT INTO tbl VALUES(1, -1);", namedCheckViolation.Error()},
+		{"CREATE TABLE tbl(one INT DEFAULT -1 CONSTRAINT positive CHECK (one > 0)); INSERT INTO tbl VALUES(DEFAULT);", namedCheckViolation.Error()},
+		{"CREATE TABLE tbl(one INT, CONSTRAINT positive CHECK (one > 0), CONSTRAINT positive CHECK (one < 10));", duplicatedConstraintName.Error()},
+		{"CREATE TABLE tbl(one INT, two INT DEFAULT one + 1);", columnInDefault.Error()},
+		{"CREATE TABLE tbl(one INT DEFAULT 'a');", invalidDefaultType.Error()},
+		{"CREATE TABLE tbl(one INT CHECK (three > 0));", missingColumnInCheck.Error()},
+		{"CREATE TABLE tbl(one INT); INSERT INTO tbl (three) VALUES(1);", missingColumnInInsert.Error()},
+		{"CREATE TABLE tbl(one INT, two INT); INSERT INTO tbl (one, one) VALUES(1, 2);", duplicatedColumnInInsert.Error()},
+		{"CREATE TABLE tbl(one INT, two INT); INSERT INTO tbl (one) VALUES(1, 2);", tooManyValues.Error()},
+	}
+
+	runEngineErrorHandlingSuite(t, tests)
+}
+
+func TestEngineCheckConstraintAcceptsNull(t *testing.T) {
+	input := "CREATE TABLE tbl(one INT CHECK (one > 0), two BOOLEAN CHECK (two)); INSERT INTO tbl VALUES(NULL, NULL);"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("CHECK constraint shouldn't reject NULL values, got: %s", err)
+	}
+}
+
+func TestEngineFailedUpdateDoesNotChangePrimaryKey(t *testing.T) {
+	input := "CREATE TABLE tbl(one INT PRIMARY KEY, two TEXT); INSERT INTO tbl VALUES(1, 'a'); INSERT INTO tbl VALUES(2, 'b');" +
+		"UPDATE tbl SET one TO 2, two TO 'c' WHERE one EQUAL 1;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err == nil {
+		t.Fatalf("Update should fail because of duplicated key")
+	}
+
+	table := engine.Tables["tbl"]
+	if table.Columns[0].Values[0].ToString() != "1" || table.Columns[1].Values[0].ToString() != "a" {
+		t.Errorf("Row shouldn't be changed by failed update, got: %s, %s", table.Columns[0].Values[0].ToString(), table.Columns[1].Values[0].ToString())
+	}
+	if _, exists := table.primaryKey.rows[`"1"`]; !exists {
+		t.Errorf("Index should still contain key of the row, got: %v", table.primaryKey.rows)
+	}
+}
+
+func TestEngineFailedUpdateDoesNotChangeUniqueIndexes(t *testing.T) {
+	input := "CREATE TABLE tbl(one INT PRIMARY KEY, two TEXT UNIQUE); INSERT INTO tbl VALUES(1, 'a'); INSERT INTO tbl VALUES(2, 'b');" +
+		"UPDATE tbl SET one TO one + 10, two TO 'b';"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err == nil {
+		t.Fatalf("Update should fail because of duplicated unique key")
+	}
+
+	table := engine.Tables["tbl"]
+	for key, rowIndex := range map[string]int{`"1"`: 0, `"2"`: 1} {
+		if actualRowIndex, exists := table.primaryKey.rows[key]; !exists || actualRowIndex != rowIndex {
+			t.Errorf("Primary key %s should point to row %d, got: %v", key, rowIndex, table.primaryKey.rows)
+		}
+	}
+	for key, rowIndex := range map[string]int{`"a"`: 0, `"b"`: 1} {
+		if actualRowIndex, exists := table.uniqueConstraints[0].rows[key]; !exists || actualRowIndex != rowIndex {
+			t.Errorf("Unique key %s should point to row %d, got: %v", key, rowIndex, table.uniqueConstraints[0].rows)
+		}
+	}
+}
+
 func runEngineErrorHandlingSuite(t *testing.T, suite []errorHandlingTestSuite) {
 	for i, test := range suite {
 		errorMsg := getErrorMessage(t, test.input, i)
@@ -1,8 +1,15 @@
 package engine
 
 import (
+	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/LissaGreense/GO4SQL/ast"
 	"github.com/LissaGreense/GO4SQL/lexer"
@@ -362,6 +369,27 @@ func TestDelete(t *testing.T) {
 	engineTestSuite.runTestSuite(t)
 }
 
+func TestDeleteWithoutWhere(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'hello', 1 );",
+			"INSERT INTO tb1 VALUES( 'byebye', 3 );",
+			"DELETE FROM tb1;",
+			"INSERT INTO tb1 VALUES( 'goodbye', 2 );",
+		},
+		selectInput: "SELECT one, two FROM tb1;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"goodbye", "2"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
 func TestUpdateWithWhere(t *testing.T) {
 	engineTestSuite := engineTableContentTestSuite{
 		createInputs: []string{
@@ -948,6 +976,2431 @@ func TestAggregateFunctionWithColumnSelectionAndOrderBy(t *testing.T) {
 	engineTestSuite.runTestSuite(t)
 }
 
+func TestNullSemanticsMatrix(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE tb1( one TEXT, two INT );",
+		"CREATE TABLE tb2( two INT, name TEXT );",
+	}
+	insertInputs := []string{
+		"INSERT INTO tb1 VALUES( 'a', 1 );",
+		"INSERT INTO tb1 VALUES( 'b', NULL );",
+		"INSERT INTO tb1 VALUES( NULL, 3 );",
+		"INSERT INTO tb2 VALUES( NULL, 'x' );",
+		"INSERT INTO tb2 VALUES( 1, 'y' );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		legacyOutput   [][]string
+		standardOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two NOT 1;",
+			legacyOutput:   [][]string{{"one", "two"}, {"b", "NULL"}, {"NULL", "3"}},
+			standardOutput: [][]string{{"one", "two"}, {"NULL", "3"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two EQUAL NULL;",
+			legacyOutput:   [][]string{{"one", "two"}, {"b", "NULL"}},
+			standardOutput: [][]string{{"one", "two"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two IN (1, NULL);",
+			legacyOutput:   [][]string{{"one", "two"}, {"a", "1"}, {"b", "NULL"}},
+			standardOutput: [][]string{{"one", "two"}, {"a", "1"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two NOTIN (1, NULL);",
+			legacyOutput:   [][]string{{"one", "two"}, {"NULL", "3"}},
+			standardOutput: [][]string{{"one", "two"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two NOTIN (1);",
+			legacyOutput:   [][]string{{"one", "two"}, {"b", "NULL"}, {"NULL", "3"}},
+			standardOutput: [][]string{{"one", "two"}, {"NULL", "3"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE one EQUAL 'a' OR two EQUAL NULL;",
+			legacyOutput:   [][]string{{"one", "two"}, {"a", "1"}, {"b", "NULL"}},
+			standardOutput: [][]string{{"one", "two"}, {"a", "1"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE one NOT 'a' AND two NOT 1;",
+			legacyOutput:   [][]string{{"one", "two"}, {"b", "NULL"}, {"NULL", "3"}},
+			standardOutput: [][]string{{"one", "two"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tb1 WHERE two EQUAL NULL OR TRUE;",
+			legacyOutput:   [][]string{{"one", "two"}, {"a", "1"}, {"b", "NULL"}, {"NULL", "3"}},
+			standardOutput: [][]string{{"one", "two"}, {"a", "1"}, {"b", "NULL"}, {"NULL", "3"}},
+		},
+		{
+			selectInput:    "SELECT tb1.one, tb2.name FROM tb1 JOIN tb2 ON tb1.two EQUAL tb2.two;",
+			legacyOutput:   [][]string{{"tb1.one", "tb2.name"}, {"a", "y"}, {"b", "x"}},
+			standardOutput: [][]string{{"tb1.one", "tb2.name"}, {"a", "y"}},
+		},
+		{
+			selectInput:    "SELECT MIN(two), MIN(one), COUNT(two) FROM tb1;",
+			legacyOutput:   [][]string{{"MIN(two)", "MIN(one)", "COUNT(two)"}, {"NULL", "NULL", "2"}},
+			standardOutput: [][]string{{"MIN(two)", "MIN(one)", "COUNT(two)"}, {"1", "a", "2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, standardNulls := range []bool{false, true} {
+			expectedOutput := tt.legacyOutput
+			if standardNulls {
+				expectedOutput = tt.standardOutput
+			}
+
+			engineTestSuite := engineTableContentTestSuite{
+				createInputs:          createInputs,
+				insertAndDeleteInputs: insertInputs,
+				selectInput:           tt.selectInput,
+				expectedOutput:        expectedOutput,
+				standardNulls:         standardNulls,
+			}
+
+			engineTestSuite.runTestSuite(t)
+		}
+	}
+}
+
+func TestAggregateFunctionAvgWithStandardNulls(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE table1( id INT, value TEXT);",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO table1 VALUES(1, 'Value1');",
+			"INSERT INTO table1 VALUES(NULL, 'Value2');",
+			"INSERT INTO table1 VALUES(5, NULL);",
+		},
+		selectInput: "SELECT AVG(id), MAX(value), SUM(id) FROM table1;",
+		expectedOutput: [][]string{
+			{"AVG(id)", "MAX(value)", "SUM(id)"},
+			{"3", "Value2", "6"},
+		},
+		standardNulls: true,
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestDeleteWithStandardNulls(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'a', 1 );",
+			"INSERT INTO tb1 VALUES( 'b', NULL );",
+			"INSERT INTO tb1 VALUES( 'c', 3 );",
+			"DELETE FROM tb1 WHERE two NOT 1;",
+		},
+		selectInput: "SELECT * FROM tb1;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"a", "1"},
+			{"b", "NULL"},
+		},
+		standardNulls: true,
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestStringFunctionsInSelect(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'Hello', 2 );",
+			"INSERT INTO tb1 VALUES( 'byebye', 4 );",
+			"INSERT INTO tb1 VALUES( NULL, 3 );",
+		},
+		selectInput: "SELECT one, UPPER(one), lower(one), LENGTH(one), SUBSTR(one, two), SUBSTRING(one, 2, two) FROM tb1;",
+		expectedOutput: [][]string{
+			{"one", "UPPER(one)", "lower(one)", "LENGTH(one)", "SUBSTR(one, two)", "SUBSTRING(one, 2, two)"},
+			{"Hello", "HELLO", "hello", "5", "ello", "el"},
+			{"byebye", "BYEBYE", "byebye", "6", "bye", "yeby"},
+			{"NULL", "NULL", "NULL", "NULL", "NULL", "NULL"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestStringFunctionsResults(t *testing.T) {
+	tests := []struct {
+		function       string
+		expectedOutput string
+	}{
+		{"TRIM('xxhixx', 'x')", "hi"},
+		{"LTRIM('xxhixx', 'x')", "hixx"},
+		{"RTRIM('xxhixx', 'x')", "xxhi"},
+		{"REPLACE('banana', 'an', 'AN')", "bANANa"},
+		{"CONCAT('a', NULL, 'b', 1)", "ab1"},
+		{"one || 'b' || 1", "ab1"},
+		{"one || NULL", "NULL"},
+		{"POSITION('na', 'banana')", "3"},
+		{"POSITION('x', 'banana')", "0"},
+		{"INSTR('banana', 'na')", "3"},
+		{"LPAD('hi', 5, 'ab')", "abahi"},
+		{"LPAD('hello', 2)", "he"},
+		{"RPAD('hi', 5, 'ab')", "hiaba"},
+		{"LENGTH(RPAD(one, 10485760, 'ab'))", "10485760"},
+		{"SPLIT_PART('a,b,c', ',', 2)", "b"},
+		{"SPLIT_PART('a,b,c', ',', -1)", "c"},
+		{"SPLIT_PART('a,b,c', ',', 4)", ""},
+		{"SUBSTR('hello', 0, 3)", "he"},
+		{"SUBSTR('hello', 7)", ""},
+		{"UPPER(SUBSTR('hello', 2, 3))", "ELL"},
+	}
+
+	for _, test := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs: []string{
+				"CREATE TABLE tb1( one TEXT );",
+			},
+			insertAndDeleteInputs: []string{
+				"INSERT INTO tb1 VALUES( 'a' );",
+			},
+			selectInput: "SELECT " + test.function + " FROM tb1;",
+			expectedOutput: [][]string{
+				{test.function},
+				{test.expectedOutput},
+			},
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestPaddingToMaximalLengthIsPrinted(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tb1( one TEXT ); INSERT INTO tb1 VALUES( 'a' );"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	start := time.Now()
+	result, err := engine.Evaluate(getSequences("SELECT LPAD(one, 10485760, 'x') FROM tb1;"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("Printing padded value took %s", time.Since(start))
+	}
+
+	rows := strings.Split(result, "\n")
+	if len(rows) != 6 || len(rows[3]) != 10485760+6 || !strings.HasSuffix(rows[3], "xa' |") {
+		t.Fatalf("Padded value isn't printed correctly, got %d rows", len(rows))
+	}
+}
+
+func TestStringFunctionsInWhere(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'Hello', 2 );",
+			"INSERT INTO tb1 VALUES( 'byebye', 4 );",
+			"INSERT INTO tb1 VALUES( 'HELLO', 3 );",
+		},
+		selectInput: "SELECT one, two FROM tb1 WHERE UPPER(one) EQUAL 'HELLO' AND LENGTH(one) IN (5, 6);",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"Hello", "2"},
+			{"HELLO", "3"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestStringFunctionsInOrderBy(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'ccc', 2 );",
+			"INSERT INTO tb1 VALUES( 'a', 4 );",
+			"INSERT INTO tb1 VALUES( 'bb', 3 );",
+			"INSERT INTO tb1 VALUES( 'dd', 1 );",
+		},
+		selectInput: "SELECT one, two FROM tb1 ORDER BY LENGTH(one) DESC, two ASC;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"ccc", "2"},
+			{"dd", "1"},
+			{"bb", "3"},
+			{"a", "4"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestStringFunctionsInUpdate(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two TEXT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'hello', 'a' );",
+			"INSERT INTO tb1 VALUES( 'bye', 'b' );",
+			"UPDATE tb1 SET one TO UPPER(one) || '-' || two, two TO one WHERE two EQUAL 'a';",
+		},
+		selectInput: "SELECT one, two FROM tb1;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"HELLO-a", "hello"},
+			{"bye", "b"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestNumericFunctionsResults(t *testing.T) {
+	tests := []struct {
+		function       string
+		expectedOutput string
+	}{
+		{"ABS(one)", "7"},
+		{"ABS(7)", "7"},
+		{"ROUND(one)", "-7"},
+		{"ROUND(1250, -2)", "1300"},
+		{"ROUND(1249, -2)", "1200"},
+		{"ROUND(one, -1)", "-10"},
+		{"ROUND(15, -5)", "0"},
+		{"FLOOR(one)", "-7"},
+		{"CEIL(one)", "-7"},
+		{"CEILING(3)", "3"},
+		{"MOD(one, 3)", "-1"},
+		{"MOD(7, 3)", "1"},
+		{"POWER(one, 3)", "-343"},
+		{"POW(2, 62)", "4611686018427387904"},
+		{"POWER(3, 0)", "1"},
+		{"SQRT(50)", "7"},
+		{"SQRT(49)", "7"},
+		{"SIGN(one)", "-1"},
+		{"SIGN(0)", "0"},
+		{"GREATEST(one, 3, NULL, 2)", "3"},
+		{"LEAST(one, 3, NULL, 2)", "-7"},
+		{"GREATEST(NULL, NULL)", "NULL"},
+		{"GREATEST('abc', 'abd')", "abd"},
+		{"ABS(NULL)", "NULL"},
+		{"POWER(2, NULL)", "NULL"},
+		{"ABS(MOD(one, 4)) || '!'", "3!"},
+	}
+
+	for _, test := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs: []string{
+				"CREATE TABLE tb1( one INT );",
+			},
+			insertAndDeleteInputs: []string{
+				"INSERT INTO tb1 VALUES( -7 );",
+			},
+			selectInput: "SELECT " + test.function + " FROM tb1;",
+			expectedOutput: [][]string{
+				{test.function},
+				{test.expectedOutput},
+			},
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestArithmeticOperatorsResults(t *testing.T) {
+	tests := []struct {
+		expression     string
+		expectedOutput string
+	}{
+		{"one + 3", "-4"},
+		{"one - 3", "-10"},
+		{"one * 3", "-21"},
+		{"one / 2", "-3"},
+		{"-one", "7"},
+		{"+one", "-7"},
+		{"one + 2 * 3", "-1"},
+		{"(one + 2) * 3", "-15"},
+		{"one - 1 - 1", "-9"},
+		{"one + 0.5", "-6.5"},
+		{"one * 1.10", "-7.70"},
+		{"one / 2.0", "-3.5000000000000000"},
+		{"one / 3.0", "-2.3333333333333333"},
+		{"one + 7.1 + 0.2", "0.3"},
+		{"one + 1.5e1", "8"},
+		{"one * 2.5e-1", "-1.75"},
+		{"one + NULL", "NULL"},
+		{"ABS(one / 2.00)", "3.5000000000000000"},
+		{"ROUND(one / 3.0, 2)", "-2.33"},
+		{"ROUND(2.5)", "3"},
+		{"ROUND(-2.5)", "-3"},
+		{"ROUND(1234.5678, -2)", "1200"},
+		{"ROUND(2.5e0)", "3"},
+		{"FLOOR(-2.5)", "-3"},
+		{"CEIL(-2.5)", "-2"},
+		{"CEIL(2.01)", "3"},
+		{"MOD(7.5, 2)", "1.5"},
+		{"POWER(1.5, 2)", "2.25"},
+		{"POWER(2, -1)", "0.5"},
+		{"SQRT(6.25)", "2.5"},
+		{"SIGN(-0.5)", "-1"},
+		{"GREATEST(one, 1.5, 2)", "2"},
+		{"LEAST(one, -7.5, 1e0)", "-7.5"},
+		{"CAST(one AS DECIMAL) / 4", "-1.7500000000000000"},
+		{"CAST(one * 1.5 AS INT)", "-11"},
+		{"CAST(one AS FLOAT) / 4", "-1.75"},
+		{"one::TEXT || 0.50", "-70.50"},
+	}
+
+	for _, test := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs: []string{
+				"CREATE TABLE tb1( one INT );",
+			},
+			insertAndDeleteInputs: []string{
+				"INSERT INTO tb1 VALUES( -7 );",
+			},
+			selectInput: "SELECT " + test.expression + " FROM tb1;",
+			expectedOutput: [][]string{
+				{test.expression},
+				{test.expectedOutput},
+			},
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestNumericColumnTypes(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE products( name TEXT, price DECIMAL(8, 2), weight FLOAT, ratio REAL, quantity INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO products VALUES( 'apple', 1.255, 0.2, 1, 10 );",
+			"INSERT INTO products VALUES( 'pear', 3, 1.5e-1, 0.5, 3 );",
+			"INSERT INTO products VALUES( 'plum', -0.004, -2, NULL, 7 );",
+			"UPDATE products SET price TO price * quantity WHERE quantity NOT 7;",
+		},
+		selectInput: "SELECT name, price, weight, ratio, weight * quantity FROM products WHERE name NOT 'plum' ORDER BY price DESC;",
+		expectedOutput: [][]string{
+			{"name", "price", "weight", "ratio", "weight * quantity"},
+			{"apple", "12.60", "0.2", "1", "2"},
+			{"pear", "9.00", "0.15", "0.5", "0.44999999999999996"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestNumericComparisonAcrossTypes(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one INT, two DECIMAL(4, 1), three FLOAT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 1, 1.0, 1 );",
+			"INSERT INTO tb1 VALUES( 2, 2.5, 2.5 );",
+			"INSERT INTO tb1 VALUES( 3, 2.9, 3.5 );",
+		},
+		selectInput: "SELECT one, GREATEST(one, two, three) FROM tb1 WHERE one EQUAL two OR two EQUAL three ORDER BY three * -1 ASC;",
+		expectedOutput: [][]string{
+			{"one", "GREATEST(one, two, three)"},
+			{"2", "2.5"},
+			{"1", "1"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestAggregateFunctionsOfNumericTypes(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( id INT, price DECIMAL(6, 2), weight FLOAT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 1, 0.10, 0.5 );",
+			"INSERT INTO tb1 VALUES( 2, 0.20, 0.25 );",
+			"INSERT INTO tb1 VALUES( 4, 0.05, 1 );",
+		},
+		selectInput: "SELECT AVG(id), SUM(price), AVG(price), SUM(weight), AVG(weight), MAX(price) FROM tb1;",
+		expectedOutput: [][]string{
+			{"AVG(id)", "SUM(price)", "AVG(price)", "SUM(weight)", "AVG(weight)", "MAX(price)"},
+			{"2.3333333333333333", "0.35", "0.1166666666666667", "1.75", "0.5833333333333334", "0.20"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestBooleanColumn(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE users( name TEXT, isActive BOOLEAN, isAdmin BOOLEAN );",
+	}
+	insertInputs := []string{
+		"INSERT INTO users VALUES( 'Anna', TRUE, FALSE );",
+		"INSERT INTO users VALUES( 'Bob', FALSE, TRUE );",
+		"INSERT INTO users VALUES( 'Carl', NULL, FALSE );",
+		"INSERT INTO users VALUES( 'Dana', TRUE, TRUE );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT name, isActive FROM users WHERE isActive;",
+			expectedOutput: [][]string{{"name", "isActive"}, {"Anna", "TRUE"}, {"Dana", "TRUE"}},
+		},
+		{
+			selectInput:    "SELECT name FROM users WHERE isActive AND isAdmin;",
+			expectedOutput: [][]string{{"name"}, {"Dana"}},
+		},
+		{
+			selectInput:    "SELECT name FROM users WHERE isAdmin OR isActive EQUAL FALSE;",
+			expectedOutput: [][]string{{"name"}, {"Bob"}, {"Dana"}},
+		},
+		{
+			selectInput:    "SELECT name FROM users WHERE isActive IN (FALSE, NULL);",
+			expectedOutput: [][]string{{"name"}, {"Bob"}, {"Carl"}},
+		},
+		{
+			selectInput:    "SELECT name, isAdmin FROM users ORDER BY isAdmin DESC, name ASC;",
+			expectedOutput: [][]string{{"name", "isAdmin"}, {"Bob", "TRUE"}, {"Dana", "TRUE"}, {"Anna", "FALSE"}, {"Carl", "FALSE"}},
+		},
+		{
+			selectInput:    "SELECT BOOL_AND(isActive), BOOL_OR(isActive), BOOL_AND(isAdmin), BOOL_OR(isAdmin), MAX(isAdmin) FROM users;",
+			expectedOutput: [][]string{{"BOOL_AND(isActive)", "BOOL_OR(isActive)", "BOOL_AND(isAdmin)", "BOOL_OR(isAdmin)", "MAX(isAdmin)"}, {"FALSE", "TRUE", "FALSE", "TRUE", "TRUE"}},
+		},
+		{
+			selectInput:    "SELECT BOOL_OR(isAdmin) FROM users WHERE name EQUAL 'Anna' OR name EQUAL 'Carl';",
+			expectedOutput: [][]string{{"BOOL_OR(isAdmin)"}, {"FALSE"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestBooleanColumnUpdate(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE users( name TEXT, isActive BOOLEAN );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO users VALUES( 'Anna', TRUE );",
+			"INSERT INTO users VALUES( 'Bob', FALSE );",
+			"INSERT INTO users VALUES( 'Carl', NULL );",
+			"UPDATE users SET isActive TO FALSE WHERE isActive;",
+			"UPDATE users SET isActive TO TRUE WHERE name EQUAL 'Carl';",
+		},
+		selectInput: "SELECT name, isActive FROM users;",
+		expectedOutput: [][]string{
+			{"name", "isActive"},
+			{"Anna", "FALSE"},
+			{"Bob", "FALSE"},
+			{"Carl", "TRUE"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestBooleanPredicateWithStandardNulls(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE users( name TEXT, isActive BOOLEAN );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO users VALUES( 'Anna', TRUE );",
+			"INSERT INTO users VALUES( 'Bob', NULL );",
+			"DELETE FROM users WHERE isActive OR FALSE;",
+		},
+		selectInput: "SELECT name, BOOL_AND(isActive) FROM users;",
+		expectedOutput: [][]string{
+			{"name", "BOOL_AND(isActive)"},
+			{"Bob", "NULL"},
+		},
+		standardNulls: true,
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestRandomFunction(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		value, err := random("RANDOM", []ValueInterface{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		integerValue, isInteger := value.(IntegerValue)
+		if !isInteger || integerValue.Value < 0 {
+			t.Fatalf("RANDOM should return non-negative integer, got: %s", value.ToString())
+		}
+	}
+}
+
+func TestNullFunctionsWithJoin(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( id INT, name TEXT );",
+			"CREATE TABLE tb2( id INT, city TEXT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 1, 'Anna' );",
+			"INSERT INTO tb1 VALUES( 2, 'Bob' );",
+			"INSERT INTO tb1 VALUES( 3, NULL );",
+			"INSERT INTO tb2 VALUES( 1, 'Paris' );",
+			"INSERT INTO tb2 VALUES( 3, 'unknown' );",
+		},
+		selectInput: "SELECT tb1.id, COALESCE(tb1.name, tb2.city, '-'), IFNULL(tb2.city, 'none'), NULLIF(tb2.city, 'unknown') " +
+			"FROM tb1 LEFT JOIN tb2 ON tb1.id EQUAL tb2.id ORDER BY tb1.id ASC;",
+		expectedOutput: [][]string{
+			{"tb1.id", "COALESCE(tb1.name, tb2.city, '-')", "IFNULL(tb2.city, 'none')", "NULLIF(tb2.city, 'unknown')"},
+			{"1", "Anna", "Paris", "Paris"},
+			{"2", "Bob", "none", "NULL"},
+			{"3", "unknown", "unknown", "NULL"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestNullFunctionsInWhereAndOrderBy(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( 'a', 5 );",
+			"INSERT INTO tb1 VALUES( 'b', NULL );",
+			"INSERT INTO tb1 VALUES( 'c', 0 );",
+			"INSERT INTO tb1 VALUES( 'd', 2 );",
+		},
+		selectInput: "SELECT one, two FROM tb1 WHERE NULLIF(two, 0) NOT NULL OR one EQUAL 'b' ORDER BY COALESCE(two, 3) DESC;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"a", "5"},
+			{"b", "NULL"},
+			{"d", "2"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestTypeConversion(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( '10', 5 );",
+			"INSERT INTO tb1 VALUES( '9', 12 );",
+			"INSERT INTO tb1 VALUES( NULL, 1 );",
+			"INSERT INTO tb1 VALUES( '100', 7 );",
+		},
+		selectInput: "SELECT one, CAST(one AS INT), two::TEXT, CAST(two AS TEXT) || '!' FROM tb1 WHERE one NOT NULL ORDER BY one::INT ASC;",
+		expectedOutput: [][]string{
+			{"one", "CAST(one AS INT)", "two::TEXT", "CAST(two AS TEXT) || '!'"},
+			{"9", "9", "12", "12!"},
+			{"10", "10", "5", "5!"},
+			{"100", "100", "7", "7!"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestTypeConversionInWhereAndUpdate(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tb1( one TEXT, two INT );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tb1 VALUES( '10', 5 );",
+			"INSERT INTO tb1 VALUES( '9', 12 );",
+			"INSERT INTO tb1 VALUES( NULL, 1 );",
+			"UPDATE tb1 SET two TO CAST(one AS INT) WHERE one::INT IN (9, 10);",
+		},
+		selectInput: "SELECT one, two FROM tb1;",
+		expectedOutput: [][]string{
+			{"one", "two"},
+			{"10", "10"},
+			{"9", "9"},
+			{"NULL", "1"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestTemporalColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE events( name TEXT, day DATE, at TIMESTAMP, atTz TIMESTAMP WITH TIME ZONE, starts TIME, length INTERVAL );",
+	}
+	insertInputs := []string{
+		"INSERT INTO events VALUES( 'launch', '2024-01-31', '2024-01-31T10:30:00', '2024-01-31 10:30:00+02:00', '09:15', '1 day 02:00:00' );",
+		"INSERT INTO events VALUES( 'review', '2024-03-15', '2024-03-15 08:00:00.5', '2024-03-15 08:00:00Z', '23:30:00', '2 hours 30 minutes' );",
+		"INSERT INTO events VALUES( 'retro', '2023-12-29', '2023-12-29 17:45:00', '2023-12-29 17:45:00-05', '17:00', '-1 year 2 mons' );",
+		"INSERT INTO events VALUES( 'unknown', NULL, NULL, NULL, NULL, NULL );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM events WHERE day NOT NULL;",
+			expectedOutput: [][]string{
+				{"name", "day", "at", "atTz", "starts", "length"},
+				{"launch", "2024-01-31", "2024-01-31 10:30:00", "2024-01-31 08:30:00+00", "09:15:00", "1 day 02:00:00"},
+				{"review", "2024-03-15", "2024-03-15 08:00:00.5", "2024-03-15 08:00:00+00", "23:30:00", "02:30:00"},
+				{"retro", "2023-12-29", "2023-12-29 17:45:00", "2023-12-29 22:45:00+00", "17:00:00", "-10 mons"},
+			},
+		},
+		{
+			selectInput:    "SELECT name FROM events WHERE day < '2024-03-01' AND at >= TIMESTAMP '2024-01-01';",
+			expectedOutput: [][]string{{"name"}, {"launch"}},
+		},
+		{
+			selectInput:    "SELECT name FROM events WHERE day <= DATE '2024-01-31' OR starts > '23:00';",
+			expectedOutput: [][]string{{"name"}, {"launch"}, {"review"}, {"retro"}},
+		},
+		{
+			selectInput:    "SELECT name FROM events WHERE at > day AND day IN ('2024-01-31', '2023-12-29') AND length < INTERVAL '1 month';",
+			expectedOutput: [][]string{{"name"}, {"launch"}, {"retro"}},
+		},
+		{
+			selectInput:    "SELECT name, at FROM events WHERE atTz EQUAL '2024-03-15 10:00:00+02:00' OR day EQUAL '2023-12-29';",
+			expectedOutput: [][]string{{"name", "at"}, {"review", "2024-03-15 08:00:00.5"}, {"retro", "2023-12-29 17:45:00"}},
+		},
+		{
+			selectInput:    "SELECT name, day FROM events ORDER BY day DESC;",
+			expectedOutput: [][]string{{"name", "day"}, {"review", "2024-03-15"}, {"launch", "2024-01-31"}, {"retro", "2023-12-29"}, {"unknown", "NULL"}},
+		},
+		{
+			selectInput:    "SELECT MIN(day), MAX(at), MAX(length) FROM events;",
+			expectedOutput: [][]string{{"MIN(day)", "MAX(at)", "MAX(length)"}, {"NULL", "2024-03-15 08:00:00.5", "1 day 02:00:00"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestDateFunctionsResults(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE events( name TEXT, day DATE, at TIMESTAMP, starts TIME, length INTERVAL );",
+	}
+	insertInputs := []string{
+		"INSERT INTO events VALUES( 'launch', '2024-01-31', '2024-01-31 10:30:00', '09:15', '1 day 02:00:00' );",
+		"INSERT INTO events VALUES( 'review', '2024-03-15', '2024-03-15 08:00:00.5', '23:30:00', '2 hours 30 minutes' );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT name, EXTRACT(year FROM day), EXTRACT(dow FROM at), DATE_PART('doy', at), EXTRACT(hour FROM length) FROM events;",
+			expectedOutput: [][]string{
+				{"name", "EXTRACT(year FROM day)", "EXTRACT(dow FROM at)", "DATE_PART('doy', at)", "EXTRACT(hour FROM length)"},
+				{"launch", "2024", "3", "31", "2"},
+				{"review", "2024", "5", "75", "2"},
+			},
+		},
+		{
+			selectInput: "SELECT name, EXTRACT(quarter FROM day), EXTRACT(week FROM day), EXTRACT(millisecond FROM at), EXTRACT(epoch FROM day) FROM events;",
+			expectedOutput: [][]string{
+				{"name", "EXTRACT(quarter FROM day)", "EXTRACT(week FROM day)", "EXTRACT(millisecond FROM at)", "EXTRACT(epoch FROM day)"},
+				{"launch", "1", "5", "0", "1706659200"},
+				{"review", "1", "11", "500", "1710460800"},
+			},
+		},
+		{
+			selectInput: "SELECT name, DATE_TRUNC('month', at), DATE_TRUNC('week', day), DATE_TRUNC('hour', at), DATE_TRUNC('year', day) FROM events;",
+			expectedOutput: [][]string{
+				{"name", "DATE_TRUNC('month', at)", "DATE_TRUNC('week', day)", "DATE_TRUNC('hour', at)", "DATE_TRUNC('year', day)"},
+				{"launch", "2024-01-01 00:00:00", "2024-01-29", "2024-01-31 10:00:00", "2024-01-01"},
+				{"review", "2024-03-01 00:00:00", "2024-03-11", "2024-03-15 08:00:00", "2024-01-01"},
+			},
+		},
+		{
+			selectInput: "SELECT name, DATE_ADD(day, INTERVAL '1 month'), DATE_SUB(day, '1 day'), DATE_ADD(at, length), DATE_ADD(day, '1 hour') FROM events;",
+			expectedOutput: [][]string{
+				{"name", "DATE_ADD(day, '1 month'::INTERVAL)", "DATE_SUB(day, '1 day')", "DATE_ADD(at, length)", "DATE_ADD(day, '1 hour')"},
+				{"launch", "2024-02-29", "2024-01-30", "2024-02-01 12:30:00", "2024-01-31 01:00:00"},
+				{"review", "2024-04-15", "2024-03-14", "2024-03-15 10:30:00.5", "2024-03-15 01:00:00"},
+			},
+		},
+		{
+			selectInput: "SELECT name, day + 1, day - DATE '2024-01-01', at - TIMESTAMP '2024-03-16 09:00', starts + length, day + starts FROM events;",
+			expectedOutput: [][]string{
+				{"name", "day + 1", "day - '2024-01-01'::DATE", "at - '2024-03-16 09:00'::TIMESTAMP", "starts + length", "day + starts"},
+				{"launch", "2024-02-01", "30", "-44 days -22:30:00", "11:15:00", "2024-01-31 09:15:00"},
+				{"review", "2024-03-16", "74", "-1 days -00:59:59.5", "02:00:00", "2024-03-15 23:30:00"},
+			},
+		},
+		{
+			selectInput: "SELECT name, length * 2, -length, length + INTERVAL '1 year', starts - TIME '08:00', INTERVAL '1 day' + day FROM events;",
+			expectedOutput: [][]string{
+				{"name", "length * 2", "-length", "length + '1 year'::INTERVAL", "starts - '08:00'::TIME", "'1 day'::INTERVAL + day"},
+				{"launch", "2 days 04:00:00", "-1 days -02:00:00", "1 year 1 day 02:00:00", "01:15:00", "2024-02-01"},
+				{"review", "05:00:00", "-02:30:00", "1 year 02:30:00", "15:30:00", "2024-03-16"},
+			},
+		},
+		{
+			selectInput: "SELECT name, CAST(at AS DATE), day::TIMESTAMP, at::TIME, CAST('2024-01-31 10:00:00+02' AS TIMESTAMPTZ), day::TEXT FROM events;",
+			expectedOutput: [][]string{
+				{"name", "CAST(at AS DATE)", "day::TIMESTAMP", "at::TIME", "CAST('2024-01-31 10:00:00+02' AS TIMESTAMPTZ)", "day::TEXT"},
+				{"launch", "2024-01-31", "2024-01-31 00:00:00", "10:30:00", "2024-01-31 08:00:00+00", "2024-01-31"},
+				{"review", "2024-03-15", "2024-03-15 00:00:00", "08:00:00.5", "2024-01-31 08:00:00+00", "2024-03-15"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestTemporalColumnUpdate(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE events( name TEXT, day DATE, at TIMESTAMP );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO events VALUES( 'launch', '2024-01-31', '2024-01-31 10:30:00' );",
+			"INSERT INTO events VALUES( 'review', '2024-03-15', NULL );",
+			"UPDATE events SET day TO day + INTERVAL '1 week', at TO '2024-05-01T12:00:00' WHERE at EQUAL NULL;",
+			"UPDATE events SET at TO day + INTERVAL '8 hours' WHERE day < '2024-03-01';",
+			"DELETE FROM events WHERE day > '2024-12-31';",
+		},
+		selectInput: "SELECT name, day, at FROM events;",
+		expectedOutput: [][]string{
+			{"name", "day", "at"},
+			{"launch", "2024-01-31", "2024-01-31 08:00:00"},
+			{"review", "2024-03-22", "2024-05-01 12:00:00"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestIntegerTypeWidths(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE numbers( small SMALLINT, regular INT, big BIGINT );",
+	}
+	insertInputs := []string{
+		"INSERT INTO numbers VALUES( -32768, -2147483648, -9223372036854775808 );",
+		"INSERT INTO numbers VALUES( 32767, 2147483647, 9223372036854775807 );",
+		"INSERT INTO numbers VALUES( -5, +3, -1 );",
+		"UPDATE numbers SET small TO small -5, big TO big - -1 WHERE regular EQUAL 3;",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM numbers;",
+			expectedOutput: [][]string{
+				{"small", "regular", "big"},
+				{"-32768", "-2147483648", "-9223372036854775808"},
+				{"32767", "2147483647", "9223372036854775807"},
+				{"-10", "3", "0"},
+			},
+		},
+		{
+			selectInput:    "SELECT small FROM numbers WHERE big EQUAL -9223372036854775808 OR small < -9;",
+			expectedOutput: [][]string{{"small"}, {"-32768"}, {"-10"}},
+		},
+		{
+			selectInput:    "SELECT SUM(regular), SUM(small) FROM numbers WHERE regular > 0;",
+			expectedOutput: [][]string{{"SUM(regular)", "SUM(small)"}, {"2147483650", "32757"}},
+		},
+		{
+			selectInput:    "SELECT CAST(regular AS BIGINT) * 4 FROM numbers WHERE regular > 0;",
+			expectedOutput: [][]string{{"CAST(regular AS BIGINT) * 4"}, {"8589934588"}, {"12"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestCharacterTypes(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE people( code CHAR(4), name VARCHAR(5), initial CHAR, note VARCHAR );",
+	}
+	insertInputs := []string{
+		"INSERT INTO people VALUES( 'ab', 'Anna', 'A', 'first' );",
+		"INSERT INTO people VALUES( 'abcd', 'Ola   ', 'O', 'second note without limit' );",
+		"INSERT INTO people VALUES( NULL, NULL, NULL, NULL );",
+		"UPDATE people SET name TO 12345, code TO 'xy' WHERE initial EQUAL 'A';",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM people;",
+			expectedOutput: [][]string{
+				{"code", "name", "initial", "note"},
+				{"xy  ", "12345", "A", "first"},
+				{"abcd", "Ola  ", "O", "second note without limit"},
+				{"NULL", "NULL", "NULL", "NULL"},
+			},
+		},
+		{
+			selectInput:    "SELECT LENGTH(code), RTRIM(code) FROM people WHERE code EQUAL 'xy  ';",
+			expectedOutput: [][]string{{"LENGTH(code)", "RTRIM(code)"}, {"4", "xy"}},
+		},
+		{
+			selectInput:    "SELECT name FROM people WHERE code EQUAL 'xy' OR code IN ('abcd ');",
+			expectedOutput: [][]string{{"name"}, {"12345"}, {"Ola  "}},
+		},
+		{
+			selectInput:    "SELECT name FROM people WHERE code < 'xy' OR code > 'xy ';",
+			expectedOutput: [][]string{{"name"}, {"Ola  "}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestCharacterTypesInIndexes(t *testing.T) {
+	for _, method := range []string{"HASH", "BTREE"} {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs: []string{
+				"CREATE TABLE tbl( code CHAR(3) UNIQUE, name TEXT );",
+				"CREATE INDEX ON tbl USING " + method + " (code);",
+			},
+			insertAndDeleteInputs: []string{
+				"INSERT INTO tbl VALUES( 'ab', 'first' );",
+				"INSERT INTO tbl VALUES( 'abc', 'second' );",
+				"INSERT INTO tbl VALUES( 'a', 'third' );",
+			},
+			selectInput:    "SELECT name FROM tbl WHERE code EQUAL 'ab';",
+			expectedOutput: [][]string{{"name"}, {"first"}},
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestBlobColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE files( name TEXT, hash BYTEA, thumbnail BLOB );",
+	}
+	insertInputs := []string{
+		"INSERT INTO files VALUES( 'one', X'DEADBEEF', x'' );",
+		"INSERT INTO files VALUES( 'two', X'00ff', NULL );",
+		"INSERT INTO files VALUES( 'three', X'00', X'0102' );",
+		"UPDATE files SET thumbnail TO SUBSTR(hash, 2) WHERE name EQUAL 'two';",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM files;",
+			expectedOutput: [][]string{
+				{"name", "hash", "thumbnail"},
+				{"one", "\\xdeadbeef", "\\x"},
+				{"two", "\\x00ff", "\\xff"},
+				{"three", "\\x00", "\\x0102"},
+			},
+		},
+		{
+			selectInput:    "SELECT name, LENGTH(hash), SUBSTR(hash, 2, 2) FROM files WHERE hash > X'00' ORDER BY hash DESC;",
+			expectedOutput: [][]string{{"name", "LENGTH(hash)", "SUBSTR(hash, 2, 2)"}, {"one", "4", "\\xadbe"}, {"two", "2", "\\xff"}},
+		},
+		{
+			selectInput:    "SELECT name FROM files WHERE hash EQUAL X'deadbeef' OR thumbnail IN (X'0102');",
+			expectedOutput: [][]string{{"name"}, {"one"}, {"three"}},
+		},
+		{
+			selectInput: "SELECT name, hash || thumbnail, CONCAT(thumbnail, NULL, hash) FROM files;",
+			expectedOutput: [][]string{
+				{"name", "hash || thumbnail", "CONCAT(thumbnail, NULL, hash)"},
+				{"one", "\\xdeadbeef", "\\xdeadbeef"},
+				{"two", "\\x00ffff", "\\xff00ff"},
+				{"three", "\\x000102", "\\x010200"},
+			},
+		},
+		{
+			selectInput:    "SELECT name FROM files WHERE hash || hash EQUAL X'DEADBEEFDEADBEEF';",
+			expectedOutput: [][]string{{"name"}, {"one"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestJsonColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE events( id INT, payload JSON );",
+	}
+	insertInputs := []string{
+		`INSERT INTO events VALUES( 1, '{"user": {"name": "Anna", "age": 31}, "tags": ["a", "b"], "ok": true}' );`,
+		`INSERT INTO events VALUES( 2, '{"user": {"name": "Ola", "age": 25}, "tags": [], "price": 1.50, "note": null}' );`,
+		"INSERT INTO events VALUES( 3, '[1, 2, 3]' );",
+		"INSERT INTO events VALUES( 4, NULL );",
+		`UPDATE events SET payload TO '{"user": {"age": 40}}' WHERE id EQUAL 4;`,
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM events;",
+			expectedOutput: [][]string{
+				{"id", "payload"},
+				{"1", `{"ok":true,"tags":["a","b"],"user":{"age":31,"name":"Anna"}}`},
+				{"2", `{"note":null,"price":1.50,"tags":[],"user":{"age":25,"name":"Ola"}}`},
+				{"3", "[1,2,3]"},
+				{"4", `{"user":{"age":40}}`},
+			},
+		},
+		{
+			selectInput: "SELECT id, payload->'user'->>'name', payload->'tags', payload->-1, payload->>'note' FROM events;",
+			expectedOutput: [][]string{
+				{"id", "payload->'user'->>'name'", "payload->'tags'", "payload->-1", "payload->>'note'"},
+				{"1", "Anna", `["a","b"]`, "NULL", "NULL"},
+				{"2", "Ola", "[]", "NULL", "NULL"},
+				{"3", "NULL", "NULL", "3", "NULL"},
+				{"4", "NULL", "NULL", "NULL", "NULL"},
+			},
+		},
+		{
+			selectInput:    "SELECT id FROM events WHERE payload->'user'->>'age' > 30 AND JSON_EXTRACT(payload, '$.ok') IN (TRUE) ORDER BY id DESC;",
+			expectedOutput: [][]string{{"id"}, {"1"}},
+		},
+		{
+			selectInput:    "SELECT id FROM events ORDER BY payload->'user'->>'age' DESC;",
+			expectedOutput: [][]string{{"id"}, {"4"}, {"1"}, {"2"}, {"3"}},
+		},
+		{
+			selectInput:    "SELECT id, JSON_EXTRACT(payload, '$.user.age') FROM events WHERE JSON_EXTRACT(payload, '$.user.age') NOT NULL ORDER BY JSON_EXTRACT(payload, '$.user.age') ASC;",
+			expectedOutput: [][]string{{"id", "JSON_EXTRACT(payload, '$.user.age')"}, {"2", "25"}, {"1", "31"}, {"4", "40"}},
+		},
+		{
+			selectInput: `SELECT JSON_EXTRACT(payload, '$.tags[1]'), JSON_EXTRACT(payload, '$."price"'), JSON_ARRAY_LENGTH(payload, '$.tags'), JSON_KEYS(payload) FROM events WHERE id < 3;`,
+			expectedOutput: [][]string{
+				{"JSON_EXTRACT(payload, '$.tags[1]')", `JSON_EXTRACT(payload, '$."price"')`, "JSON_ARRAY_LENGTH(payload, '$.tags')", "JSON_KEYS(payload)"},
+				{"b", "NULL", "2", `["ok","tags","user"]`},
+				{"NULL", "1.50", "0", `["note","price","tags","user"]`},
+			},
+		},
+		{
+			selectInput:    "SELECT JSON_ARRAY_LENGTH(payload), JSON_KEYS(payload), payload::TEXT FROM events WHERE id EQUAL 3;",
+			expectedOutput: [][]string{{"JSON_ARRAY_LENGTH(payload)", "JSON_KEYS(payload)", "payload::TEXT"}, {"3", "NULL", "[1,2,3]"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestUuidColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE users( id UUID, name TEXT );",
+	}
+	insertInputs := []string{
+		"INSERT INTO users VALUES( 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'Anna' );",
+		"INSERT INTO users VALUES( '0E984725-C51C-4BF4-9960-E1C80E27ABA0', 'Ola' );",
+		"INSERT INTO users VALUES( 'f47ac10b58cc4372a5670e02b2c3d479', 'Jan' );",
+		"INSERT INTO users VALUES( NULL, 'Ewa' );",
+		"UPDATE users SET id TO '00000000-0000-0000-0000-000000000001' WHERE name EQUAL 'Ewa';",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM users ORDER BY id ASC;",
+			expectedOutput: [][]string{
+				{"id", "name"},
+				{"00000000-0000-0000-0000-000000000001", "Ewa"},
+				{"0e984725-c51c-4bf4-9960-e1c80e27aba0", "Ola"},
+				{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "Anna"},
+				{"f47ac10b-58cc-4372-a567-0e02b2c3d479", "Jan"},
+			},
+		},
+		{
+			selectInput:    "SELECT name FROM users WHERE id EQUAL 'A0EEBC999C0B4EF8BB6D6BB9BD380A11';",
+			expectedOutput: [][]string{{"name"}, {"Anna"}},
+		},
+		{
+			selectInput:    "SELECT name FROM users WHERE id > 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11' OR id IN ('0e984725-c51c-4bf4-9960-e1c80e27aba0') ORDER BY name ASC;",
+			expectedOutput: [][]string{{"name"}, {"Jan"}, {"Ola"}},
+		},
+		{
+			selectInput:    "SELECT id::TEXT, UPPER(id::TEXT)::UUID FROM users WHERE name EQUAL 'Jan';",
+			expectedOutput: [][]string{{"id::TEXT", "UPPER(id::TEXT)::UUID"}, {"f47ac10b-58cc-4372-a567-0e02b2c3d479", "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestEnumColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TYPE status AS ENUM ('new', 'active', 'closed');",
+		"CREATE TYPE stage AS ENUM ('closed', 'new');",
+		"CREATE TABLE tickets( id INT, state status, other stage );",
+	}
+	insertInputs := []string{
+		"INSERT INTO tickets VALUES( 1, 'closed', 'closed' );",
+		"INSERT INTO tickets VALUES( 2, 'new', NULL );",
+		"INSERT INTO tickets VALUES( 3, 'active', NULL );",
+		"INSERT INTO tickets VALUES( 4, NULL, NULL );",
+		"UPDATE tickets SET state TO 'new' WHERE id EQUAL 4;",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT id, state FROM tickets ORDER BY state ASC, id ASC;",
+			expectedOutput: [][]string{{"id", "state"}, {"2", "new"}, {"4", "new"}, {"3", "active"}, {"1", "closed"}},
+		},
+		{
+			selectInput:    "SELECT id FROM tickets WHERE state EQUAL other;",
+			expectedOutput: [][]string{{"id"}},
+		},
+		{
+			selectInput:    "SELECT id FROM tickets WHERE state::TEXT EQUAL other::TEXT;",
+			expectedOutput: [][]string{{"id"}, {"1"}},
+		},
+		{
+			selectInput:    "SELECT id FROM tickets WHERE state > 'new' ORDER BY state DESC;",
+			expectedOutput: [][]string{{"id"}, {"1"}, {"3"}},
+		},
+		{
+			selectInput:    "SELECT id FROM tickets WHERE state EQUAL 'new' OR state IN ('closed') ORDER BY id ASC;",
+			expectedOutput: [][]string{{"id"}, {"1"}, {"2"}, {"4"}},
+		},
+		{
+			selectInput:    "SELECT MIN(state), MAX(state) FROM tickets;",
+			expectedOutput: [][]string{{"MIN(state)", "MAX(state)"}, {"new", "closed"}},
+		},
+		{
+			selectInput:    "SELECT UPPER(state::TEXT) FROM tickets WHERE id EQUAL 3;",
+			expectedOutput: [][]string{{"UPPER(state::TEXT)"}, {"ACTIVE"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestArrayColumns(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE posts( id INT, tags TEXT[], scores INT[] );",
+	}
+	insertInputs := []string{
+		"INSERT INTO posts VALUES( 1, ARRAY['go', 'sql', 'hello world'], ARRAY[3, 1, 2] );",
+		"INSERT INTO posts VALUES( 2, ARRAY['rust'], ARRAY[] );",
+		"INSERT INTO posts VALUES( 3, NULL, ARRAY[5, NULL] );",
+		"INSERT INTO posts VALUES( 4, '{\"a b\",c}', '{7}' );",
+		"UPDATE posts SET tags TO ARRAY['x', 'y'] WHERE id EQUAL 3;",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM posts;",
+			expectedOutput: [][]string{{"id", "tags", "scores"}, {"1", "{go,sql,\"hello world\"}", "{3,1,2}"}, {"2", "{rust}", "{}"}, {"3", "{x,y}", "{5,NULL}"}, {"4", "{\"a b\",c}", "{7}"}},
+		},
+		{
+			selectInput:    "SELECT id, tags[1], scores[2], ARRAY_LENGTH(tags, 1), ARRAY_LENGTH(scores) FROM posts;",
+			expectedOutput: [][]string{{"id", "tags[1]", "scores[2]", "ARRAY_LENGTH(tags, 1)", "ARRAY_LENGTH(scores)"}, {"1", "go", "1", "3", "3"}, {"2", "rust", "NULL", "1", "NULL"}, {"3", "x", "NULL", "2", "2"}, {"4", "a b", "NULL", "2", "1"}},
+		},
+		{
+			selectInput:    "SELECT id FROM posts WHERE 'sql' EQUAL ANY(tags) OR 'c' EQUAL ANY(tags);",
+			expectedOutput: [][]string{{"id"}, {"1"}, {"4"}},
+		},
+		{
+			selectInput:    "SELECT id FROM posts WHERE 2 < ALL(scores);",
+			expectedOutput: [][]string{{"id"}, {"2"}, {"4"}},
+		},
+		{
+			selectInput:    "SELECT id, tags FROM posts ORDER BY tags DESC;",
+			expectedOutput: [][]string{{"id", "tags"}, {"3", "{x,y}"}, {"2", "{rust}"}, {"1", "{go,sql,\"hello world\"}"}, {"4", "{\"a b\",c}"}},
+		},
+		{
+			selectInput:    "SELECT ARRAY[1, 2.5], tags[-1] FROM posts WHERE id EQUAL 1;",
+			expectedOutput: [][]string{{"ARRAY[1, 2.5]", "tags[-1]"}, {"{1,2.5}", "NULL"}},
+		},
+		{
+			selectInput:    "SELECT * FROM UNNEST(ARRAY['x', 'y', 'z']) AS letter WHERE letter NOT 'y' ORDER BY letter DESC;",
+			expectedOutput: [][]string{{"letter"}, {"z"}, {"x"}},
+		},
+		{
+			selectInput:    "SELECT unnest FROM UNNEST(ARRAY[1, NULL, 2]);",
+			expectedOutput: [][]string{{"unnest"}, {"1"}, {"NULL"}, {"2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestPrimaryKey(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE users( id INT PRIMARY KEY, name TEXT );",
+		"CREATE TABLE members( team TEXT, user INT, role TEXT, PRIMARY KEY (team, user) );",
+	}
+	insertInputs := []string{
+		"INSERT INTO users VALUES( 1, 'Anna' );",
+		"INSERT INTO users VALUES( 2, 'Bob' );",
+		"INSERT INTO users VALUES( 3, 'Carl' );",
+		"UPDATE users SET id TO id + 1;",
+		"DELETE FROM users WHERE id EQUAL 2;",
+		"INSERT INTO users VALUES( 1, 'Dave' );",
+		"INSERT INTO members VALUES( 'red', 1, 'owner' );",
+		"INSERT INTO members VALUES( 'red', 2, 'guest' );",
+		"INSERT INTO members VALUES( 'blue', 1, 'guest' );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM users ORDER BY id ASC;",
+			expectedOutput: [][]string{{"id", "name"}, {"1", "Dave"}, {"3", "Bob"}, {"4", "Carl"}},
+		},
+		{
+			selectInput:    "SELECT * FROM members ORDER BY team ASC, user ASC;",
+			expectedOutput: [][]string{{"team", "user", "role"}, {"blue", "1", "guest"}, {"red", "1", "owner"}, {"red", "2", "guest"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestColumnConstraints(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE products( id INT PRIMARY KEY, name TEXT NOT NULL, price DECIMAL DEFAULT 9.99 CHECK (price > 0), qty INT DEFAULT 1 + 1, CONSTRAINT qty_limit CHECK (qty <= 100) );",
+	}
+	insertInputs := []string{
+		"INSERT INTO products (id, name) VALUES( 1, 'pen' );",
+		"INSERT INTO products VALUES( 2, 'book', DEFAULT, 5 );",
+		"INSERT INTO products (name, id, price) VALUES( 'ink', 3, NULL );",
+		"UPDATE products SET qty TO DEFAULT, price TO 1.5 WHERE id EQUAL 2;",
+		"DELETE FROM products WHERE id EQUAL 1;",
+		"INSERT INTO products (id, name, qty) VALUES( 4, 'cup', 100 );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM products ORDER BY id ASC;",
+			expectedOutput: [][]string{{"id", "name", "price", "qty"}, {"2", "book", "1.5", "2"}, {"3", "ink", "NULL", "2"}, {"4", "cup", "9.99", "100"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestPrimaryKeyIndexFollowsChangesOfRows(t *testing.T) {
+	input := "CREATE TABLE users( id INT PRIMARY KEY, name TEXT );" +
+		"INSERT INTO users VALUES( 1, 'Anna' );" +
+		"INSERT INTO users VALUES( 2, 'Bob' );" +
+		"INSERT INTO users VALUES( 3, 'Carl' );" +
+		"DELETE FROM users WHERE id EQUAL 1;" +
+		"UPDATE users SET id TO 5 WHERE id EQUAL 3;"
+	expectedRows := map[string]int{`"2"`: 0, `"5"`: 1}
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	primaryKey := engine.Tables["users"].primaryKey
+	if len(primaryKey.rows) != len(expectedRows) {
+		t.Fatalf("Index should contain %d keys, got: %v", len(expectedRows), primaryKey.rows)
+	}
+	for key, rowIndex := range expectedRows {
+		if actualRowIndex, exists := primaryKey.rows[key]; !exists || actualRowIndex != rowIndex {
+			t.Errorf("Key %s should point to row %d, got: %v", key, rowIndex, primaryKey.rows)
+		}
+	}
+}
+
+func TestUpdateOfKeysChangesOnlyKeysOfUpdatedRows(t *testing.T) {
+	input := "CREATE TABLE users( id INT PRIMARY KEY, name TEXT );" +
+		"INSERT INTO users VALUES( 1, 'Anna' );" +
+		"INSERT INTO users VALUES( 2, 'Bob' );" +
+		"INSERT INTO users VALUES( 3, 'Carl' );" +
+		"UPDATE users SET id TO 4 - id WHERE id > 1 OR name EQUAL 'Anna';"
+	expectedRows := map[string]int{`"3"`: 0, `"2"`: 1, `"1"`: 2}
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_, err = engine.Evaluate(getSequences("UPDATE users SET id TO id + 1 WHERE id < 3;"))
+	if err == nil {
+		t.Fatalf("Update duplicating key should fail")
+	}
+
+	primaryKey := engine.Tables["users"].primaryKey
+	if len(primaryKey.rows) != len(expectedRows) {
+		t.Fatalf("Index should contain %d keys, got: %v", len(expectedRows), primaryKey.rows)
+	}
+	for key, rowIndex := range expectedRows {
+		if actualRowIndex, exists := primaryKey.rows[key]; !exists || actualRowIndex != rowIndex {
+			t.Errorf("Key %s should point to row %d, got: %v", key, rowIndex, primaryKey.rows)
+		}
+	}
+}
+
+func TestUniqueConstraints(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE users( id INT PRIMARY KEY, email TEXT UNIQUE, phone TEXT UNIQUE NULLS NOT DISTINCT, team INT, nick TEXT, UNIQUE (team, nick) );",
+	}
+	insertInputs := []string{
+		"INSERT INTO users VALUES( 1, 'anna@mail.com', '123', 1, 'ann' );",
+		"INSERT INTO users VALUES( 2, NULL, NULL, 1, NULL );",
+		"INSERT INTO users VALUES( 3, NULL, '456', 1, NULL );",
+		"INSERT INTO users VALUES( 4, 'bob@mail.com', '789', 2, 'ann' );",
+		"UPDATE users SET phone TO '000' WHERE id EQUAL 4;",
+		"UPDATE users SET team TO 3 - team WHERE nick EQUAL 'ann';",
+		"DELETE FROM users WHERE id EQUAL 1;",
+		"INSERT INTO users VALUES( 5, 'anna@mail.com', '123', 2, 'ann' );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput: "SELECT * FROM users ORDER BY id ASC;",
+			expectedOutput: [][]string{
+				{"id", "email", "phone", "team", "nick"},
+				{"2", "NULL", "NULL", "1", "NULL"},
+				{"3", "NULL", "456", "1", "NULL"},
+				{"4", "bob@mail.com", "000", "1", "ann"},
+				{"5", "anna@mail.com", "123", "2", "ann"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE users( id INT PRIMARY KEY, name TEXT );",
+		"CREATE TABLE orders( id INT PRIMARY KEY, user_id INT REFERENCES users ON DELETE CASCADE ON UPDATE CASCADE );",
+		"CREATE TABLE notes( id INT, order_id INT, FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE SET NULL );",
+	}
+	insertInputs := []string{
+		"INSERT INTO users VALUES( 1, 'Anna' );",
+		"INSERT INTO users VALUES( 2, 'Bob' );",
+		"INSERT INTO orders VALUES( 10, 1 );",
+		"INSERT INTO orders VALUES( 20, 2 );",
+		"INSERT INTO orders VALUES( 21, 2 );",
+		"INSERT INTO orders VALUES( 30, NULL );",
+		"INSERT INTO notes VALUES( 1, 10 );",
+		"INSERT INTO notes VALUES( 2, 21 );",
+		"UPDATE users SET id TO 5 WHERE id EQUAL 1;",
+		"DELETE FROM users WHERE id EQUAL 2;",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM users;",
+			expectedOutput: [][]string{{"id", "name"}, {"5", "Anna"}},
+		},
+		{
+			selectInput:    "SELECT * FROM orders;",
+			expectedOutput: [][]string{{"id", "user_id"}, {"10", "5"}, {"30", "NULL"}},
+		},
+		{
+			selectInput:    "SELECT * FROM notes;",
+			expectedOutput: [][]string{{"id", "order_id"}, {"1", "10"}, {"2", "NULL"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestSelfReferencingForeignKey(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE employees( id INT PRIMARY KEY, manager INT REFERENCES employees (id) ON DELETE CASCADE ON UPDATE CASCADE );",
+	}
+	insertInputs := []string{
+		"INSERT INTO employees VALUES( 1, NULL );",
+		"INSERT INTO employees VALUES( 2, 1 );",
+		"INSERT INTO employees VALUES( 3, 2 );",
+		"INSERT INTO employees VALUES( 4, 4 );",
+		"INSERT INTO employees VALUES( 5, 4 );",
+		"UPDATE employees SET id TO 6 WHERE id EQUAL 4;",
+		"DELETE FROM employees WHERE id EQUAL 1;",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM employees;",
+			expectedOutput: [][]string{{"id", "manager"}, {"6", "6"}, {"5", "6"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestDropTableCascadeDropsForeignKeys(t *testing.T) {
+	input := "CREATE TABLE users( id INT PRIMARY KEY ); CREATE TABLE orders( id INT, user_id INT REFERENCES users );" +
+		"INSERT INTO users VALUES( 1 ); INSERT INTO orders VALUES( 1, 1 );" +
+		"DROP TABLE users CASCADE;" +
+		"INSERT INTO orders VALUES( 2, 7 );"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, exists := engine.Tables["users"]; exists {
+		t.Errorf("Table users should be dropped")
+	}
+	if len(engine.Tables["orders"].foreignKeys) != 0 {
+		t.Errorf("Foreign key of table orders should be dropped, got: %v", engine.Tables["orders"].foreignKeys)
+	}
+}
+
+func TestSequences(t *testing.T) {
+	createInputs := []string{
+		"CREATE SEQUENCE ids INCREMENT BY 10 START WITH 100;",
+		"CREATE SEQUENCE countdown INCREMENT -1 MINVALUE 1 MAXVALUE 3 CYCLE;",
+		"CREATE TABLE tbl( id SERIAL, code BIGINT GENERATED BY DEFAULT AS IDENTITY, ref INT DEFAULT NEXTVAL('ids'), down INT DEFAULT NEXTVAL('countdown') );",
+	}
+	insertInputs := []string{
+		"INSERT INTO tbl (down) VALUES( DEFAULT );",
+		"INSERT INTO tbl (code) VALUES( 50 );",
+		"INSERT INTO tbl (id) VALUES( DEFAULT );",
+		"INSERT INTO tbl VALUES( DEFAULT, DEFAULT, DEFAULT, DEFAULT );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM tbl;",
+			expectedOutput: [][]string{{"id", "code", "ref", "down"}, {"1", "1", "100", "3"}, {"2", "50", "110", "2"}, {"3", "2", "120", "1"}, {"4", "3", "130", "3"}},
+		},
+		{
+			selectInput:    "SELECT CURRVAL('ids'), NEXTVAL('tbl_id_seq'), SETVAL('tbl_code_seq', 10), SETVAL('countdown', 2, FALSE) FROM tbl LIMIT 1;",
+			expectedOutput: [][]string{{"CURRVAL('ids')", "NEXTVAL('tbl_id_seq')", "SETVAL('tbl_code_seq', 10)", "SETVAL('countdown', 2, FALSE)"}, {"130", "5", "10", "2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestSetvalChangesNextValueOfSequence(t *testing.T) {
+	input := "CREATE TABLE tbl( id INT GENERATED ALWAYS AS IDENTITY, name TEXT );" +
+		"INSERT INTO tbl (name) VALUES( 'a' ); SELECT SETVAL('tbl_id_seq', 10) FROM tbl;" +
+		"INSERT INTO tbl (name) VALUES( 'b' ); SELECT SETVAL('tbl_id_seq', 20, FALSE) FROM tbl LIMIT 1;" +
+		"INSERT INTO tbl (name) VALUES( 'c' );"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedIds := []int64{1, 11, 20}
+	for i, value := range engine.Tables["tbl"].Columns[0].Values {
+		if value != (IntegerValue{Value: expectedIds[i]}) {
+			t.Errorf("Row %d should have id %d, got: %v", i, expectedIds[i], value)
+		}
+	}
+}
+
+func TestDropTableDropsOwnedSequences(t *testing.T) {
+	input := "CREATE SEQUENCE shared; CREATE TABLE tbl( id SERIAL, other INT DEFAULT NEXTVAL('shared') ); DROP TABLE tbl;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, exists := engine.Sequences["tbl_id_seq"]; exists {
+		t.Errorf("Sequence tbl_id_seq should be dropped together with table")
+	}
+	if _, exists := engine.Sequences["shared"]; !exists {
+		t.Errorf("Sequence shared isn't owned by table and shouldn't be dropped")
+	}
+}
+
+func TestConcurrentInsertsIntoSerialColumn(t *testing.T) {
+	const clients, insertsPerClient = 8, 25
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( id SERIAL PRIMARY KEY, client INT );"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var waitGroup sync.WaitGroup
+	errors := make(chan error, clients*insertsPerClient)
+	for client := 0; client < clients; client++ {
+		waitGroup.Add(1)
+		go func(client int) {
+			defer waitGroup.Done()
+			for i := 0; i < insertsPerClient; i++ {
+				_, err := engine.Evaluate(getSequences("INSERT INTO tbl (client) VALUES( " + strconv.Itoa(client) + " );"))
+				errors <- err
+			}
+		}(client)
+	}
+	waitGroup.Wait()
+	close(errors)
+
+	for err := range errors {
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	}
+	ids := engine.Tables["tbl"].Columns[0].Values
+	if len(ids) != clients*insertsPerClient {
+		t.Fatalf("Table should have %d rows, got: %d", clients*insertsPerClient, len(ids))
+	}
+	for i, id := range ids {
+		if id != (IntegerValue{Value: int64(i + 1)}) {
+			t.Errorf("Row %d should have id %d, got: %v", i, i+1, id)
+		}
+	}
+}
+
+func TestIndexedQueriesReturnSameRowsAsScan(t *testing.T) {
+	input := "CREATE TABLE tbl( id INT, name TEXT, price DECIMAL, created DATE );" +
+		"INSERT INTO tbl VALUES( 1, 'a', 1.50, '2024-01-01' ); INSERT INTO tbl VALUES( 2, 'b', 2.5, '2024-02-01' );" +
+		"INSERT INTO tbl VALUES( 3, 'c', NULL, '2024-03-01' ); INSERT INTO tbl VALUES( 4, 'b', 1.5, NULL );" +
+		"INSERT INTO tbl VALUES( NULL, 'd', 4, '2024-01-01' ); INSERT INTO tbl VALUES( 6, NULL, 7, '2024-05-01' );"
+	indexes := "CREATE INDEX ON tbl USING HASH (name); CREATE INDEX ON tbl (price); CREATE INDEX ON tbl (created);" +
+		"CREATE INDEX ON tbl USING HASH (id); CREATE INDEX ON tbl USING BTREE (id);"
+
+	queries := []string{
+		"SELECT * FROM tbl WHERE name EQUAL 'b';",
+		"SELECT * FROM tbl WHERE name EQUAL NULL;",
+		"SELECT * FROM tbl WHERE id EQUAL 1.5;",
+		"SELECT * FROM tbl WHERE id EQUAL 2.0;",
+		"SELECT * FROM tbl WHERE price EQUAL 1.5;",
+		"SELECT * FROM tbl WHERE price >= 2 OR name IN ('a', 'd');",
+		"SELECT * FROM tbl WHERE created > '2024-01-15' AND id < 10;",
+		"SELECT * FROM tbl WHERE 2 < id AND price <= 7;",
+		"SELECT * FROM tbl WHERE id EQUAL NULL;",
+		"SELECT * FROM tbl WHERE id IN (1, 4, 9) ORDER BY id DESC;",
+		"SELECT * FROM tbl WHERE name NOTIN ('b') AND price > 1;",
+		"SELECT * FROM tbl WHERE id EQUAL 1 OR name NOT 'b';",
+		"SELECT * FROM tbl WHERE id > 1 + 1;",
+	}
+
+	for _, query := range queries {
+		expectedOutput, err := New().Evaluate(getSequences(input + query))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q without indexes: %s", query, err)
+		}
+		actualOutput, err := New().Evaluate(getSequences(input + indexes + query))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q with indexes: %s", query, err)
+		}
+		expectedRows := expectedOutput[strings.Index(expectedOutput, "+"):]
+		actualRows := actualOutput[strings.Index(actualOutput, "+"):]
+		if expectedRows != actualRows {
+			t.Errorf("Query %q should return the same rows with indexes\nexpected:\n%s\ngot:\n%s", query, expectedRows, actualRows)
+		}
+	}
+}
+
+func TestIndexesAreUsedForConditions(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( id INT, name TEXT );" +
+		"INSERT INTO tbl VALUES( 1, 'a' ); INSERT INTO tbl VALUES( 2, 'b' ); INSERT INTO tbl VALUES( 3, 'b' );" +
+		"CREATE INDEX ON tbl USING HASH (name); CREATE INDEX ON tbl (id);"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		condition    string
+		expectedRows []int
+	}{
+		{"name EQUAL 'b'", []int{1, 2}},
+		{"id >= 2", []int{1, 2}},
+		{"id IN (1, 3)", []int{0, 2}},
+		{"id < 3 AND name EQUAL 'b'", []int{1}},
+		{"id EQUAL 1 OR name EQUAL 'b'", []int{0, 1, 2}},
+		{"name > 'a'", nil},
+		{"id EQUAL 1 OR name > 'a'", nil},
+	}
+
+	for _, tt := range tests {
+		sequences := getSequences("SELECT * FROM tbl WHERE " + tt.condition + ";")
+		whereCommand := sequences.Commands[0].(*ast.SelectCommand).WhereCommand
+		rows, isIndexed := engine.getIndexedRows(engine.Tables["tbl"], whereCommand.Expression)
+		if tt.expectedRows == nil {
+			if isIndexed {
+				t.Errorf("Indexes shouldn't be used for %q, got rows: %v", tt.condition, rows)
+			}
+			continue
+		}
+		candidateRows := engine.getCandidateRows(engine.Tables["tbl"], whereCommand.Expression)
+		if !isIndexed || !slices.Equal(candidateRows, tt.expectedRows) {
+			t.Errorf("Indexes should return rows %v for %q, got: %v", tt.expectedRows, tt.condition, candidateRows)
+		}
+	}
+}
+
+func TestIndexesFollowChangesOfRows(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE tbl( id INT, name TEXT );",
+		"CREATE INDEX by_name ON tbl USING HASH (name);",
+		"CREATE INDEX by_id ON tbl (id);",
+	}
+	insertInputs := []string{
+		"INSERT INTO tbl VALUES( 3, 'c' );",
+		"INSERT INTO tbl VALUES( 1, 'a' );",
+		"INSERT INTO tbl VALUES( 2, 'b' );",
+		"UPDATE tbl SET id TO 5, name TO 'e' WHERE name EQUAL 'a';",
+		"DELETE FROM tbl WHERE id < 3;",
+		"INSERT INTO tbl VALUES( 4, 'e' );",
+	}
+
+	tests := []struct {
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			selectInput:    "SELECT * FROM tbl WHERE name EQUAL 'e';",
+			expectedOutput: [][]string{{"id", "name"}, {"5", "e"}, {"4", "e"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tbl WHERE id >= 4;",
+			expectedOutput: [][]string{{"id", "name"}, {"5", "e"}, {"4", "e"}},
+		},
+		{
+			selectInput:    "SELECT * FROM tbl WHERE id < 4;",
+			expectedOutput: [][]string{{"id", "name"}, {"3", "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: insertInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestIndexesChangedRowByRowMatchRebuiltIndexes(t *testing.T) {
+	input := "CREATE TABLE tbl( id INT PRIMARY KEY, name TEXT UNIQUE, age INT );" +
+		"CREATE INDEX by_name ON tbl USING HASH (name);" +
+		"CREATE INDEX by_age ON tbl (age) INCLUDE (name);"
+	for i := 0; i < 20; i++ {
+		input += "INSERT INTO tbl VALUES( " + strconv.Itoa(i) + ", 'n" + strconv.Itoa(i) + "', " + strconv.Itoa(i%4) + " );"
+	}
+	input += "DELETE FROM tbl WHERE age EQUAL 1;" +
+		"UPDATE tbl SET age TO 7 - age, name TO 'x' || name WHERE id > 10;" +
+		"DELETE FROM tbl WHERE id EQUAL 4;" +
+		"UPDATE tbl SET id TO id + 100 WHERE age EQUAL 5;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	table := engine.Tables["tbl"]
+	for _, index := range table.getIndexes() {
+		rows := maps.Clone(index.rows)
+		err = index.rebuild(table, "tbl")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !maps.Equal(rows, index.rows) {
+			t.Errorf("Keys of index should be %v, got: %v", index.rows, rows)
+		}
+	}
+	for _, index := range table.indexes {
+		hashRows, entries := maps.Clone(index.hashRows), slices.Clone(index.entries)
+		index.rebuild(table)
+		for key, keyRows := range index.hashRows {
+			changedRows := slices.Clone(hashRows[key])
+			slices.Sort(changedRows)
+			if !slices.Equal(changedRows, keyRows) {
+				t.Errorf("Index %s should contain rows %v under key %s, got: %v", index.name, keyRows, key, hashRows[key])
+			}
+		}
+		if len(hashRows) != len(index.hashRows) {
+			t.Errorf("Index %s should contain %d keys, got: %d", index.name, len(index.hashRows), len(hashRows))
+		}
+		if !slices.EqualFunc(entries, index.entries, func(first indexEntry, second indexEntry) bool {
+			return first.row == second.row && slices.EqualFunc(first.values, second.values, ValueInterface.IsEqual)
+		}) {
+			t.Errorf("Index %s should contain entries %v, got: %v", index.name, index.entries, entries)
+		}
+	}
+}
+
+func TestFailedCascadeRevertsChangedRowsAndIndexes(t *testing.T) {
+	input := "CREATE TABLE users( id INT PRIMARY KEY, name TEXT UNIQUE );" +
+		"CREATE TABLE orders( id INT PRIMARY KEY, user_id INT REFERENCES users ON DELETE CASCADE ON UPDATE CASCADE );" +
+		"CREATE TABLE notes( id INT, order_id INT, FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE SET NULL );" +
+		"CREATE TABLE invoices( id INT, user_id INT REFERENCES users );" +
+		"CREATE INDEX ON orders USING HASH (user_id); CREATE INDEX ON notes (order_id) INCLUDE (id);"
+	for i := 0; i < 10; i++ {
+		id := strconv.Itoa(i)
+		input += "INSERT INTO users VALUES( " + id + ", 'u" + id + "' );" +
+			"INSERT INTO orders VALUES( " + id + ", " + strconv.Itoa(i/2) + " );"
+	}
+	for i := 0; i < 10; i++ {
+		input += "INSERT INTO notes VALUES( " + strconv.Itoa(i) + ", " + strconv.Itoa(9-i) + " );"
+	}
+	input += "INSERT INTO invoices VALUES( 1, 3 );"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	getState := func() string {
+		state := ""
+		for _, tableName := range []string{"users", "orders", "notes", "invoices"} {
+			table := engine.Tables[tableName]
+			state += table.ToString()
+			for _, index := range table.getIndexes() {
+				state += fmt.Sprint(index.rows)
+			}
+			for _, index := range table.indexes {
+				for _, rows := range index.hashRows {
+					slices.Sort(rows)
+				}
+				state += fmt.Sprint(index.hashRows, index.entries)
+			}
+		}
+		return state
+	}
+
+	expectedState := getState()
+	for _, command := range []string{
+		"DELETE FROM users WHERE id > 1 AND id < 8;",
+		"UPDATE users SET id TO id + 10, name TO 'x' || name WHERE id < 5;",
+	} {
+		_, err = engine.Evaluate(getSequences(command))
+		if _, ok := err.(*ReferencedKeyError); !ok {
+			t.Fatalf("Command %s should fail with ReferencedKeyError, got: %v", command, err)
+		}
+		state := getState()
+		if state != expectedState {
+			t.Errorf("Tables and indexes changed by %s should be reverted to:\n%s\ngot:\n%s", command, expectedState, state)
+		}
+	}
+}
+
+func TestDropIndex(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( id INT ); CREATE INDEX ON tbl (id); CREATE INDEX ON tbl (id);" +
+		"DROP INDEX tbl_id_idx;"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	indexes := engine.Tables["tbl"].indexes
+	if len(indexes) != 1 || indexes[0].name != "tbl_id_idx1" {
+		t.Errorf("Only index tbl_id_idx1 should be left, got: %v", indexes)
+	}
+}
+
+func TestCompositeIndexQueriesReturnSameRowsAsScan(t *testing.T) {
+	input := "CREATE TABLE tbl( tenant INT, created DATE, amount INT, note TEXT );" +
+		"INSERT INTO tbl VALUES( 1, '2024-03-01', 10, 'a' ); INSERT INTO tbl VALUES( 2, '2024-01-01', 20, 'b' );" +
+		"INSERT INTO tbl VALUES( 1, '2024-01-01', 30, 'c' ); INSERT INTO tbl VALUES( 1, NULL, 40, 'd' );" +
+		"INSERT INTO tbl VALUES( 2, '2024-02-01', NULL, 'e' ); INSERT INTO tbl VALUES( NULL, '2024-02-01', 60, 'f' );" +
+		"INSERT INTO tbl VALUES( 1, '2024-03-01', 70, 'g' ); INSERT INTO tbl VALUES( 2, '2024-01-01', 80, 'h' );"
+	indexes := "CREATE INDEX ON tbl (tenant, created) INCLUDE (amount); CREATE INDEX ON tbl (created);"
+
+	queries := []string{
+		"SELECT * FROM tbl WHERE tenant EQUAL 1 AND created >= '2024-02-01';",
+		"SELECT * FROM tbl WHERE created > '2024-01-01' AND tenant EQUAL 1 AND created <= '2024-03-01';",
+		"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY created ASC;",
+		"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY created DESC;",
+		"SELECT * FROM tbl ORDER BY tenant ASC, created ASC;",
+		"SELECT * FROM tbl ORDER BY tenant DESC, created DESC;",
+		"SELECT * FROM tbl ORDER BY created ASC;",
+		"SELECT * FROM tbl ORDER BY created DESC;",
+		"SELECT * FROM tbl ORDER BY tenant ASC, created DESC;",
+		"SELECT * FROM tbl WHERE tenant IN (1, 2) ORDER BY created ASC;",
+		"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY created ASC, amount ASC;",
+		"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY note ASC;",
+		"SELECT tenant, created, amount FROM tbl WHERE tenant EQUAL 2 AND created < '2024-03-01';",
+		"SELECT amount FROM tbl WHERE tenant EQUAL 1 ORDER BY created DESC LIMIT 2;",
+		"SELECT SUM(amount) FROM tbl WHERE tenant EQUAL 1;",
+		"SELECT COUNT(*) FROM tbl WHERE tenant > 1;",
+		"SELECT DISTINCT tenant FROM tbl ORDER BY tenant ASC;",
+		"SELECT created FROM tbl WHERE created >= '2024-02-01' ORDER BY created DESC;",
+		"SELECT amount FROM tbl WHERE tenant EQUAL 1 AND amount > 20;",
+		"SELECT * FROM tbl WHERE tenant EQUAL 3 ORDER BY created ASC;",
+	}
+
+	for _, query := range queries {
+		expectedOutput, err := New().Evaluate(getSequences(input + query))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q without indexes: %s", query, err)
+		}
+		actualOutput, err := New().Evaluate(getSequences(input + indexes + query))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q with indexes: %s", query, err)
+		}
+		expectedRows := expectedOutput[strings.Index(expectedOutput, "+"):]
+		actualRows := actualOutput[strings.Index(actualOutput, "+"):]
+		if expectedRows != actualRows {
+			t.Errorf("Query %q should return the same rows with indexes\nexpected:\n%s\ngot:\n%s", query, expectedRows, actualRows)
+		}
+	}
+}
+
+func TestCompositeIndexesAreUsedForConditions(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( tenant INT, created INT );" +
+		"INSERT INTO tbl VALUES( 1, 5 ); INSERT INTO tbl VALUES( 2, 1 ); INSERT INTO tbl VALUES( 1, 3 );" +
+		"INSERT INTO tbl VALUES( 1, 9 ); CREATE INDEX ON tbl (tenant, created);"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		condition    string
+		expectedRows []int
+	}{
+		{"tenant EQUAL 1", []int{0, 2, 3}},
+		{"tenant EQUAL 1 AND created EQUAL 3", []int{2}},
+		{"tenant EQUAL 1 AND created > 3", []int{0, 3}},
+		{"created <= 5 AND tenant EQUAL 1 AND created > 3", []int{0}},
+		{"tenant > 1 AND created EQUAL 3", []int{1}},
+		{"created EQUAL 3", nil},
+	}
+
+	for _, tt := range tests {
+		sequences := getSequences("SELECT * FROM tbl WHERE " + tt.condition + ";")
+		whereCommand := sequences.Commands[0].(*ast.SelectCommand).WhereCommand
+		rows, isIndexed := engine.getIndexedRows(engine.Tables["tbl"], whereCommand.Expression)
+		if tt.expectedRows == nil {
+			if isIndexed {
+				t.Errorf("Indexes shouldn't be used for %q, got rows: %v", tt.condition, rows)
+			}
+			continue
+		}
+		candidateRows := engine.getCandidateRows(engine.Tables["tbl"], whereCommand.Expression)
+		if !isIndexed || !slices.Equal(candidateRows, tt.expectedRows) {
+			t.Errorf("Indexes should return rows %v for %q, got: %v", tt.expectedRows, tt.condition, candidateRows)
+		}
+	}
+}
+
+func TestIndexesAreUsedForOrderBy(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( tenant INT, created INT, note TEXT );" +
+		"INSERT INTO tbl VALUES( 1, 2, 'a' ); INSERT INTO tbl VALUES( 2, 1, 'b' );" +
+		"CREATE INDEX ON tbl (tenant, created); CREATE INDEX ON tbl USING HASH (note);"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		query     string
+		isIndexed bool
+	}{
+		{"SELECT * FROM tbl ORDER BY tenant ASC;", true},
+		{"SELECT * FROM tbl ORDER BY tenant DESC, created DESC;", true},
+		{"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY created ASC;", true},
+		{"SELECT * FROM tbl WHERE tenant EQUAL 1 ORDER BY tenant ASC, created DESC;", false},
+		{"SELECT * FROM tbl ORDER BY created ASC;", false},
+		{"SELECT * FROM tbl WHERE tenant > 1 ORDER BY created ASC;", false},
+		{"SELECT * FROM tbl ORDER BY note ASC;", false},
+		{"SELECT * FROM tbl WHERE tenant EQUAL 1;", false},
+		{"SELECT tenant, created FROM tbl WHERE tenant EQUAL 1;", true},
+		{"SELECT tenant FROM tbl WHERE note EQUAL 'a';", false},
+	}
+
+	for _, tt := range tests {
+		selectCommand := getSequences(tt.query).Commands[0].(*ast.SelectCommand)
+		indexedTable, err := engine.selectWithIndex(selectCommand, engine.Tables["tbl"])
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %s", tt.query, err)
+		}
+		if (indexedTable != nil) != tt.isIndexed {
+			t.Errorf("Usage of index for %q should be %t, got: %t", tt.query, tt.isIndexed, indexedTable != nil)
+		}
+	}
+}
+
+func TestCoveringIndexDoesNotReadTable(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( tenant INT, created INT, amount INT, note TEXT );" +
+		"INSERT INTO tbl VALUES( 1, 2, 10, 'a' ); INSERT INTO tbl VALUES( 1, 1, 20, 'b' ); INSERT INTO tbl VALUES( 2, 1, 30, 'c' );" +
+		"CREATE INDEX ON tbl (tenant, created) INCLUDE (amount); UPDATE tbl SET amount TO 25 WHERE note EQUAL 'b';"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// Values of columns stored in the index are replaced, so they can be returned only from the index
+	table := engine.Tables["tbl"]
+	for _, column := range table.Columns[:3] {
+		column.Values = []ValueInterface{NullValue{}, NullValue{}, NullValue{}}
+	}
+
+	tests := []struct {
+		query          string
+		expectedOutput string
+	}{
+		{"SELECT created, amount FROM tbl WHERE tenant EQUAL 1 ORDER BY created ASC;", "+---------+--------+\n" +
+			"| created | amount |\n" +
+			"+---------+--------+\n" +
+			"|       1 |     25 |\n" +
+			"|       2 |     10 |\n" +
+			"+---------+--------+\n"},
+		{"SELECT SUM(amount) FROM tbl WHERE tenant EQUAL 1 AND created > 1;", "+-------------+\n" +
+			"| SUM(amount) |\n" +
+			"+-------------+\n" +
+			"|          10 |\n" +
+			"+-------------+\n"},
+	}
+
+	for _, tt := range tests {
+		actualOutput, err := engine.Evaluate(getSequences(tt.query))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %s", tt.query, err)
+		}
+		actualRows := actualOutput[strings.Index(actualOutput, "+"):]
+		if actualRows != tt.expectedOutput {
+			t.Errorf("Query %q should return values from the index\nexpected:\n%s\ngot:\n%s", tt.query, tt.expectedOutput, actualRows)
+		}
+	}
+}
+
+func TestIfExistsMakesScriptsRepeatable(t *testing.T) {
+	script := "DROP TABLE IF EXISTS old; CREATE TABLE IF NOT EXISTS tbl( one INT ); INSERT INTO tbl VALUES( 1 );"
+
+	engine := New()
+	output, err := engine.Evaluate(getSequences(script))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedOutput := "Table: 'old' doesn't exist, skipping\nTable 'tbl' has been created\nData Inserted (1 row affected)\n"
+	if output != expectedOutput {
+		t.Errorf("Output of the first run should be:\n%s\ngot:\n%s", expectedOutput, output)
+	}
+
+	output, err = engine.Evaluate(getSequences(script))
+	if err != nil {
+		t.Fatalf("Script should be run again without error, got: %s", err)
+	}
+	expectedOutput = "Table: 'old' doesn't exist, skipping\nTable 'tbl' already exists, skipping\nData Inserted (1 row affected)\n"
+	if output != expectedOutput {
+		t.Errorf("Output of the second run should be:\n%s\ngot:\n%s", expectedOutput, output)
+	}
+	if len(engine.Tables["tbl"].Columns[0].Values) != 2 {
+		t.Errorf("Existing table shouldn't be replaced, expected 2 rows, got %d", len(engine.Tables["tbl"].Columns[0].Values))
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	createInputs := []string{
+		"CREATE TABLE tbl( id INT PRIMARY KEY, name TEXT, price INT );",
+		"INSERT INTO tbl VALUES( 1, 'a', 10 );",
+		"INSERT INTO tbl VALUES( 2, 'b', 25 );",
+	}
+
+	tests := []struct {
+		alterInputs    []string
+		selectInput    string
+		expectedOutput [][]string
+	}{
+		{
+			alterInputs:    []string{"ALTER TABLE tbl ADD COLUMN amount DECIMAL(6, 2) NOT NULL DEFAULT 1.5;", "INSERT INTO tbl VALUES( 3, 'c', 30, 2 );"},
+			selectInput:    "SELECT * FROM tbl;",
+			expectedOutput: [][]string{{"id", "name", "price", "amount"}, {"1", "a", "10", "1.50"}, {"2", "b", "25", "1.50"}, {"3", "c", "30", "2.00"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl ADD code SERIAL;", "INSERT INTO tbl (id) VALUES( 3 );"},
+			selectInput:    "SELECT id, code FROM tbl;",
+			expectedOutput: [][]string{{"id", "code"}, {"1", "1"}, {"2", "2"}, {"3", "3"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl DROP COLUMN name;"},
+			selectInput:    "SELECT * FROM tbl;",
+			expectedOutput: [][]string{{"id", "price"}, {"1", "10"}, {"2", "25"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl RENAME COLUMN name TO label;"},
+			selectInput:    "SELECT label FROM tbl WHERE label EQUAL 'b';",
+			expectedOutput: [][]string{{"label"}, {"b"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl RENAME TO items;", "INSERT INTO items VALUES( 3, 'c', 30 );"},
+			selectInput:    "SELECT id FROM items;",
+			expectedOutput: [][]string{{"id"}, {"1"}, {"2"}, {"3"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl ALTER COLUMN price TYPE DECIMAL(5, 1);"},
+			selectInput:    "SELECT price FROM tbl;",
+			expectedOutput: [][]string{{"price"}, {"10.0"}, {"25.0"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl ALTER COLUMN price TYPE TEXT;", "ALTER TABLE tbl ALTER COLUMN price TYPE SMALLINT;"},
+			selectInput:    "SELECT price FROM tbl WHERE price > 20;",
+			expectedOutput: [][]string{{"price"}, {"25"}},
+		},
+		{
+			alterInputs:    []string{"ALTER TABLE tbl ALTER COLUMN name TYPE CHAR(2);", "ALTER TABLE tbl ADD COLUMN tags INT[] DEFAULT '{1,2}';", "ALTER TABLE tbl ALTER COLUMN tags TYPE TEXT[];"},
+			selectInput:    "SELECT name, tags FROM tbl WHERE '1' EQUAL ANY(tags);",
+			expectedOutput: [][]string{{"name", "tags"}, {"a ", "{1,2}"}, {"b ", "{1,2}"}},
+		},
+	}
+
+	for _, tt := range tests {
+		engineTestSuite := engineTableContentTestSuite{
+			createInputs:          createInputs,
+			insertAndDeleteInputs: tt.alterInputs,
+			selectInput:           tt.selectInput,
+			expectedOutput:        tt.expectedOutput,
+		}
+
+		engineTestSuite.runTestSuite(t)
+	}
+}
+
+func TestAlterTableKeepsConstraintsAndIndexesConsistent(t *testing.T) {
+	input := "CREATE TABLE parent( id INT PRIMARY KEY, code TEXT UNIQUE, note TEXT, rank INT CHECK (rank > 0) );" +
+		"CREATE TABLE child( id INT, parent_code TEXT REFERENCES parent (code) );" +
+		"CREATE INDEX by_rank ON parent (rank) INCLUDE (code); CREATE INDEX by_note ON parent (note);" +
+		"INSERT INTO parent VALUES( 1, 'a', 'x', 1 ); INSERT INTO parent VALUES( 2, 'b', 'y', 2 );" +
+		"INSERT INTO child VALUES( 1, 'b' );" +
+		"ALTER TABLE parent RENAME COLUMN rank TO position; ALTER TABLE parent DROP COLUMN id;" +
+		"ALTER TABLE parent DROP COLUMN code CASCADE; ALTER TABLE parent RENAME TO other;"
+
+	engine := New()
+	_, err := engine.Evaluate(getSequences(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	table := engine.Tables["other"]
+	if table == nil || engine.Tables["parent"] != nil {
+		t.Fatalf("Table parent should be renamed to other")
+	}
+	if table.primaryKey != nil || len(table.uniqueConstraints) != 0 {
+		t.Errorf("Primary key and UNIQUE constraint of dropped columns should be dropped")
+	}
+	if len(engine.Tables["child"].foreignKeys) != 0 {
+		t.Errorf("Foreign key referring to dropped column should be dropped with CASCADE")
+	}
+	if len(table.indexes) != 1 || table.indexes[0].name != "by_note" || table.indexes[0].columns[0] != 0 {
+		t.Errorf("Only index by_note on the first column should be left, got: %v", table.indexes)
+	}
+
+	_, err = engine.Evaluate(getSequences("INSERT INTO other VALUES( 'z', 0 );"))
+	expectedError := CheckViolationError{constraintName: "parent_rank_check", tableName: "other"}
+	if err == nil || err.Error() != expectedError.Error() {
+		t.Errorf("Renamed column should be checked by CHECK constraint, got: %v", err)
+	}
+	output, err := engine.Evaluate(getSequences("SELECT position FROM other WHERE note EQUAL 'y';"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !strings.Contains(output, " 2 |") {
+		t.Errorf("Index on shifted column should find row with note y, got:\n%s", output)
+	}
+}
+
+func TestFailedAlterTableDoesNotChangeTable(t *testing.T) {
+	inputs := []string{
+		"ALTER TABLE tbl ADD COLUMN three INT NOT NULL;",
+		"ALTER TABLE tbl ADD COLUMN three INT DEFAULT 1 UNIQUE;",
+		"ALTER TABLE tbl ADD COLUMN three SERIAL CHECK (three > 1);",
+		"ALTER TABLE tbl ALTER COLUMN two TYPE INT;",
+		"ALTER TABLE tbl ALTER COLUMN one TYPE VARCHAR(1);",
+	}
+
+	for _, input := range inputs {
+		engine := New()
+		_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( one INT UNIQUE, two TEXT ); CREATE INDEX ON tbl (one);" +
+			"INSERT INTO tbl VALUES( 10, 'a' ); INSERT INTO tbl VALUES( 20, 'b' );"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		_, err = engine.Evaluate(getSequences(input))
+		if err == nil {
+			t.Fatalf("%q should fail", input)
+		}
+
+		table := engine.Tables["tbl"]
+		if len(table.Columns) != 2 || table.Columns[0].getTypeName() != "INT" || table.Columns[1].getTypeName() != "TEXT" {
+			t.Errorf("Columns shouldn't be changed by %q", input)
+		}
+		if len(table.uniqueConstraints) != 1 || len(table.checks) != 0 || len(engine.Sequences) != 0 {
+			t.Errorf("Constraints and sequences shouldn't be changed by %q", input)
+		}
+		output, err := engine.Evaluate(getSequences("SELECT two FROM tbl WHERE one EQUAL 20;"))
+		if err != nil || !strings.Contains(output, "'b'") {
+			t.Errorf("Index should still find rows after %q, got: %s", input, output)
+		}
+	}
+}
+
+func TestGenRandomUuidFunction(t *testing.T) {
+	first, err := genRandomUuid("GEN_RANDOM_UUID", []ValueInterface{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	second, err := genRandomUuid("GEN_RANDOM_UUID", []ValueInterface{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	uuid, isUuid := first.(UuidValue)
+	if !isUuid {
+		t.Fatalf("GEN_RANDOM_UUID should return UUID, got: %s", first.ToString())
+	}
+	if uuid.Value[6]>>4 != 4 || uuid.Value[8]>>6 != 2 {
+		t.Fatalf("GEN_RANDOM_UUID should return UUID in version 4, got: %s", first.ToString())
+	}
+	if first.IsEqual(second) {
+		t.Fatalf("GEN_RANDOM_UUID should return different values, got: %s twice", first.ToString())
+	}
+	parsed, err := parseUuid(first.ToString())
+	if err != nil || !parsed.IsEqual(first) {
+		t.Fatalf("UUID should be parsed back from its text form, got: %s", first.ToString())
+	}
+}
+
+func TestFunctionCallsInValues(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE SEQUENCE ids START WITH 5;",
+			"CREATE TABLE tbl( id INT, code UUID, name TEXT, day DATE );",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tbl VALUES( NEXTVAL('ids'), GEN_RANDOM_UUID(), UPPER('anna'), DATE '2024-01-31' );",
+			"INSERT INTO tbl (id, name) VALUES( NEXTVAL('ids') * 10, 'o' || 'la' );",
+			"INSERT INTO tbl VALUES( -(1), NULL, CAST(2 AS TEXT), '2024-02-01' );",
+		},
+		selectInput: "SELECT id, LENGTH(code::TEXT), name, day FROM tbl;",
+		expectedOutput: [][]string{
+			{"id", "LENGTH(code::TEXT)", "name", "day"},
+			{"5", "36", "ANNA", "2024-01-31"},
+			{"60", "NULL", "ola", "NULL"},
+			{"-1", "NULL", "2", "2024-02-01"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestNowFunction(t *testing.T) {
+	before := time.Now().UTC().Truncate(time.Microsecond)
+	value, err := now("NOW", []ValueInterface{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	after := time.Now().UTC()
+
+	timestamp, isTimestamp := value.(TimestampValue)
+	if !isTimestamp || !timestamp.WithTimeZone {
+		t.Fatalf("NOW should return TIMESTAMPTZ, got: %s", value.ToString())
+	}
+	if timestamp.Value.Before(before) || timestamp.Value.After(after) {
+		t.Fatalf("NOW should return current time, got: %s", value.ToString())
+	}
+}
+
+func TestAffectedRowsCount(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+	}{
+		{"INSERT INTO tbl VALUES( 4, 'd' );", "Data Inserted (1 row affected)\n"},
+		{"UPDATE tbl SET name TO 'x' WHERE id > 1;", "Table: 'tbl' has been updated (2 rows affected)\n"},
+		{"UPDATE tbl SET name TO 'x' WHERE id > 5;", "Table: 'tbl' has been updated (0 rows affected)\n"},
+		{"DELETE FROM tbl WHERE id EQUAL 1;", "Data from 'tbl' has been deleted (1 row affected)\n"},
+		{"DELETE FROM tbl;", "Data from 'tbl' has been deleted (3 rows affected)\n"},
+		{"TRUNCATE TABLE tbl;", "Table: 'tbl' has been truncated (3 rows affected)\n"},
+	}
+
+	for _, tt := range tests {
+		engine := New()
+		_, err := engine.Evaluate(getSequences("CREATE TABLE tbl( id INT, name TEXT ); INSERT INTO tbl VALUES( 1, 'a' );" +
+			"INSERT INTO tbl VALUES( 2, 'b' ); INSERT INTO tbl VALUES( 3, 'c' );"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		output, err := engine.Evaluate(getSequences(tt.input))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %s", tt.input, err)
+		}
+		if output != tt.expectedOutput {
+			t.Errorf("Output of %q should be %q, got %q", tt.input, tt.expectedOutput, output)
+		}
+	}
+}
+
+func TestDeleteWithoutWhereDoesActionsOfForeignKeys(t *testing.T) {
+	engine := New()
+	_, err := engine.Evaluate(getSequences("CREATE TABLE users( id INT PRIMARY KEY );" +
+		"CREATE TABLE orders( id INT, user_id INT REFERENCES users ON DELETE CASCADE );" +
+		"INSERT INTO users VALUES( 1 ); INSERT INTO users VALUES( 2 );" +
+		"INSERT INTO orders VALUES( 10, 1 ); INSERT INTO orders VALUES( 11, 2 );" +
+		"DELETE FROM users;"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(engine.Tables["orders"].Columns[0].Values) != 0 {
+		t.Errorf("Orders of deleted users should be deleted, got %d rows", len(engine.Tables["orders"].Columns[0].Values))
+	}
+}
+
+func TestTruncateTable(t *testing.T) {
+	engineTestSuite := engineTableContentTestSuite{
+		createInputs: []string{
+			"CREATE TABLE tbl( id SERIAL PRIMARY KEY, code INT GENERATED ALWAYS AS IDENTITY, name TEXT UNIQUE );",
+			"CREATE INDEX ON tbl (name);",
+		},
+		insertAndDeleteInputs: []string{
+			"INSERT INTO tbl (name) VALUES( 'a' );",
+			"INSERT INTO tbl (name) VALUES( 'b' );",
+			"TRUNCATE TABLE tbl;",
+			"INSERT INTO tbl (name) VALUES( 'b' );",
+			"INSERT INTO tbl (name) VALUES( 'c' );",
+		},
+		selectInput: "SELECT * FROM tbl WHERE name EQUAL 'b' OR name EQUAL 'c';",
+		expectedOutput: [][]string{
+			{"id", "code", "name"},
+			{"1", "1", "b"},
+			{"2", "2", "c"},
+		},
+	}
+
+	engineTestSuite.runTestSuite(t)
+}
+
+func TestTruncateTablesReferencedByForeignKeys(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+		expectedRows   map[string]int
+	}{
+		{
+			"TRUNCATE items, orders;",
+			"Table: 'items' has been truncated (1 row affected)\nTable: 'orders' has been truncated (2 rows affected)\n",
+			map[string]int{"users": 2, "orders": 0, "items": 0},
+		},
+		{
+			"TRUNCATE users CASCADE;",
+			"Table: 'users' has been truncated (2 rows affected)\nTable: 'orders' has been truncated (2 rows affected)\nTable: 'items' has been truncated (1 row affected)\n",
+			map[string]int{"users": 0, "orders": 0, "items": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		engine := New()
+		_, err := engine.Evaluate(getSequences("CREATE TABLE users( id INT PRIMARY KEY );" +
+			"CREATE TABLE orders( id INT PRIMARY KEY, user_id INT REFERENCES users );" +
+			"CREATE TABLE items( order_id INT REFERENCES orders );" +
+			"INSERT INTO users VALUES( 1 ); INSERT INTO users VALUES( 2 );" +
+			"INSERT INTO orders VALUES( 10, 1 ); INSERT INTO orders VALUES( 11, 2 ); INSERT INTO items VALUES( NULL );"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		output, err := engine.Evaluate(getSequences(tt.input))
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %s", tt.input, err)
+		}
+		if output != tt.expectedOutput {
+			t.Errorf("Output of %q should be %q, got %q", tt.input, tt.expectedOutput, output)
+		}
+		for tableName, expectedRows := range tt.expectedRows {
+			if len(engine.Tables[tableName].Columns[0].Values) != expectedRows {
+				t.Errorf("After %q table %s should have %d rows, got %d", tt.input, tableName, expectedRows, len(engine.Tables[tableName].Columns[0].Values))
+			}
+		}
+	}
+}
+
 type engineDBContentTestSuite struct {
 	inputs             []string
 	expectedTableNames []string
@@ -974,6 +3427,7 @@ type engineTableContentTestSuite struct {
 	insertAndDeleteInputs []string
 	selectInput           string
 	expectedOutput        [][]string
+	standardNulls         bool
 }
 
 func (engineTestSuite *engineTableContentTestSuite) runTestSuite(t *testing.T) {
@@ -991,6 +3445,7 @@ func (engineTestSuite *engineTableContentTestSuite) runTestSuite(t *testing.T) {
 	}
 
 	engine := New()
+	engine.StandardNullSemantics = engineTestSuite.standardNulls
 	_, err := engine.Evaluate(sequencesWithoutSelect)
 	if err != nil {
 		log.Fatal(err)
@@ -1,8 +1,14 @@
 package engine
 
 import (
+	"encoding/hex"
+	"errors"
+	"math"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/LissaGreense/GO4SQL/ast"
 	"github.com/LissaGreense/GO4SQL/token"
 )
 
@@ -10,23 +16,66 @@ func getInterfaceValue(t token.Token) (ValueInterface, error) {
 	switch t.Type {
 	case token.NULL:
 		return NullValue{}, nil
-	case token.LITERAL:
-		castedInteger, err := strconv.Atoi(t.Literal)
+	case token.TRUE, token.FALSE:
+		return BooleanValue{Value: t.Type == token.TRUE}, nil
+	case token.HEX:
+		decoded, err := hex.DecodeString(t.Literal)
 		if err != nil {
-			return nil, err
+			return nil, &InvalidHexLiteralError{literal: t.Literal}
+		}
+		return BlobValue{Value: decoded}, nil
+	case token.LITERAL:
+		castedInteger, err := strconv.ParseInt(t.Literal, 10, 64)
+		if err == nil {
+			return IntegerValue{Value: castedInteger}, nil
 		}
-		return IntegerValue{Value: castedInteger}, nil
+		if strings.ContainsAny(t.Literal, "eE") {
+			castedFloat, floatErr := strconv.ParseFloat(t.Literal, 64)
+			if floatErr != nil {
+				return nil, &InvalidNumericLiteralError{literal: t.Literal, outOfRange: errors.Is(floatErr, strconv.ErrRange)}
+			}
+			return FloatValue{Value: castedFloat}, nil
+		}
+		castedDecimal, isDecimal := parseDecimal(t.Literal)
+		if !isDecimal {
+			return nil, &InvalidNumericLiteralError{literal: t.Literal}
+		}
+		return castedDecimal, nil
 	default:
 		return StringValue{Value: t.Literal}, nil
 	}
 }
 
+// getColumnValue - Return value written in VALUES converted to the type of column, error is returned when token
+// doesn't match the type, arrays are written as text
+func getColumnValue(value token.Token, column *Column, commandName string) (ValueInterface, error) {
+	expectedToken := tokenMapper(column.Type.Type)
+	if column.IsArray {
+		expectedToken = token.IDENT
+	}
+	if (expectedToken != tokenMapper(value.Type)) && (value.Type != token.NULL) {
+		return nil, &InvalidValueTypeError{expectedType: string(expectedToken), actualType: string(value.Type), commandName: commandName}
+	}
+	interfaceValue, err := getInterfaceValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return convertToColumnType(interfaceValue, column, commandName)
+}
+
 func tokenMapper(inputToken token.Type) token.Type {
 	switch inputToken {
-	case token.TEXT:
+	case token.TEXT, token.VARCHAR, token.CHAR, token.JSON, token.UUID, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL:
 		return token.IDENT
-	case token.INT:
+	case token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL:
 		return token.LITERAL
+	case token.TRUE, token.FALSE:
+		return token.BOOLEAN
+	case token.BLOB:
+		return token.HEX
+	case token.ARRAY:
+		// array literal from VALUES is passed to engine in text form
+		return token.IDENT
 	default:
 		return inputToken
 	}
@@ -44,3 +93,321 @@ func unique(arr []string) *[]string {
 	}
 	return &result
 }
+
+func getIdentifierNames(identifiers []ast.Identifier) []string {
+	names := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		names = append(names, identifier.Token.Literal)
+	}
+	return names
+}
+
+// getColumnTypeOfValues - Return column type matching type of values calculated by the function, NULL values are
+// skipped, TEXT is used when no value determines the type
+func getColumnTypeOfValues(values []ValueInterface) token.Token {
+	for _, value := range values {
+		if value.GetType() != NullType {
+			return getColumnTypeOfValue(value)
+		}
+	}
+	return token.Token{Type: token.TEXT, Literal: token.TEXT}
+}
+
+// getColumnTypeOfValue - Return column type matching type of value, TEXT is used for NULL
+func getColumnTypeOfValue(value ValueInterface) token.Token {
+	switch value.GetType() {
+	case IntType:
+		return token.Token{Type: token.INT, Literal: token.INT}
+	case FloatType:
+		return token.Token{Type: token.FLOAT, Literal: token.FLOAT}
+	case DecimalType:
+		return token.Token{Type: token.DECIMAL, Literal: token.DECIMAL}
+	case BooleanType:
+		return token.Token{Type: token.BOOLEAN, Literal: token.BOOLEAN}
+	case BlobType:
+		return token.Token{Type: token.BLOB, Literal: token.BLOB}
+	case JsonType:
+		return token.Token{Type: token.JSON, Literal: token.JSON}
+	case UuidType:
+		return token.Token{Type: token.UUID, Literal: token.UUID}
+	case EnumType:
+		return token.Token{Type: token.IDENT, Literal: value.(EnumValue).Enum.Name}
+	case ArrayType:
+		return token.Token{Type: token.ARRAY, Literal: getTypeName(value)}
+	case DateType, TimeType, TimestampType, IntervalType:
+		typeName := getTypeName(value)
+		return token.Token{Type: token.Type(typeName), Literal: typeName}
+	default:
+		return token.Token{Type: token.TEXT, Literal: token.TEXT}
+	}
+}
+
+// getTypeName - Return name of the type of value in the same form as it's used in column definition
+func getTypeName(value ValueInterface) string {
+	switch value.GetType() {
+	case IntType:
+		return token.INT
+	case StringType:
+		return token.TEXT
+	case FloatType:
+		return token.FLOAT
+	case DecimalType:
+		return token.DECIMAL
+	case BooleanType:
+		return token.BOOLEAN
+	case DateType:
+		return token.DATE
+	case TimeType:
+		return token.TIME
+	case TimestampType:
+		if value.(TimestampValue).WithTimeZone {
+			return token.TIMESTAMPTZ
+		}
+		return token.TIMESTAMP
+	case IntervalType:
+		return token.INTERVAL
+	case BlobType:
+		return token.BLOB
+	case JsonType:
+		return token.JSON
+	case UuidType:
+		return token.UUID
+	case EnumType:
+		return value.(EnumValue).Enum.Name
+	case ArrayType:
+		for _, element := range value.(ArrayValue).Values {
+			if element.GetType() != NullType {
+				return getTypeName(element) + "[]"
+			}
+		}
+		return token.ARRAY
+	default:
+		return token.NULL
+	}
+}
+
+// convertToColumnType - Return numeric value converted to the type of column, FLOAT and REAL columns store
+// floating-point numbers, DECIMAL(precision, scale) columns round value to scale and reject values with too many
+// integer digits, SMALLINT, INT and BIGINT columns reject fractional numbers and values outside of their range, DATE, TIME, TIMESTAMP and INTERVAL columns parse text in
+// ISO-8601 format, VARCHAR(n) and CHAR(n) columns reject values longer than n characters and CHAR(n) pads value
+// with spaces up to n characters, BLOB columns accept only binary values, JSON columns parse text as JSON document,
+// UUID columns parse text in canonical form, ENUM columns accept only declared labels, elements of arrays are
+// converted to the type of the column, other values are returned unchanged
+func convertToColumnType(value ValueInterface, column *Column, commandName string) (ValueInterface, error) {
+	if column.IsArray && value.GetType() != NullType {
+		return convertToArrayColumnType(value, column, commandName)
+	}
+	if column.Enum != nil && value.GetType() != NullType {
+		return convertToEnumColumnType(value, column.Enum, commandName)
+	}
+	if column.Type.Type == token.UUID && value.GetType() != NullType {
+		return convertToUuidColumnType(value, commandName)
+	}
+	if column.Type.Type == token.JSON && value.GetType() != NullType {
+		return convertToJsonColumnType(value, commandName)
+	}
+	if column.Type.Type == token.BLOB && value.GetType() != NullType && value.GetType() != BlobType {
+		return nil, &InvalidValueTypeError{expectedType: token.BLOB, actualType: getTypeName(value), commandName: commandName}
+	}
+	if (column.Type.Type == token.VARCHAR || column.Type.Type == token.CHAR) && value.GetType() != NullType {
+		return convertToCharacterColumnType(value, column)
+	}
+	if isTemporalColumnType(column.Type.Type) && value.GetType() != NullType {
+		return convertToTemporalColumnType(value, column, commandName)
+	}
+
+	if !isNumeric(value) {
+		return value, nil
+	}
+
+	switch column.Type.Type {
+	case token.SMALLINT, token.INT, token.BIGINT:
+		if decimal, isDecimal := value.(DecimalValue); isDecimal && decimal.Scale == 0 {
+			return nil, &ValueOutOfRangeError{value: value.ToString(), columnName: column.Name, columnType: column.Type.Literal}
+		}
+		if value.GetType() != IntType {
+			return nil, &InvalidValueTypeError{expectedType: column.Type.Literal, actualType: getTypeName(value), commandName: commandName}
+		}
+		if !isInIntegerRange(value.(IntegerValue).Value, column.Type.Type) {
+			return nil, &ValueOutOfRangeError{value: value.ToString(), columnName: column.Name, columnType: column.Type.Literal}
+		}
+		return value, nil
+	case token.FLOAT, token.REAL:
+		return FloatValue{Value: toFloat(value)}, nil
+	case token.DECIMAL:
+		decimal := toDecimal(value)
+		if len(column.TypeParameters) == 0 {
+			return decimal, nil
+		}
+		precision, scale := column.TypeParameters[0], 0
+		if len(column.TypeParameters) > 1 {
+			scale = column.TypeParameters[1]
+		}
+		decimal = decimal.rescale(scale)
+		if decimal.countIntegerDigits() > precision-scale {
+			return nil, &NumericFieldOverflowError{columnName: column.Name, precision: precision, scale: scale}
+		}
+		return decimal, nil
+	default:
+		return value, nil
+	}
+}
+
+// isInIntegerRange - Return true if value fits into SMALLINT (16-bit), INT (32-bit) or BIGINT (64-bit) type
+func isInIntegerRange(value int64, integerType token.Type) bool {
+	switch integerType {
+	case token.SMALLINT:
+		return value >= math.MinInt16 && value <= math.MaxInt16
+	case token.INT:
+		return value >= math.MinInt32 && value <= math.MaxInt32
+	default:
+		return true
+	}
+}
+
+// convertToCharacterColumnType - Return value converted to text fitting into VARCHAR(n) or CHAR(n) column, trailing
+// spaces exceeding the length are removed, CHAR without length holds single character
+func convertToCharacterColumnType(value ValueInterface, column *Column) (ValueInterface, error) {
+	text := value.ToString()
+	maxLength := -1
+	if len(column.TypeParameters) > 0 {
+		maxLength = column.TypeParameters[0]
+	} else if column.Type.Type == token.CHAR {
+		maxLength = 1
+	}
+	if maxLength < 0 {
+		return StringValue{Value: text}, nil
+	}
+
+	length := utf8.RuneCountInString(text)
+	if length > maxLength {
+		trimmed := strings.TrimRight(text, " ")
+		if utf8.RuneCountInString(trimmed) > maxLength {
+			return nil, &ValueTooLongError{length: length, columnName: column.Name, columnType: column.Type.Literal, maxLength: maxLength}
+		}
+		text = string([]rune(text)[:maxLength])
+		length = maxLength
+	}
+	if column.Type.Type == token.CHAR {
+		return StringValue{Value: text + strings.Repeat(" ", maxLength-length), Padded: true}, nil
+	}
+	return StringValue{Value: text}, nil
+}
+
+func convertToJsonColumnType(value ValueInterface, commandName string) (ValueInterface, error) {
+	switch value.GetType() {
+	case JsonType:
+		return value, nil
+	case StringType:
+		return parseJson(value.ToString())
+	default:
+		return nil, &InvalidValueTypeError{expectedType: token.JSON, actualType: getTypeName(value), commandName: commandName}
+	}
+}
+
+func convertToUuidColumnType(value ValueInterface, commandName string) (ValueInterface, error) {
+	switch value.GetType() {
+	case UuidType:
+		return value, nil
+	case StringType:
+		return parseUuid(value.ToString())
+	default:
+		return nil, &InvalidValueTypeError{expectedType: token.UUID, actualType: getTypeName(value), commandName: commandName}
+	}
+}
+
+// convertToArrayColumnType - Return array with elements converted to the type of column, text is read in the form
+// of {a,b,NULL}
+func convertToArrayColumnType(value ValueInterface, column *Column, commandName string) (ValueInterface, error) {
+	elementColumn := &Column{Name: column.Name, Type: column.Type, TypeParameters: column.TypeParameters, Enum: column.Enum}
+	expectedToken := tokenMapper(column.Type.Type)
+
+	switch mappedValue := value.(type) {
+	case ArrayValue:
+		elements := make([]ValueInterface, 0, len(mappedValue.Values))
+		for _, element := range mappedValue.Values {
+			if element.GetType() != NullType && getValueTokenType(element) != expectedToken {
+				return nil, &InvalidValueTypeError{expectedType: column.Type.Literal, actualType: getTypeName(element), commandName: commandName}
+			}
+			converted, err := convertToColumnType(element, elementColumn, commandName)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, converted)
+		}
+		return ArrayValue{Values: elements}, nil
+	case StringValue:
+		tokens, err := parseArray(mappedValue.Value)
+		if err != nil {
+			return nil, err
+		}
+		elements := make([]ValueInterface, 0, len(tokens))
+		for _, element := range tokens {
+			// text elements don't have to be quoted
+			if expectedToken == token.IDENT && element.Type != token.NULL {
+				element.Type = token.IDENT
+			}
+			converted, err := getColumnValue(element, elementColumn, commandName)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, converted)
+		}
+		return ArrayValue{Values: elements}, nil
+	default:
+		return nil, &InvalidValueTypeError{expectedType: column.Type.Literal + "[]", actualType: getTypeName(value), commandName: commandName}
+	}
+}
+
+// getValueTokenType - Return type of token which is used to write the value in VALUES, it's compared with column type
+// mapped by tokenMapper
+func getValueTokenType(value ValueInterface) token.Type {
+	switch {
+	case isNumeric(value):
+		return token.LITERAL
+	case value.GetType() == BooleanType:
+		return token.BOOLEAN
+	case value.GetType() == BlobType:
+		return token.HEX
+	case value.GetType() == ArrayType:
+		return token.ARRAY
+	default:
+		return token.IDENT
+	}
+}
+
+func convertToEnumColumnType(value ValueInterface, enum *Enum, commandName string) (ValueInterface, error) {
+	switch value.GetType() {
+	case EnumType:
+		if value.(EnumValue).Enum == enum {
+			return value, nil
+		}
+		return enum.getValue(value.ToString())
+	case StringType:
+		return enum.getValue(value.ToString())
+	default:
+		return nil, &InvalidValueTypeError{expectedType: enum.Name, actualType: getTypeName(value), commandName: commandName}
+	}
+}
+
+func isTemporalColumnType(columnType token.Type) bool {
+	switch columnType {
+	case token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL:
+		return true
+	default:
+		return false
+	}
+}
+
+func convertToTemporalColumnType(value ValueInterface, column *Column, commandName string) (ValueInterface, error) {
+	columnType := string(column.Type.Type)
+	if value.GetType() == StringType {
+		return parseTemporal(value.ToString(), columnType)
+	}
+
+	converted, isConverted := convertTemporal(value, columnType)
+	if !isConverted {
+		return nil, &InvalidValueTypeError{expectedType: columnType, actualType: getTypeName(value), commandName: commandName}
+	}
+	return converted, nil
+}
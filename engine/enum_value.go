@@ -0,0 +1,79 @@
+package engine
+
+import (
+	"log"
+)
+
+// Enum - User-defined type created with CREATE TYPE ... AS ENUM, values are ordered by position of their labels
+type Enum struct {
+	Name      string
+	Labels    []string
+	positions map[string]int
+}
+
+// EnumValue - Implementation of ValueInterface that is containing label of user-defined ENUM type
+type EnumValue struct {
+	Value string
+	Enum  *Enum
+}
+
+// newEnum - Return Enum with labels in declaration order or error if any label is duplicated
+func newEnum(name string, labels []string) (*Enum, error) {
+	enum := &Enum{Name: name, Labels: labels, positions: make(map[string]int, len(labels))}
+	for position, label := range labels {
+		if _, exists := enum.positions[label]; exists {
+			return nil, &DuplicatedEnumLabelError{label: label, typeName: name}
+		}
+		enum.positions[label] = position
+	}
+	return enum, nil
+}
+
+// getValue - Return EnumValue with provided label or error if label isn't declared in the type
+func (enum *Enum) getValue(label string) (EnumValue, error) {
+	if _, exists := enum.positions[label]; !exists {
+		return EnumValue{}, &InvalidEnumValueError{value: label, typeName: enum.Name}
+	}
+	return EnumValue{Value: label, Enum: enum}, nil
+}
+
+func (value EnumValue) ToString() string { return value.Value }
+
+func (value EnumValue) GetType() SupportedTypes { return EnumType }
+
+// IsEqual - Labels of different ENUM types are never equal, even when they have the same text
+func (value EnumValue) IsEqual(valueInterface ValueInterface) bool {
+	secondValue, isEnum := valueInterface.(EnumValue)
+	return isEnum && value.Enum == secondValue.Enum && value.Value == secondValue.Value
+}
+
+// Labels are ordered the same way as they were declared, not alphabetically
+func (value EnumValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	return value.position() < getEnumPosition(secondValue)
+}
+
+func (value EnumValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	return value.position() > getEnumPosition(secondValue)
+}
+
+func (value EnumValue) position() int {
+	return value.Enum.positions[value.Value]
+}
+
+func getEnumPosition(value ValueInterface) int {
+	enumValue, isEnum := value.(EnumValue)
+	if !isEnum {
+		log.Fatal("Can't compare ENUM with other type")
+	}
+	return enumValue.position()
+}
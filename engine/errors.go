@@ -21,6 +21,225 @@ func (m *TableDoesNotExistError) Error() string {
 	return "table with the name of " + m.tableName + " doesn't exist"
 }
 
+// TypeAlreadyExistsError - error thrown when user tries to create type using name that already exists in database
+type TypeAlreadyExistsError struct {
+	typeName string
+}
+
+func (m *TypeAlreadyExistsError) Error() string {
+	return "type with the name of " + m.typeName + " already exists"
+}
+
+// TypeDoesNotExistError - error thrown when user tries to create column of un-existing type
+type TypeDoesNotExistError struct {
+	typeName string
+}
+
+func (m *TypeDoesNotExistError) Error() string {
+	return "type with the name of " + m.typeName + " doesn't exist"
+}
+
+// DuplicatedEnumLabelError - error thrown when the same label is declared more than once in ENUM type
+type DuplicatedEnumLabelError struct {
+	label    string
+	typeName string
+}
+
+func (m *DuplicatedEnumLabelError) Error() string {
+	return "label '" + m.label + "' is declared more than once in type " + m.typeName
+}
+
+// InvalidEnumValueError - error thrown when value isn't one of labels declared in ENUM type
+type InvalidEnumValueError struct {
+	value    string
+	typeName string
+}
+
+func (m *InvalidEnumValueError) Error() string {
+	return "invalid value '" + m.value + "' for enum type " + m.typeName
+}
+
+// DuplicateKeyError - error thrown when inserted or updated row has the same key as other row of the table
+type DuplicateKeyError struct {
+	constraint string
+	tableName  string
+	key        string
+}
+
+func (m *DuplicateKeyError) Error() string {
+	return "duplicate key value violates " + m.constraint + " of table " + m.tableName + ", key " + m.key + " already exists"
+}
+
+// UniqueViolationError - error thrown when inserted or updated row has the same values in columns of UNIQUE
+// constraint as other row of the table
+type UniqueViolationError struct {
+	constraintName string
+	tableName      string
+	key            string
+}
+
+func (m *UniqueViolationError) Error() string {
+	return "duplicate key value violates unique constraint " + m.constraintName + " of table " + m.tableName + ", key " + m.key + " already exists"
+}
+
+// ForeignKeyViolationError - error thrown when inserted or updated row refers to key which doesn't exist in
+// referenced table
+type ForeignKeyViolationError struct {
+	constraintName  string
+	tableName       string
+	referencedTable string
+	referencedKey   string
+}
+
+func (m *ForeignKeyViolationError) Error() string {
+	return "insert or update on table " + m.tableName + " violates foreign key constraint " + m.constraintName + ", key " + m.referencedKey + " is not present in table \"" + m.referencedTable + "\""
+}
+
+// ReferencedKeyError - error thrown when deleted or updated key is still referenced by foreign key with RESTRICT
+// action
+type ReferencedKeyError struct {
+	tableName        string
+	constraintName   string
+	referencingTable string
+	key              string
+}
+
+func (m *ReferencedKeyError) Error() string {
+	return "update or delete on table " + m.tableName + " violates foreign key constraint " + m.constraintName + " of table " + m.referencingTable + ", key " + m.key + " is still referenced"
+}
+
+// NotNullViolationError - error thrown when NULL is inserted into column which doesn't accept it, ex. column of
+// primary key
+type NotNullViolationError struct {
+	columnName string
+	tableName  string
+}
+
+func (m *NotNullViolationError) Error() string {
+	return "null value in column " + m.columnName + " of table " + m.tableName + " violates not-null constraint"
+}
+
+// CheckViolationError - error thrown when inserted or updated row doesn't fulfill CHECK constraint of the table
+type CheckViolationError struct {
+	constraintName string
+	tableName      string
+}
+
+func (m *CheckViolationError) Error() string {
+	return "new row of table " + m.tableName + " violates check constraint " + m.constraintName
+}
+
+// DuplicatedConstraintNameError - error thrown when the same constraint name is used more than once in the table
+type DuplicatedConstraintNameError struct {
+	constraintName string
+	tableName      string
+}
+
+func (m *DuplicatedConstraintNameError) Error() string {
+	return "constraint " + m.constraintName + " for table " + m.tableName + " already exists"
+}
+
+// InvalidDefaultValueError - error thrown when default value of the column refers to columns of the table
+type InvalidDefaultValueError struct {
+	columnName string
+}
+
+func (m *InvalidDefaultValueError) Error() string {
+	return "default value of column " + m.columnName + " can't refer to columns"
+}
+
+// DefaultValueTypeMismatchError - error thrown when type of column is changed, but its default value can't be
+// stored in the column of new type
+type DefaultValueTypeMismatchError struct {
+	columnName   string
+	defaultValue string
+	columnType   string
+}
+
+func (m *DefaultValueTypeMismatchError) Error() string {
+	return "default value " + m.defaultValue + " of column " + m.columnName + " can't be used as value of type " + m.columnType
+}
+
+// DuplicatedColumnError - error thrown when the same column is listed more than once in command
+type DuplicatedColumnError struct {
+	columnName  string
+	commandName string
+}
+
+func (m *DuplicatedColumnError) Error() string {
+	return "column " + m.columnName + " is specified more than once in " + m.commandName + " command"
+}
+
+// MissingUniqueConstraintError - error thrown when foreign key refers to columns which aren't primary key nor UNIQUE
+// constraint of referenced table
+type MissingUniqueConstraintError struct {
+	tableName string
+}
+
+func (m *MissingUniqueConstraintError) Error() string {
+	return "there is no primary key or unique constraint matching referenced columns of table " + m.tableName
+}
+
+// InvalidNumberOfReferencedColumnsError - error thrown when foreign key has different number of columns than
+// referenced key
+type InvalidNumberOfReferencedColumnsError struct {
+	constraintName string
+}
+
+func (m *InvalidNumberOfReferencedColumnsError) Error() string {
+	return "number of referencing and referenced columns for foreign key " + m.constraintName + " differ"
+}
+
+// ForeignKeyTypeMismatchError - error thrown when column of foreign key has different type than referenced column
+type ForeignKeyTypeMismatchError struct {
+	columnName           string
+	columnType           string
+	referencedColumnName string
+	referencedType       string
+}
+
+func (m *ForeignKeyTypeMismatchError) Error() string {
+	return "foreign key column " + m.columnName + " of type " + m.columnType + " can't refer to column " + m.referencedColumnName + " of type " + m.referencedType
+}
+
+// DependentObjectsExistError - error thrown when dropped table or column is referenced by foreign key of other table
+type DependentObjectsExistError struct {
+	tableName        string
+	columnName       string // dropped column, it's empty when the whole table is dropped
+	constraintName   string
+	referencingTable string
+}
+
+func (m *DependentObjectsExistError) Error() string {
+	droppedObject := "table " + m.tableName
+	if m.columnName != "" {
+		droppedObject = "column " + m.columnName + " of table " + m.tableName
+	}
+	return "can't drop " + droppedObject + " because constraint " + m.constraintName + " of table " + m.referencingTable + " depends on it, use CASCADE to drop the constraint too"
+}
+
+// TruncatedTableReferencedError - error thrown when truncated table is referenced by foreign key of table which
+// isn't truncated together with it
+type TruncatedTableReferencedError struct {
+	tableName        string
+	constraintName   string
+	referencingTable string
+}
+
+func (m *TruncatedTableReferencedError) Error() string {
+	return "can't truncate table " + m.tableName + " because constraint " + m.constraintName + " of table " + m.referencingTable + " refers to it, truncate table " + m.referencingTable + " at the same time or use CASCADE"
+}
+
+// DuplicatedKeyColumnError - error thrown when the same column is listed more than once in key of the table
+type DuplicatedKeyColumnError struct {
+	columnName string
+	constraint string
+}
+
+func (m *DuplicatedKeyColumnError) Error() string {
+	return "column " + m.columnName + " appears more than once in " + m.constraint
+}
+
 // ColumnDoesNotExistError - error thrown when user tries to make operation on un-existing column
 type ColumnDoesNotExistError struct {
 	tableName  string
@@ -75,7 +294,7 @@ func (m *UnsupportedOperationTokenError) Error() string {
 }
 
 // UnsupportedConditionalTokenError - error thrown when engine found unsupported conditional token
-// inside expression (supported are: EQUAL, NOT)
+// inside expression (supported are: EQUAL, NOT, <, >, <=, >=)
 type UnsupportedConditionalTokenError struct {
 	variable    string
 	commandName string
@@ -104,3 +323,386 @@ type UnsupportedCommandTypeFromParserError struct {
 func (m *UnsupportedCommandTypeFromParserError) Error() string {
 	return "unsupported Command detected: " + m.variable
 }
+
+// FunctionDoesNotExistError - error thrown when user calls function which isn't registered in engine
+type FunctionDoesNotExistError struct {
+	functionName string
+}
+
+func (m *FunctionDoesNotExistError) Error() string {
+	return "function with the name of " + m.functionName + " doesn't exist"
+}
+
+// InvalidNumberOfFunctionArgumentsError - error thrown when function is called with fewer or more arguments than
+// it accepts
+type InvalidNumberOfFunctionArgumentsError struct {
+	functionName string
+	minNumber    int
+	maxNumber    int
+	actualNumber int
+}
+
+func (m *InvalidNumberOfFunctionArgumentsError) Error() string {
+	var expectedNumber string
+	if m.maxNumber == variadicArguments {
+		expectedNumber = "at least " + strconv.Itoa(m.minNumber)
+	} else if m.minNumber == m.maxNumber {
+		expectedNumber = strconv.Itoa(m.minNumber)
+	} else {
+		expectedNumber = "from " + strconv.Itoa(m.minNumber) + " to " + strconv.Itoa(m.maxNumber)
+	}
+	return "invalid number of arguments in " + m.functionName + " function, should be: " + expectedNumber + ", but got: " + strconv.Itoa(m.actualNumber)
+}
+
+// InvalidFunctionArgumentError - error thrown when function argument has unsupported type or value
+type InvalidFunctionArgumentError struct {
+	functionName string
+	expectedType string
+	actualValue  string
+}
+
+func (m *InvalidFunctionArgumentError) Error() string {
+	return "invalid argument provided to " + m.functionName + " function, expecting: " + m.expectedType + ", got: " + m.actualValue
+}
+
+// IntegerOverflowError - error thrown when result of calculation doesn't fit into integer
+type IntegerOverflowError struct {
+	operation string
+}
+
+func (m *IntegerOverflowError) Error() string {
+	return "integer out of range while calculating " + m.operation
+}
+
+// FloatOverflowError - error thrown when result of calculation doesn't fit into floating-point number
+type FloatOverflowError struct {
+	operation string
+}
+
+func (m *FloatOverflowError) Error() string {
+	return "floating-point value out of range while calculating " + m.operation
+}
+
+// DecimalOverflowError - error thrown when result of calculation has too many digits to be DECIMAL
+type DecimalOverflowError struct {
+	operation string
+}
+
+func (m *DecimalOverflowError) Error() string {
+	return "decimal value out of range while calculating " + m.operation
+}
+
+// NumericFieldOverflowError - error thrown when value doesn't fit into DECIMAL column precision and scale
+type NumericFieldOverflowError struct {
+	columnName string
+	precision  int
+	scale      int
+}
+
+func (m *NumericFieldOverflowError) Error() string {
+	return "numeric field overflow, value of column " + m.columnName + " must fit into DECIMAL(" +
+		strconv.Itoa(m.precision) + ", " + strconv.Itoa(m.scale) + ")"
+}
+
+// ValueOutOfRangeError - error thrown when integer value doesn't fit into SMALLINT, INT or BIGINT column
+type ValueOutOfRangeError struct {
+	value      string
+	columnName string
+	columnType string
+}
+
+func (m *ValueOutOfRangeError) Error() string {
+	return "value " + m.value + " is out of range for column " + m.columnName + " of type " + m.columnType
+}
+
+// ValueTooLongError - error thrown when text doesn't fit into VARCHAR(n) or CHAR(n) column
+type ValueTooLongError struct {
+	length     int
+	columnName string
+	columnType string
+	maxLength  int
+}
+
+func (m *ValueTooLongError) Error() string {
+	return "value of length " + strconv.Itoa(m.length) + " is too long for column " + m.columnName + " of type " +
+		m.columnType + "(" + strconv.Itoa(m.maxLength) + ")"
+}
+
+// InvalidHexLiteralError - error thrown when binary literal, ex. X'DEADBEEF', doesn't contain even number of
+// hexadecimal digits
+type InvalidHexLiteralError struct {
+	literal string
+}
+
+func (m *InvalidHexLiteralError) Error() string {
+	return "invalid hexadecimal literal: X'" + m.literal + "'"
+}
+
+// InvalidNumericLiteralError - error thrown when number written in command isn't valid or doesn't fit into
+// floating-point number, ex. 1e400
+type InvalidNumericLiteralError struct {
+	literal    string
+	outOfRange bool
+}
+
+func (m *InvalidNumericLiteralError) Error() string {
+	if m.outOfRange {
+		return "numeric literal out of range: " + m.literal
+	}
+	return "invalid numeric literal: " + m.literal
+}
+
+// InvalidJsonError - error thrown when text isn't valid JSON document
+type InvalidJsonError struct {
+	value string
+}
+
+func (m *InvalidJsonError) Error() string {
+	return "invalid JSON value: " + m.value
+}
+
+// InvalidJsonPathError - error thrown when JSON path isn't written as $.key[0]
+type InvalidJsonPathError struct {
+	path string
+}
+
+func (m *InvalidJsonPathError) Error() string {
+	return "invalid JSON path: " + m.path
+}
+
+// InvalidUuidError - error thrown when text isn't UUID written in canonical form
+type InvalidUuidError struct {
+	value string
+}
+
+func (m *InvalidUuidError) Error() string {
+	return "invalid UUID value: " + m.value
+}
+
+// InvalidArrayLiteralError - error thrown when text can't be read as array, ex. {"a",b}
+type InvalidArrayLiteralError struct {
+	value string
+}
+
+func (m *InvalidArrayLiteralError) Error() string {
+	return "invalid array literal: " + m.value
+}
+
+// MixedArrayElementTypesError - error thrown when elements of ARRAY[...] have different types
+type MixedArrayElementTypesError struct {
+	firstType  string
+	secondType string
+}
+
+func (m *MixedArrayElementTypesError) Error() string {
+	return "array elements must have the same type, got " + m.firstType + " and " + m.secondType
+}
+
+// DivisionByZeroError - error thrown when calculation requires dividing by zero
+type DivisionByZeroError struct {
+	operation string
+}
+
+func (m *DivisionByZeroError) Error() string {
+	return "division by zero while calculating " + m.operation
+}
+
+// InvalidCastError - error thrown when value can't be converted to requested type
+type InvalidCastError struct {
+	value      string
+	targetType string
+}
+
+func (m *InvalidCastError) Error() string {
+	return "can't convert value " + m.value + " to type " + m.targetType
+}
+
+// InvalidTemporalValueError - error thrown when text can't be read as DATE, TIME, TIMESTAMP or INTERVAL value
+type InvalidTemporalValueError struct {
+	value    string
+	typeName string
+}
+
+func (m *InvalidTemporalValueError) Error() string {
+	return "invalid " + m.typeName + " value: " + m.value
+}
+
+// IncomparableValuesError - error thrown when values of different types are compared with <, >, <= or >= operator
+type IncomparableValuesError struct {
+	leftType    string
+	rightType   string
+	commandName string
+}
+
+func (m *IncomparableValuesError) Error() string {
+	return "can't compare " + m.leftType + " with " + m.rightType + " in " + m.commandName + " command"
+}
+
+// UnsupportedOperandTypesError - error thrown when arithmetic operator can't be used with types of operands
+type UnsupportedOperandTypesError struct {
+	operator string
+	types    []string
+}
+
+func (m *UnsupportedOperandTypesError) Error() string {
+	if len(m.types) == 1 {
+		return "operator " + m.operator + " can't be used with " + m.types[0]
+	}
+	return "operator " + m.operator + " can't be used with " + m.types[0] + " and " + m.types[1]
+}
+
+// SequenceAlreadyExistsError - error thrown when user tries to create sequence using name that already exists
+type SequenceAlreadyExistsError struct {
+	sequenceName string
+}
+
+func (m *SequenceAlreadyExistsError) Error() string {
+	return "sequence with the name of " + m.sequenceName + " already exists"
+}
+
+// SequenceDoesNotExistError - error thrown when function refers to sequence that doesn't exist
+type SequenceDoesNotExistError struct {
+	sequenceName string
+}
+
+func (m *SequenceDoesNotExistError) Error() string {
+	return "sequence with the name of " + m.sequenceName + " doesn't exist"
+}
+
+// InvalidSequenceOptionsError - error thrown when options of created sequence contradict each other
+type InvalidSequenceOptionsError struct {
+	sequenceName string
+	description  string
+}
+
+func (m *InvalidSequenceOptionsError) Error() string {
+	return "invalid options of sequence " + m.sequenceName + ": " + m.description
+}
+
+// SequenceLimitError - error thrown when sequence without CYCLE option reached its maximum or minimum value
+type SequenceLimitError struct {
+	sequenceName string
+	limit        string
+	value        int64
+}
+
+func (m *SequenceLimitError) Error() string {
+	return "sequence " + m.sequenceName + " reached its " + m.limit + " value (" + strconv.FormatInt(m.value, 10) + ")"
+}
+
+// SequenceValueNotDefinedError - error thrown when CURRVAL is called before sequence returned any value
+type SequenceValueNotDefinedError struct {
+	sequenceName string
+}
+
+func (m *SequenceValueNotDefinedError) Error() string {
+	return "current value of sequence " + m.sequenceName + " is not yet defined"
+}
+
+// SequenceValueOutOfRangeError - error thrown when SETVAL sets value outside of bounds of sequence
+type SequenceValueOutOfRangeError struct {
+	sequenceName string
+	value        int64
+	minValue     int64
+	maxValue     int64
+}
+
+func (m *SequenceValueOutOfRangeError) Error() string {
+	return "value " + strconv.FormatInt(m.value, 10) + " is out of bounds for sequence " + m.sequenceName +
+		" (" + strconv.FormatInt(m.minValue, 10) + ".." + strconv.FormatInt(m.maxValue, 10) + ")"
+}
+
+// InvalidIdentityTypeError - error thrown when identity column doesn't have integer type
+type InvalidIdentityTypeError struct {
+	columnName string
+	columnType string
+}
+
+func (m *InvalidIdentityTypeError) Error() string {
+	return "identity column " + m.columnName + " must have SMALLINT, INT or BIGINT type, but got: " + m.columnType
+}
+
+// MultipleDefaultValuesError - error thrown when SERIAL or identity column has also DEFAULT value
+type MultipleDefaultValuesError struct {
+	columnName string
+}
+
+func (m *MultipleDefaultValuesError) Error() string {
+	return "multiple default values specified for column " + m.columnName
+}
+
+// IdentityColumnValueError - error thrown when value of GENERATED ALWAYS AS IDENTITY column is set explicitly
+type IdentityColumnValueError struct {
+	columnName  string
+	commandName string
+}
+
+func (m *IdentityColumnValueError) Error() string {
+	return "column " + m.columnName + " is GENERATED ALWAYS AS IDENTITY and can't be set to non-DEFAULT value in " + m.commandName + " command"
+}
+
+// IndexAlreadyExistsError - error thrown when user tries to create index using name that already exists
+type IndexAlreadyExistsError struct {
+	indexName string
+}
+
+func (m *IndexAlreadyExistsError) Error() string {
+	return "index with the name of " + m.indexName + " already exists"
+}
+
+// IndexDoesNotExistError - error thrown when user tries to drop index that doesn't exist
+type IndexDoesNotExistError struct {
+	indexName string
+}
+
+func (m *IndexDoesNotExistError) Error() string {
+	return "index with the name of " + m.indexName + " doesn't exist"
+}
+
+// UnsupportedIndexColumnTypeError - error thrown when index is created on column which values can't be indexed
+type UnsupportedIndexColumnTypeError struct {
+	columnName string
+	columnType string
+}
+
+func (m *UnsupportedIndexColumnTypeError) Error() string {
+	return "index can't be created on column " + m.columnName + " of type " + m.columnType
+}
+
+// UnsupportedIndexIncludeError - error thrown when INCLUDE is used with index method which can't store included columns
+type UnsupportedIndexIncludeError struct {
+	method string
+}
+
+func (m *UnsupportedIndexIncludeError) Error() string {
+	return "index method " + m.method + " doesn't support INCLUDE columns"
+}
+
+// ColumnAlreadyExistsError - error thrown when column is added or renamed using name that already exists in the table
+type ColumnAlreadyExistsError struct {
+	tableName  string
+	columnName string
+}
+
+func (m *ColumnAlreadyExistsError) Error() string {
+	return "column with the name of " + m.columnName + " already exists in table " + m.tableName
+}
+
+// LastColumnDropError - error thrown when user tries to drop the only column of the table
+type LastColumnDropError struct {
+	tableName  string
+	columnName string
+}
+
+func (m *LastColumnDropError) Error() string {
+	return "can't drop column " + m.columnName + " because it's the only column of table " + m.tableName
+}
+
+// MultiplePrimaryKeysError - error thrown when primary key is added to the table which already has one
+type MultiplePrimaryKeysError struct {
+	tableName string
+}
+
+func (m *MultiplePrimaryKeysError) Error() string {
+	return "multiple primary keys for table " + m.tableName + " are not allowed"
+}
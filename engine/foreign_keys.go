@@ -0,0 +1,387 @@
+package engine
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// foreignKey - Columns of the table which values have to exist in unique columns of referenced table
+type foreignKey struct {
+	name            string
+	columns         []int // positions of referencing columns, ordered the same way as columns of referencedIndex
+	referencedTable string
+	referencedIndex *uniqueIndex // primary key or UNIQUE constraint of referenced table
+	onDelete        ast.ReferentialAction
+	onUpdate        ast.ReferentialAction
+}
+
+// reference - Foreign key together with the table it belongs to
+type reference struct {
+	tableName  string
+	table      *Table
+	foreignKey *foreignKey
+}
+
+// getForeignKeys - Return foreign keys of the table, constraints without name are named the same way as in
+// PostgreSQL, ex. tbl_one_two_fkey for constraint of columns one and two, table can refer to itself
+func (engine *DbEngine) getForeignKeys(command *ast.CreateCommand, table *Table, usedNames map[string]bool) ([]*foreignKey, error) {
+	tableName := command.Name.Token.Literal
+	foreignKeys := make([]*foreignKey, 0, len(command.ForeignKeys))
+	for _, constraint := range command.ForeignKeys {
+		referencedTable, exist := engine.Tables[constraint.ReferencedTable]
+		if constraint.ReferencedTable == tableName {
+			referencedTable, exist = table, true
+		}
+		if !exist {
+			return nil, &TableDoesNotExistError{constraint.ReferencedTable}
+		}
+
+		name := constraint.Name
+		if name == "" {
+			name = getUnusedConstraintName(tableName+"_"+strings.Join(constraint.ColumnNames, "_")+"_fkey", usedNames)
+		}
+
+		columns, err := getKeyColumns(table, constraint.ColumnNames, tableName, token.FOREIGN+" "+token.KEY)
+		if err != nil {
+			return nil, err
+		}
+		invalidNumberOfColumnsError := &InvalidNumberOfReferencedColumnsError{constraintName: name}
+		if len(constraint.ReferencedColumnNames) > 0 && len(constraint.ReferencedColumnNames) != len(columns) {
+			return nil, invalidNumberOfColumnsError
+		}
+		referencedIndex, referencedColumns, err := getReferencedIndex(referencedTable, constraint)
+		if err != nil {
+			return nil, err
+		}
+		// Primary key used by default can also have different number of columns
+		if len(columns) != len(referencedColumns) {
+			return nil, invalidNumberOfColumnsError
+		}
+
+		foreignKey := &foreignKey{
+			name:            name,
+			columns:         make([]int, 0, len(columns)),
+			referencedTable: constraint.ReferencedTable,
+			referencedIndex: referencedIndex,
+			onDelete:        constraint.OnDelete,
+			onUpdate:        constraint.OnUpdate,
+		}
+		// Referencing columns are ordered like columns of the index, so their key can be found in the index
+		for _, referencedColumn := range referencedIndex.columns {
+			position := slices.Index(referencedColumns, referencedColumn)
+			column, referencedColumn := table.Columns[columns[position]], referencedTable.Columns[referencedColumn]
+			if !column.hasSameType(referencedColumn) {
+				return nil, &ForeignKeyTypeMismatchError{
+					columnName:           column.Name,
+					columnType:           column.getTypeName(),
+					referencedColumnName: referencedColumn.Name,
+					referencedType:       referencedColumn.getTypeName(),
+				}
+			}
+			foreignKey.columns = append(foreignKey.columns, columns[position])
+		}
+		foreignKeys = append(foreignKeys, foreignKey)
+	}
+	return foreignKeys, nil
+}
+
+// getReferencedIndex - Return primary key or UNIQUE constraint made of referenced columns and positions of these
+// columns, primary key is used when columns aren't provided
+func getReferencedIndex(referencedTable *Table, constraint ast.ForeignKeyConstraint) (*uniqueIndex, []int, error) {
+	missingConstraintError := &MissingUniqueConstraintError{tableName: constraint.ReferencedTable}
+	if len(constraint.ReferencedColumnNames) == 0 {
+		if referencedTable.primaryKey == nil {
+			return nil, nil, missingConstraintError
+		}
+		return referencedTable.primaryKey, referencedTable.primaryKey.columns, nil
+	}
+
+	columns, err := getKeyColumns(referencedTable, constraint.ReferencedColumnNames, constraint.ReferencedTable, token.FOREIGN+" "+token.KEY)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, index := range referencedTable.getIndexes() {
+		if len(index.columns) == len(columns) && !slices.ContainsFunc(columns, func(column int) bool { return !slices.Contains(index.columns, column) }) {
+			return index, columns, nil
+		}
+	}
+	return nil, nil, missingConstraintError
+}
+
+// getKey - Return key of referencing columns of the row, it can be found in referenced index
+func (foreignKey *foreignKey) getKey(row []ValueInterface) string {
+	values := make([]ValueInterface, 0, len(foreignKey.columns))
+	for _, column := range foreignKey.columns {
+		values = append(values, row[column])
+	}
+	return getKeyOfValues(values)
+}
+
+// hasNull - Return true if any referencing column of the row is NULL, such row doesn't refer to any other row
+func (foreignKey *foreignKey) hasNull(row []ValueInterface) bool {
+	return slices.ContainsFunc(foreignKey.columns, func(column int) bool { return row[column].GetType() == NullType })
+}
+
+// isReferenceMissing - Return true if the row refers to key which doesn't exist in referenced table
+func (foreignKey *foreignKey) isReferenceMissing(row []ValueInterface) bool {
+	if foreignKey.hasNull(row) {
+		return false
+	}
+	_, exists := foreignKey.referencedIndex.rows[foreignKey.getKey(row)]
+	return !exists
+}
+
+// validateReferences - Return error if the row refers to key which doesn't exist in referenced table, the row can
+// refer to itself when foreign key references the same table
+func (table *Table) validateReferences(engine *DbEngine, tableName string, row []ValueInterface) error {
+	for _, foreignKey := range table.foreignKeys {
+		if !foreignKey.isReferenceMissing(row) {
+			continue
+		}
+		if slices.Contains(table.getIndexes(), foreignKey.referencedIndex) && foreignKey.referencedIndex.getKey(row) == foreignKey.getKey(row) {
+			continue
+		}
+		referencedTable := engine.Tables[foreignKey.referencedTable]
+		if foreignKey.referencedTable == tableName {
+			referencedTable = table
+		}
+		return &ForeignKeyViolationError{
+			constraintName:  foreignKey.name,
+			tableName:       tableName,
+			referencedTable: foreignKey.referencedTable,
+			referencedKey:   formatKey(referencedTable, foreignKey.referencedIndex.columns, foreignKey.columns, row),
+		}
+	}
+	return nil
+}
+
+// getReferences - Return foreign keys of all tables which refer to the table, they are sorted by name of the table,
+// so actions are always done in the same order
+func (engine *DbEngine) getReferences(tableName string) []reference {
+	tableNames := make([]string, 0, len(engine.Tables))
+	for name := range engine.Tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	references := make([]reference, 0)
+	for _, name := range tableNames {
+		table := engine.Tables[name]
+		for _, foreignKey := range table.foreignKeys {
+			if foreignKey.referencedTable == tableName {
+				references = append(references, reference{tableName: name, table: table, foreignKey: foreignKey})
+			}
+		}
+	}
+	return references
+}
+
+// updateRows - Change values of rows and do actions of foreign keys referring to changed keys, updatedRows maps
+// position of the row to new values of changed columns, every change is saved in changes, so tables left partially
+// changed on error can be reverted
+func (engine *DbEngine) updateRows(tableName string, table *Table, updatedRows map[int]map[int]ValueInterface, commandName string, changes *changeLog) error {
+	if len(updatedRows) == 0 {
+		return nil
+	}
+	rowIndexes := make([]int, 0, len(updatedRows))
+	for rowIndex := range updatedRows {
+		rowIndexes = append(rowIndexes, rowIndex)
+	}
+	sort.Ints(rowIndexes)
+
+	references := engine.getReferences(tableName)
+	// Old keys of referenced rows are mapped to their new values, so CASCADE can update referring rows
+	newKeys := make(map[*uniqueIndex]map[string][]ValueInterface)
+	for _, reference := range references {
+		newKeys[reference.foreignKey.referencedIndex] = make(map[string][]ValueInterface)
+	}
+
+	oldRows := make([][]ValueInterface, 0, len(rowIndexes))
+	newRows := make([][]ValueInterface, 0, len(rowIndexes))
+	changedColumns := make(map[int]bool)
+	for _, rowIndex := range rowIndexes {
+		oldRow := getRowValues(table, rowIndex)
+		newRow := slices.Clone(oldRow)
+		for colIndex, value := range updatedRows[rowIndex] {
+			newRow[colIndex] = value
+			changedColumns[colIndex] = true
+		}
+		err := table.validateRow(tableName, newRow, commandName)
+		if err != nil {
+			return err
+		}
+		oldRows = append(oldRows, oldRow)
+		newRows = append(newRows, newRow)
+
+		for index, keys := range newKeys {
+			newKey := make([]ValueInterface, 0, len(index.columns))
+			for _, column := range index.columns {
+				newKey = append(newKey, newRow[column])
+			}
+			keys[index.getKey(oldRow)] = newKey
+		}
+	}
+
+	err := table.setRows(tableName, rowIndexes, oldRows, newRows, changedColumns)
+	if err != nil {
+		return err
+	}
+	*changes = append(*changes, rowsChange{
+		tableName:      tableName,
+		table:          table,
+		rowIndexes:     rowIndexes,
+		oldRows:        oldRows,
+		newRows:        newRows,
+		changedColumns: changedColumns,
+	})
+	err = engine.applyReferentialActions(tableName, token.UPDATE, newKeys, changes)
+	if err != nil {
+		return err
+	}
+
+	// References are checked after actions, so row can refer to other row of the table which key was changed
+	for _, rowIndex := range rowIndexes {
+		err = table.validateReferences(engine, tableName, getRowValues(table, rowIndex))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteRows - Remove rows from the table and do actions of foreign keys referring to removed keys, every change is
+// saved in changes, so tables left partially changed on error can be reverted
+func (engine *DbEngine) deleteRows(tableName string, table *Table, deletedRows map[int]bool, changes *changeLog) error {
+	if len(deletedRows) == 0 {
+		return nil
+	}
+	rowIndexes := make([]int, 0, len(deletedRows))
+	for rowIndex := range deletedRows {
+		rowIndexes = append(rowIndexes, rowIndex)
+	}
+	sort.Ints(rowIndexes)
+	removedRows := make([][]ValueInterface, 0, len(rowIndexes))
+	for _, rowIndex := range rowIndexes {
+		removedRows = append(removedRows, getRowValues(table, rowIndex))
+	}
+
+	// Only values are replaced, so the table keeps its constraints
+	for _, column := range table.Columns {
+		values := make([]ValueInterface, 0, len(column.Values)-len(deletedRows))
+		for rowIndex, value := range column.Values {
+			if !deletedRows[rowIndex] {
+				values = append(values, value)
+			}
+		}
+		column.Values = values
+	}
+	// Only removed rows are found in indexes, positions of other rows are moved
+	table.removeFromIndexes(rowIndexes, removedRows)
+	*changes = append(*changes, rowsChange{tableName: tableName, table: table, rowIndexes: rowIndexes, oldRows: removedRows})
+	return engine.applyReferentialActions(tableName, token.DELETE, nil, changes)
+}
+
+// applyReferentialActions - Do ON DELETE or ON UPDATE actions for rows which refer to keys that don't exist anymore
+// in the table, newKeys maps old keys of updated rows to their new values
+func (engine *DbEngine) applyReferentialActions(tableName string, commandName string, newKeys map[*uniqueIndex]map[string][]ValueInterface, changes *changeLog) error {
+	for _, reference := range engine.getReferences(tableName) {
+		foreignKey := reference.foreignKey
+		action := foreignKey.onDelete
+		if commandName == token.UPDATE {
+			action = foreignKey.onUpdate
+		}
+
+		deletedRows := make(map[int]bool)
+		updatedRows := make(map[int]map[int]ValueInterface)
+		for rowIndex := 0; rowIndex < len(reference.table.Columns[0].Values); rowIndex++ {
+			row := getRowValues(reference.table, rowIndex)
+			if !foreignKey.isReferenceMissing(row) {
+				continue
+			}
+
+			newKey, hasNewKey := newKeys[foreignKey.referencedIndex][foreignKey.getKey(row)]
+			switch {
+			case action == ast.Cascade && commandName == token.DELETE:
+				deletedRows[rowIndex] = true
+			case action == ast.Cascade && hasNewKey:
+				updatedRows[rowIndex] = make(map[int]ValueInterface, len(foreignKey.columns))
+				for i, column := range foreignKey.columns {
+					updatedRows[rowIndex][column] = newKey[i]
+				}
+			case action == ast.SetNull:
+				updatedRows[rowIndex] = make(map[int]ValueInterface, len(foreignKey.columns))
+				for _, column := range foreignKey.columns {
+					updatedRows[rowIndex][column] = NullValue{}
+				}
+			default:
+				return &ReferencedKeyError{
+					tableName:        tableName,
+					constraintName:   foreignKey.name,
+					referencingTable: reference.tableName,
+					key:              formatKey(engine.Tables[tableName], foreignKey.referencedIndex.columns, foreignKey.columns, row),
+				}
+			}
+		}
+
+		err := engine.deleteRows(reference.tableName, reference.table, deletedRows, changes)
+		if err != nil {
+			return err
+		}
+		err = engine.updateRows(reference.tableName, reference.table, updatedRows, commandName, changes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowsChange - Rows of the table changed or removed by command, rowIndexes are sorted positions of rows, oldRows and
+// newRows contain their values before and after change, newRows is nil when rows were removed
+type rowsChange struct {
+	tableName      string
+	table          *Table
+	rowIndexes     []int
+	oldRows        [][]ValueInterface
+	newRows        [][]ValueInterface
+	changedColumns map[int]bool
+}
+
+// changeLog - Changes of rows done by command and by actions of foreign keys, only changed and removed rows are
+// saved, so they can be brought back when command fails
+type changeLog []rowsChange
+
+// revert - Bring back saved rows, changes are reverted from the last one, so every row is at the same position as
+// when it was changed
+func (changes changeLog) revert() {
+	for i := len(changes) - 1; i >= 0; i-- {
+		change := changes[i]
+		if change.newRows == nil {
+			change.table.insertRows(change.rowIndexes, change.oldRows)
+			continue
+		}
+		// Old rows were valid, so their keys can be brought back without error
+		_ = change.table.setRows(change.tableName, change.rowIndexes, change.newRows, change.oldRows, change.changedColumns)
+	}
+}
+
+// dropReferences - Remove foreign keys of other tables which refer to dropped table, error is returned when any
+// foreign key exists and CASCADE isn't used
+func (engine *DbEngine) dropReferences(command *ast.DropCommand) error {
+	tableName := command.Name.Token.Literal
+	for _, reference := range engine.getReferences(tableName) {
+		if reference.tableName == tableName {
+			continue
+		}
+		if !command.Cascade {
+			return &DependentObjectsExistError{tableName: tableName, constraintName: reference.foreignKey.name, referencingTable: reference.tableName}
+		}
+		reference.table.foreignKeys = slices.DeleteFunc(reference.table.foreignKeys, func(foreignKey *foreignKey) bool {
+			return foreignKey == reference.foreignKey
+		})
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+)
+
+// variadicArguments - Value of scalarFunction.maxArguments for functions without upper limit of arguments
+const variadicArguments = -1
+
+// scalarFunction - Definition of function that can be called inside expressions, it's evaluated for every row
+// separately
+type scalarFunction struct {
+	minArguments   int
+	maxArguments   int  // variadicArguments if function accepts any number of arguments
+	propagatesNull bool // function returns NULL without evaluation if any of arguments is NULL
+	evaluate       func(functionName string, arguments []ValueInterface) (ValueInterface, error)
+	// evaluateInEngine - used instead of evaluate by functions which read or change state of the engine, ex. NEXTVAL
+	evaluateInEngine func(engine *DbEngine, functionName string, arguments []ValueInterface) (ValueInterface, error)
+}
+
+// scalarFunctions - Registry of all functions available in expressions, mapped by upper-case name
+var scalarFunctions = make(map[string]scalarFunction)
+
+// registerScalarFunction - Make function available in expressions, names are case-insensitive
+func registerScalarFunction(name string, function scalarFunction) {
+	scalarFunctions[strings.ToUpper(name)] = function
+}
+
+// callScalarFunction - Evaluate arguments of function call for the row and return result of function
+func (engine *DbEngine) callScalarFunction(functionCall ast.FunctionCall, row map[string]ValueInterface) (ValueInterface, error) {
+	functionName := functionCall.Name.Literal
+	function, exist := scalarFunctions[strings.ToUpper(functionName)]
+	if !exist {
+		return nil, &FunctionDoesNotExistError{functionName: functionName}
+	}
+
+	argumentsCount := len(functionCall.Arguments)
+	if argumentsCount < function.minArguments ||
+		(function.maxArguments != variadicArguments && argumentsCount > function.maxArguments) {
+		return nil, &InvalidNumberOfFunctionArgumentsError{functionName: functionName, minNumber: function.minArguments,
+			maxNumber: function.maxArguments, actualNumber: argumentsCount}
+	}
+
+	arguments := make([]ValueInterface, 0, argumentsCount)
+	containsNull := false
+	for _, argument := range functionCall.Arguments {
+		value, err := engine.getTifierValue(argument, row)
+		if err != nil {
+			return nil, err
+		}
+		containsNull = containsNull || value.GetType() == NullType
+		arguments = append(arguments, value)
+	}
+
+	if function.propagatesNull && containsNull {
+		return NullValue{}, nil
+	}
+
+	if function.evaluateInEngine != nil {
+		return function.evaluateInEngine(engine, functionName, arguments)
+	}
+	return function.evaluate(functionName, arguments)
+}
+
+// getValuesOfFunction - Return values of function call evaluated for every row of the table
+func (engine *DbEngine) getValuesOfFunction(functionCall ast.FunctionCall, table *Table) ([]ValueInterface, error) {
+	values := make([]ValueInterface, 0)
+	if len(table.Columns) == 0 {
+		return values, nil
+	}
+
+	for rowIndex := 0; rowIndex < len(table.Columns[0].Values); rowIndex++ {
+		value, err := engine.callScalarFunction(functionCall, getRow(table, rowIndex))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// getTextArgument - Return text representation of function argument or error if argument is BLOB, as its bytes
+// aren't text
+func getTextArgument(functionName string, argument ValueInterface) (string, error) {
+	if argument.GetType() == BlobType {
+		return "", &InvalidFunctionArgumentError{functionName: functionName, expectedType: "TEXT", actualValue: argument.ToString()}
+	}
+	return argument.ToString(), nil
+}
+
+// getTextArguments - Return text representation of all given function arguments or error if any of them is BLOB
+func getTextArguments(functionName string, arguments []ValueInterface) ([]string, error) {
+	texts := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		text, err := getTextArgument(functionName, argument)
+		if err != nil {
+			return nil, err
+		}
+		texts = append(texts, text)
+	}
+	return texts, nil
+}
+
+// getNumericArgument - Return INT, FLOAT or DECIMAL function argument or error if argument has different type
+func getNumericArgument(functionName string, argument ValueInterface) (ValueInterface, error) {
+	if !isNumeric(argument) {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "NUMERIC", actualValue: argument.ToString()}
+	}
+	return argument, nil
+}
+
+// getIntegerArgument - Return integer from function argument or error if argument has different type
+func getIntegerArgument(functionName string, argument ValueInterface) (int, error) {
+	integerValue, isInteger := argument.(IntegerValue)
+	if !isInteger {
+		return 0, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "INT", actualValue: argument.ToString()}
+	}
+	if integerValue.Value < math.MinInt32 || integerValue.Value > math.MaxInt32 {
+		return 0, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "INT", actualValue: argument.ToString()}
+	}
+	return int(integerValue.Value), nil
+}
+
+// callsEngineFunction - Return true if expression calls function reading or changing state of the engine, such
+// expression can't be evaluated in advance, ex. to validate default value
+func callsEngineFunction(tifier ast.Tifier) bool {
+	functionCall, isFunctionCall := tifier.(ast.FunctionCall)
+	if !isFunctionCall {
+		return false
+	}
+	if scalarFunctions[strings.ToUpper(functionCall.Name.Literal)].evaluateInEngine != nil {
+		return true
+	}
+	return slices.ContainsFunc(functionCall.Arguments, callsEngineFunction)
+}
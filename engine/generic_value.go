@@ -1,10 +1,15 @@
 package engine
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
 )
 
 // ValueInterface - Represent all supported types of data that can be inserted into Table
@@ -22,22 +27,45 @@ const (
 	IntType = iota
 	StringType
 	NullType
+	FloatType
+	DecimalType
+	BooleanType
+	DateType
+	TimeType
+	TimestampType
+	IntervalType
+	BlobType
+	JsonType
+	UuidType
+	EnumType
+	ArrayType
 )
 
 // IntegerValue - Implementation of ValueInterface that is containing integer values
 type IntegerValue struct {
-	Value int
+	Value int64
 }
 
 // StringValue - Implementation of ValueInterface that is containing string values
 type StringValue struct {
-	Value string
+	Value  string
+	Padded bool // value of CHAR(n) column padded with spaces, its trailing spaces are ignored in comparisons
+}
+
+// BooleanValue - Implementation of ValueInterface that is containing boolean values
+type BooleanValue struct {
+	Value bool
 }
 
 // NullValue - Implementation of ValueInterface that is containing null
 type NullValue struct {
 }
 
+// BlobValue - Implementation of ValueInterface that is containing binary data
+type BlobValue struct {
+	Value []byte
+}
+
 // HandleValue - Function to take an instance of ValueInterface and cast to a specific implementation
 func CastValueInterface(v ValueInterface) {
 	switch value := v.(type) {
@@ -45,6 +73,24 @@ func CastValueInterface(v ValueInterface) {
 		fmt.Printf("IntegerValue with Value: %d\n", value.Value)
 	case StringValue:
 		fmt.Printf("StringValue with Value: %s\n", value.Value)
+	case FloatValue:
+		fmt.Printf("FloatValue with Value: %s\n", value.ToString())
+	case DecimalValue:
+		fmt.Printf("DecimalValue with Value: %s\n", value.ToString())
+	case BooleanValue:
+		fmt.Printf("BooleanValue with Value: %t\n", value.Value)
+	case BlobValue:
+		fmt.Printf("BlobValue with Value: %s\n", value.ToString())
+	case JsonValue:
+		fmt.Printf("JsonValue with Value: %s\n", value.ToString())
+	case UuidValue:
+		fmt.Printf("UuidValue with Value: %s\n", value.ToString())
+	case EnumValue:
+		fmt.Printf("EnumValue of type %s with Value: %s\n", value.Enum.Name, value.Value)
+	case ArrayValue:
+		fmt.Printf("ArrayValue with Values: %s\n", value.ToString())
+	case DateValue, TimeValue, TimestampValue, IntervalValue:
+		fmt.Printf("%T with Value: %s\n", value, value.ToString())
 	case NullValue:
 		fmt.Println("NullValue (no value)")
 	default:
@@ -53,14 +99,25 @@ func CastValueInterface(v ValueInterface) {
 }
 
 // ToString implementations
-func (value IntegerValue) ToString() string { return strconv.Itoa(value.Value) }
+func (value IntegerValue) ToString() string { return strconv.FormatInt(value.Value, 10) }
 func (value StringValue) ToString() string  { return value.Value }
 func (value NullValue) ToString() string    { return "NULL" }
+func (value BooleanValue) ToString() string {
+	if value.Value {
+		return token.TRUE
+	}
+	return token.FALSE
+}
+
+// ToString - Return bytes in hexadecimal form prefixed with \x, ex. \xdeadbeef
+func (value BlobValue) ToString() string { return "\\x" + hex.EncodeToString(value.Value) }
 
 // GetType implementations
 func (value IntegerValue) GetType() SupportedTypes { return IntType }
 func (value StringValue) GetType() SupportedTypes  { return StringType }
 func (value NullValue) GetType() SupportedTypes    { return NullType }
+func (value BooleanValue) GetType() SupportedTypes { return BooleanType }
+func (value BlobValue) GetType() SupportedTypes    { return BlobType }
 
 // IsEqual implementations
 func (value IntegerValue) IsEqual(valueInterface ValueInterface) bool {
@@ -72,6 +129,12 @@ func (value StringValue) IsEqual(valueInterface ValueInterface) bool {
 func (value NullValue) IsEqual(valueInterface ValueInterface) bool {
 	return areEqual(value, valueInterface)
 }
+func (value BooleanValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+func (value BlobValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
 
 // isSmallerThan implementations
 func (value IntegerValue) isSmallerThan(secondValue ValueInterface) bool {
@@ -80,12 +143,11 @@ func (value IntegerValue) isSmallerThan(secondValue ValueInterface) bool {
 		return nullValue.isGreaterThan(value)
 	}
 
-	secondValueAsInteger, isInteger := secondValue.(IntegerValue)
-	if !isInteger {
+	if !isNumeric(secondValue) {
 		log.Fatal("Can't compare Integer with other type")
 	}
 
-	return value.Value < secondValueAsInteger.Value
+	return compareNumbers(value, secondValue) < 0
 }
 
 func (value StringValue) isSmallerThan(secondValue ValueInterface) bool {
@@ -99,7 +161,38 @@ func (value StringValue) isSmallerThan(secondValue ValueInterface) bool {
 		log.Fatal("Can't compare String with other type")
 	}
 
-	return value.Value < secondValueAsString.Value
+	first, second := getComparedTexts(value, secondValueAsString)
+	return first < second
+}
+
+// FALSE is smaller than TRUE
+func (value BooleanValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	secondValueAsBoolean, isBoolean := secondValue.(BooleanValue)
+	if !isBoolean {
+		log.Fatal("Can't compare Boolean with other type")
+	}
+
+	return !value.Value && secondValueAsBoolean.Value
+}
+
+// Bytes are compared one by one, shorter value is smaller if it's prefix of the other one
+func (value BlobValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	secondValueAsBlob, isBlob := secondValue.(BlobValue)
+	if !isBlob {
+		log.Fatal("Can't compare Blob with other type")
+	}
+
+	return bytes.Compare(value.Value, secondValueAsBlob.Value) < 0
 }
 
 func (value NullValue) isSmallerThan(secondValue ValueInterface) bool {
@@ -119,12 +212,11 @@ func (value IntegerValue) isGreaterThan(secondValue ValueInterface) bool {
 		return nullValue.isSmallerThan(value)
 	}
 
-	secondValueAsInteger, isInteger := secondValue.(IntegerValue)
-	if !isInteger {
+	if !isNumeric(secondValue) {
 		log.Fatal("Can't compare Integer with other type")
 	}
 
-	return value.Value > secondValueAsInteger.Value
+	return compareNumbers(value, secondValue) > 0
 }
 func (value StringValue) isGreaterThan(secondValue ValueInterface) bool {
 	nullValue, isNull := secondValue.(NullValue)
@@ -137,7 +229,36 @@ func (value StringValue) isGreaterThan(secondValue ValueInterface) bool {
 		log.Fatal("Can't compare String with other type")
 	}
 
-	return value.Value > secondValueAsString.Value
+	first, second := getComparedTexts(value, secondValueAsString)
+	return first > second
+}
+
+func (value BooleanValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	secondValueAsBoolean, isBoolean := secondValue.(BooleanValue)
+	if !isBoolean {
+		log.Fatal("Can't compare Boolean with other type")
+	}
+
+	return value.Value && !secondValueAsBoolean.Value
+}
+
+func (value BlobValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	secondValueAsBlob, isBlob := secondValue.(BlobValue)
+	if !isBlob {
+		log.Fatal("Can't compare Blob with other type")
+	}
+
+	return bytes.Compare(value.Value, secondValueAsBlob.Value) > 0
 }
 
 func (value NullValue) isGreaterThan(_ ValueInterface) bool {
@@ -145,9 +266,31 @@ func (value NullValue) isGreaterThan(_ ValueInterface) bool {
 }
 
 func areEqual(first ValueInterface, second ValueInterface) bool {
+	if isNumeric(first) && isNumeric(second) {
+		return compareNumbers(first, second) == 0
+	}
+	if isTemporal(first) && isTemporal(second) {
+		comparison, isComparable := compareTemporals(first, second)
+		return isComparable && comparison == 0
+	}
+	firstText, isFirstText := first.(StringValue)
+	secondText, isSecondText := second.(StringValue)
+	if isFirstText && isSecondText {
+		firstComparedText, secondComparedText := getComparedTexts(firstText, secondText)
+		return firstComparedText == secondComparedText
+	}
 	return first.GetType() == second.GetType() && first.ToString() == second.ToString()
 }
 
+// getComparedTexts - Return texts in the form they are compared, trailing spaces are ignored when any of them is
+// padded value of CHAR(n) column, so 'ab' is equal to 'ab ' stored in CHAR(3) column
+func getComparedTexts(first StringValue, second StringValue) (string, string) {
+	if first.Padded || second.Padded {
+		return strings.TrimRight(first.Value, " "), strings.TrimRight(second.Value, " ")
+	}
+	return first.Value, second.Value
+}
+
 func getMin(values []ValueInterface) (ValueInterface, error) {
 	if len(values) == 0 {
 		return nil, errors.New("can't extract min from empty array")
@@ -176,3 +319,13 @@ func getMax(values []ValueInterface) (ValueInterface, error) {
 
 	return maxValue, nil
 }
+
+func withoutNullValues(values []ValueInterface) []ValueInterface {
+	nonNullValues := make([]ValueInterface, 0)
+	for _, value := range values {
+		if value.GetType() != NullType {
+			nonNullValues = append(nonNullValues, value)
+		}
+	}
+	return nonNullValues
+}
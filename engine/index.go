@@ -0,0 +1,287 @@
+package engine
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// uniqueIndex - Internal index of the table which maps values of its columns to the row containing them, it's used
+// to check uniqueness of keys without scanning the whole table
+type uniqueIndex struct {
+	constraint    string // name of the constraint shown in errors, it's empty for primary key
+	columns       []int  // positions of indexed columns in the table
+	nullsDistinct bool   // NULL isn't equal to other NULL, so keys containing NULL are never duplicated
+	rows          map[string]int
+}
+
+// newPrimaryKeyIndex - Return index of primary key, its columns can't contain NULL
+func newPrimaryKeyIndex(columns []int) *uniqueIndex {
+	return &uniqueIndex{columns: columns, rows: make(map[string]int)}
+}
+
+func newUniqueIndex(constraint string, columns []int, nullsDistinct bool) *uniqueIndex {
+	return &uniqueIndex{constraint: constraint, columns: columns, nullsDistinct: nullsDistinct, rows: make(map[string]int)}
+}
+
+// getKey - Return key of the row made of values of indexed columns, values are quoted, so keys of different rows
+// can't be mixed up, and equal numbers written with different scale have the same key
+func (index *uniqueIndex) getKey(row []ValueInterface) string {
+	values := make([]ValueInterface, 0, len(index.columns))
+	for _, column := range index.columns {
+		values = append(values, row[column])
+	}
+	return getKeyOfValues(values)
+}
+
+// getKeyOfValues - Return key made of values ordered the same way as columns of the index
+func getKeyOfValues(values []ValueInterface) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value.GetType() == NullType {
+			// Unquoted, so NULL has different key than text 'NULL'
+			parts = append(parts, token.NULL)
+			continue
+		}
+		if decimal, isDecimal := value.(DecimalValue); isDecimal {
+			value = decimal.trimTrailingZeros(0)
+		}
+		if text, isText := value.(StringValue); isText && text.Padded {
+			// Trailing spaces of CHAR(n) values are ignored, so they have the same key as text compared with them
+			value = StringValue{Value: strings.TrimRight(text.Value, " ")}
+		}
+		parts = append(parts, strconv.Quote(value.ToString()))
+	}
+	return strings.Join(parts, ",")
+}
+
+// isIndexed - Return false if the row isn't stored in the index, it happens when NULLs are distinct and key of the
+// row contains NULL
+func (index *uniqueIndex) isIndexed(row []ValueInterface) bool {
+	if !index.nullsDistinct {
+		return true
+	}
+	for _, column := range index.columns {
+		if row[column].GetType() == NullType {
+			return false
+		}
+	}
+	return true
+}
+
+// validate - Return error if key of the row is already used by other row
+func (index *uniqueIndex) validate(table *Table, tableName string, row []ValueInterface) error {
+	if !index.isIndexed(row) {
+		return nil
+	}
+	if _, exists := index.rows[index.getKey(row)]; exists {
+		return index.getDuplicateKeyError(table, tableName, row)
+	}
+	return nil
+}
+
+// add - Add row with provided position in the table to the index, the row should be validated before
+func (index *uniqueIndex) add(row []ValueInterface, rowIndex int) {
+	if index.isIndexed(row) {
+		index.rows[index.getKey(row)] = rowIndex
+	}
+}
+
+// remove - Remove key of the row from the index
+func (index *uniqueIndex) remove(row []ValueInterface) {
+	if index.isIndexed(row) {
+		delete(index.rows, index.getKey(row))
+	}
+}
+
+// replace - Replace keys of rows with provided positions, oldRows and newRows contain values of these rows before and
+// after change, only keys of these rows are checked and index is left unchanged if any new key is duplicated
+func (index *uniqueIndex) replace(table *Table, tableName string, rowIndexes []int, oldRows [][]ValueInterface, newRows [][]ValueInterface) error {
+	// Old keys are removed first, so rows can swap their keys
+	for _, oldRow := range oldRows {
+		index.remove(oldRow)
+	}
+	for i, newRow := range newRows {
+		err := index.validate(table, tableName, newRow)
+		if err != nil {
+			for _, addedRow := range newRows[:i] {
+				index.remove(addedRow)
+			}
+			for j, oldRow := range oldRows {
+				index.add(oldRow, rowIndexes[j])
+			}
+			return err
+		}
+		index.add(newRow, rowIndexes[i])
+	}
+	return nil
+}
+
+// shiftRows - Change positions of all rows stored in the index, it's used when rows before them were removed or
+// inserted, shift returns new position of the row
+func (index *uniqueIndex) shiftRows(shift func(int) int) {
+	for key, rowIndex := range index.rows {
+		index.rows[key] = shift(rowIndex)
+	}
+}
+
+// rebuild - Fill the index with all rows of the table, it's used when values of the whole column were changed, index
+// is left unchanged if any key is duplicated
+func (index *uniqueIndex) rebuild(table *Table, tableName string) error {
+	previousRows := index.rows
+	index.rows = make(map[string]int, len(previousRows))
+
+	for rowIndex := 0; rowIndex < len(table.Columns[0].Values); rowIndex++ {
+		row := getRowValues(table, rowIndex)
+		err := index.validate(table, tableName, row)
+		if err != nil {
+			index.rows = previousRows
+			return err
+		}
+		index.add(row, rowIndex)
+	}
+	return nil
+}
+
+func (index *uniqueIndex) getDuplicateKeyError(table *Table, tableName string, row []ValueInterface) error {
+	key := formatKey(table, index.columns, index.columns, row)
+
+	if index.constraint == "" {
+		return &DuplicateKeyError{constraint: token.PRIMARY + " " + token.KEY, tableName: tableName, key: key}
+	}
+	return &UniqueViolationError{constraintName: index.constraint, tableName: tableName, key: key}
+}
+
+// formatKey - Return key shown in errors, ex. (one, two)=(1, a), names of columns are taken from nameTable and values
+// are taken from the row, it can be row of other table when foreign key is shown
+func formatKey(nameTable *Table, nameColumns []int, valueColumns []int, row []ValueInterface) string {
+	columnNames := make([]string, 0, len(nameColumns))
+	for _, column := range nameColumns {
+		columnNames = append(columnNames, nameTable.Columns[column].Name)
+	}
+	values := make([]string, 0, len(valueColumns))
+	for _, column := range valueColumns {
+		values = append(values, row[column].ToString())
+	}
+	return "(" + strings.Join(columnNames, ", ") + ")=(" + strings.Join(values, ", ") + ")"
+}
+
+// getIndexes - Return all indexes of the table which keys have to be unique
+func (table *Table) getIndexes() []*uniqueIndex {
+	if table.primaryKey == nil {
+		return table.uniqueConstraints
+	}
+	return append([]*uniqueIndex{table.primaryKey}, table.uniqueConstraints...)
+}
+
+// validateKeys - Return error if the row would duplicate key of any index of the table
+func (table *Table) validateKeys(tableName string, row []ValueInterface) error {
+	for _, index := range table.getIndexes() {
+		err := index.validate(table, tableName, row)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addKeys - Add row with provided position in the table to all indexes of the table
+func (table *Table) addKeys(row []ValueInterface, rowIndex int) {
+	for _, index := range table.getIndexes() {
+		index.add(row, rowIndex)
+	}
+	for _, index := range table.indexes {
+		index.add(row, rowIndex)
+	}
+}
+
+// updateIndexes - Replace keys of changed rows in indexes containing changed columns, oldRows and newRows contain values
+// of rows with provided positions before and after change, indexes are left unchanged if any new key is duplicated
+func (table *Table) updateIndexes(tableName string, rowIndexes []int, oldRows [][]ValueInterface, newRows [][]ValueInterface, changedColumns map[int]bool) error {
+	isChanged := func(columns []int) bool {
+		return slices.ContainsFunc(columns, func(column int) bool { return changedColumns[column] })
+	}
+	updatedIndexes := make([]*uniqueIndex, 0)
+	for _, index := range table.getIndexes() {
+		if !isChanged(index.columns) {
+			continue
+		}
+		err := index.replace(table, tableName, rowIndexes, oldRows, newRows)
+		if err != nil {
+			// Old keys were valid, so they can be brought back without error
+			for _, updatedIndex := range updatedIndexes {
+				_ = updatedIndex.replace(table, tableName, rowIndexes, newRows, oldRows)
+			}
+			return err
+		}
+		updatedIndexes = append(updatedIndexes, index)
+	}
+	for _, index := range table.indexes {
+		if isChanged(index.getStoredColumns()) {
+			index.removeRows(rowIndexes, oldRows)
+			index.addRows(rowIndexes, newRows)
+		}
+	}
+	return nil
+}
+
+// removeFromIndexes - Remove rows with provided sorted positions from all indexes of the table and move positions of
+// following rows, so they match positions in the table after rows are removed, rows contain values of removed rows
+func (table *Table) removeFromIndexes(rowIndexes []int, rows [][]ValueInterface) {
+	shift := func(rowIndex int) int {
+		return rowIndex - sort.SearchInts(rowIndexes, rowIndex)
+	}
+	for _, index := range table.getIndexes() {
+		for _, row := range rows {
+			index.remove(row)
+		}
+		index.shiftRows(shift)
+	}
+	for _, index := range table.indexes {
+		index.removeRows(rowIndexes, rows)
+		index.shiftRows(shift)
+	}
+}
+
+// insertIntoIndexes - Add rows with provided sorted positions to all indexes of the table and move positions of
+// following rows, it reverts removeFromIndexes when removed rows are inserted back to the table
+func (table *Table) insertIntoIndexes(rowIndexes []int, rows [][]ValueInterface) {
+	// Row which was at position rowIndex is moved by the number of inserted rows placed before it
+	shift := func(rowIndex int) int {
+		return rowIndex + sort.Search(len(rowIndexes), func(i int) bool { return rowIndexes[i]-i > rowIndex })
+	}
+	for _, index := range table.getIndexes() {
+		index.shiftRows(shift)
+		for i, row := range rows {
+			index.add(row, rowIndexes[i])
+		}
+	}
+	for _, index := range table.indexes {
+		index.shiftRows(shift)
+		index.addRows(rowIndexes, rows)
+	}
+}
+
+// rebuildIndexes - Fill indexes of the table again, only indexes containing changed columns are rebuilt unless
+// changedColumns is nil, error is returned if any key is duplicated, indexes which were already rebuilt aren't
+// reverted, so rows should be reverted and indexes rebuilt again
+func (table *Table) rebuildIndexes(tableName string, changedColumns map[int]bool) error {
+	for _, index := range table.getIndexes() {
+		if changedColumns != nil && !slices.ContainsFunc(index.columns, func(column int) bool { return changedColumns[column] }) {
+			continue
+		}
+		err := index.rebuild(table, tableName)
+		if err != nil {
+			return err
+		}
+	}
+	for _, index := range table.indexes {
+		if changedColumns == nil || slices.ContainsFunc(index.getStoredColumns(), func(column int) bool { return changedColumns[column] }) {
+			index.rebuild(table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// selectWithIndex - Return rows of the table fulfilling WHERE of the command and sorted by its ORDER BY, when an
+// ordered index can be used for it, nil is returned otherwise, rows are read in order of the index, so they don't
+// have to be sorted and when the index stores all columns used by the command, values are read from its entries
+// instead of columns of the table
+func (engine *DbEngine) selectWithIndex(selectCommand *ast.SelectCommand, table *Table) (*Table, error) {
+	if len(table.indexes) == 0 || len(table.Columns) == 0 {
+		return nil, nil
+	}
+	usedColumnNames := getUsedColumnNames(selectCommand, table)
+	// Missing columns are reported by regular select
+	if engine.getMissingColumnName(usedColumnNames, table) != "" {
+		return nil, nil
+	}
+
+	var conditions []indexCondition
+	if selectCommand.HasWhereCommand() {
+		conditions = engine.getIndexConditions(table, getConjuncts(selectCommand.WhereCommand.Expression))
+	}
+	var index *secondaryIndex
+	var entries []indexEntry
+	if selectCommand.HasOrderByCommand() {
+		index, entries = getOrderingIndex(table, conditions, selectCommand.OrderByCommand.SortPatterns)
+	} else if selectCommand.HasWhereCommand() {
+		index, entries = getCoveringIndex(table, conditions, usedColumnNames)
+		if index == nil {
+			return nil, nil
+		}
+		// Other indexes can find fewer rows, ex. hash index for equality, then reading the table is cheaper
+		rows, isIndexed := engine.getIndexedRows(table, selectCommand.WhereCommand.Expression)
+		if isIndexed && len(rows) < len(entries) {
+			return nil, nil
+		}
+		entries = slices.Clone(entries)
+		slices.SortFunc(entries, func(first indexEntry, second indexEntry) int { return cmp.Compare(first.row, second.row) })
+	}
+	if index == nil {
+		return nil, nil
+	}
+
+	return engine.getTableOfEntries(selectCommand, table, index, entries, index.isCovering(table, usedColumnNames))
+}
+
+// getTableOfEntries - Return table containing rows of entries fulfilling WHERE of the command, when index is covering,
+// only columns stored in the index are returned and their values are taken from entries
+func (engine *DbEngine) getTableOfEntries(selectCommand *ast.SelectCommand, table *Table, index *secondaryIndex, entries []indexEntry, isCovering bool) (*Table, error) {
+	selectedTable := getCopyOfTableWithoutRows(table)
+	storedColumns := index.getStoredColumns()
+	if isCovering {
+		selectedTable.Columns = slices.DeleteFunc(selectedTable.Columns, func(column *Column) bool {
+			return !slices.ContainsFunc(storedColumns, func(storedColumn int) bool { return table.Columns[storedColumn].Name == column.Name })
+		})
+	}
+
+	for _, entry := range entries {
+		var row map[string]ValueInterface
+		if isCovering {
+			row = make(map[string]ValueInterface, len(storedColumns))
+			for i, column := range storedColumns {
+				row[table.Columns[column].Name] = entry.values[i]
+			}
+		} else {
+			row = getRow(table, entry.row)
+		}
+
+		if selectCommand.HasWhereCommand() {
+			fulfilledFilters, err := engine.isFulfillingFilters(row, selectCommand.WhereCommand.Expression, selectCommand.WhereCommand.Token.Literal)
+			if err != nil {
+				return nil, err
+			}
+			if !fulfilledFilters {
+				continue
+			}
+		}
+		for _, column := range selectedTable.Columns {
+			column.Values = append(column.Values, row[column.Name])
+		}
+	}
+	return selectedTable, nil
+}
+
+// getOrderingIndex - Return ordered index which entries fulfilling conditions can be read in order of sort patterns
+// and these entries sorted the same way as by ORDER BY, index with the fewest entries is chosen, sort patterns have
+// to list following indexed columns in the same direction starting from the first column or the first column which
+// isn't compared with EQUAL, ex. index on (a, b) is used for ORDER BY a, b and for WHERE a = 1 ORDER BY b DESC
+func getOrderingIndex(table *Table, conditions []indexCondition, sortPatterns []ast.SortPattern) (*secondaryIndex, []indexEntry) {
+	var bestIndex *secondaryIndex
+	var bestEntries []indexEntry
+	bestFirstColumn := 0
+	for _, index := range table.indexes {
+		if index.method != ast.BTreeIndex {
+			continue
+		}
+		entries, equalColumns, _ := index.getEntries(table, conditions)
+		for _, firstColumn := range []int{0, equalColumns} {
+			if index.isOrderedBy(table, firstColumn, sortPatterns) && (bestIndex == nil || len(entries) < len(bestEntries)) {
+				bestIndex, bestEntries, bestFirstColumn = index, entries, firstColumn
+			}
+		}
+	}
+	if bestIndex == nil {
+		return nil, nil
+	}
+	return bestIndex, getEntriesInSortOrder(bestEntries, bestFirstColumn, len(sortPatterns), sortPatterns[0].Order.Type == token.DESC)
+}
+
+// getEntriesInSortOrder - Return entries in the same order as stable sort of their rows by values of sorted columns,
+// entries with equal sorted values keep order of their rows also when sorting is descending
+func getEntriesInSortOrder(entries []indexEntry, firstColumn int, columnsCount int, isDescending bool) []indexEntry {
+	groups := make([][]indexEntry, 0)
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && haveEqualValues(entries[start], entries[end], firstColumn, columnsCount) {
+			end++
+		}
+		group := slices.Clone(entries[start:end])
+		slices.SortFunc(group, func(first indexEntry, second indexEntry) int { return cmp.Compare(first.row, second.row) })
+		groups = append(groups, group)
+		start = end
+	}
+	if isDescending {
+		slices.Reverse(groups)
+	}
+	sortedEntries := make([]indexEntry, 0, len(entries))
+	for _, group := range groups {
+		sortedEntries = append(sortedEntries, group...)
+	}
+	return sortedEntries
+}
+
+// haveEqualValues - Return true if entries have equal values in provided range of indexed columns
+func haveEqualValues(first indexEntry, second indexEntry, firstColumn int, columnsCount int) bool {
+	for i := firstColumn; i < firstColumn+columnsCount; i++ {
+		if compareIndexValues(first.values[i], second.values[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// getCoveringIndex - Return ordered index storing all provided columns, which can be used for conditions, and its
+// entries fulfilling them, index with the fewest entries is chosen
+func getCoveringIndex(table *Table, conditions []indexCondition, columnNames []string) (*secondaryIndex, []indexEntry) {
+	var bestIndex *secondaryIndex
+	var bestEntries []indexEntry
+	for _, index := range table.indexes {
+		if index.method != ast.BTreeIndex || !index.isCovering(table, columnNames) {
+			continue
+		}
+		entries, _, usedColumns := index.getEntries(table, conditions)
+		if usedColumns > 0 && (bestIndex == nil || len(entries) < len(bestEntries)) {
+			bestIndex, bestEntries = index, entries
+		}
+	}
+	return bestIndex, bestEntries
+}
+
+// getUsedColumnNames - Return names of columns of the table used by selected spaces, WHERE and ORDER BY of the command
+func getUsedColumnNames(selectCommand *ast.SelectCommand, table *Table) []string {
+	columnNames := make([]string, 0)
+	for _, space := range selectCommand.Space {
+		switch {
+		case space.ContainsFunction():
+			columnNames = append(columnNames, getIdentifierNames(space.Function.GetIdentifiers())...)
+		case space.ColumnName.Type != token.ASTERISK:
+			columnNames = append(columnNames, space.ColumnName.Literal)
+		case !space.ContainsAggregateFunc():
+			for _, column := range table.Columns {
+				columnNames = append(columnNames, column.Name)
+			}
+		}
+	}
+	if selectCommand.HasWhereCommand() {
+		columnNames = append(columnNames, getIdentifierNames(selectCommand.WhereCommand.Expression.GetIdentifiers())...)
+	}
+	if selectCommand.HasOrderByCommand() {
+		for _, sortPattern := range selectCommand.OrderByCommand.SortPatterns {
+			if sortPattern.Function != nil {
+				columnNames = append(columnNames, getIdentifierNames(sortPattern.Function.GetIdentifiers())...)
+			} else {
+				columnNames = append(columnNames, sortPattern.ColumnName.Literal)
+			}
+		}
+	}
+	return columnNames
+}
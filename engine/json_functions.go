@@ -0,0 +1,146 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+func init() {
+	registerScalarFunction(token.ARROW, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: jsonArrow})
+	registerScalarFunction(token.DOUBLE_ARROW, scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: jsonArrow})
+	registerScalarFunction("JSON_EXTRACT", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: jsonExtract})
+	registerScalarFunction("JSON_ARRAY_LENGTH", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: jsonArrayLength})
+	registerScalarFunction("JSON_KEYS", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: jsonKeys})
+}
+
+// jsonArrow - json -> key and json ->> key return field of object or element of array (for INT key), -> returns JSON
+// and ->> returns SQL value, except objects and arrays which are returned as TEXT, missing field is NULL
+func jsonArrow(operator string, arguments []ValueInterface) (ValueInterface, error) {
+	document, err := getJsonArgument(operator, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var step jsonPathStep
+	switch key := arguments[1].(type) {
+	case StringValue:
+		step = jsonPathStep{key: key.Value}
+	case IntegerValue:
+		step = jsonPathStep{index: int(key.Value), isIndex: true}
+	default:
+		return nil, &InvalidFunctionArgumentError{functionName: operator, expectedType: "TEXT or INT", actualValue: arguments[1].ToString()}
+	}
+
+	field, exists := getJsonField(document.Value, step)
+	if !exists {
+		return NullValue{}, nil
+	}
+	if operator == token.ARROW {
+		return JsonValue{Value: field}, nil
+	}
+	value := convertFromJson(field)
+	if value.GetType() == JsonType {
+		return StringValue{Value: value.ToString()}, nil
+	}
+	return value, nil
+}
+
+// jsonExtract - JSON_EXTRACT(json, path) returns field selected by path, ex. '$.user.tags[0]', as SQL value, objects
+// and arrays are returned as JSON and missing field is NULL
+func jsonExtract(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	field, exists, err := getJsonPathArgument(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return NullValue{}, nil
+	}
+	return convertFromJson(field), nil
+}
+
+// jsonArrayLength - JSON_ARRAY_LENGTH(json [, path]) returns number of elements of JSON array
+func jsonArrayLength(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	field, exists, err := getJsonPathArgument(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return NullValue{}, nil
+	}
+	array, isArray := field.([]any)
+	if !isArray {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "JSON array", actualValue: JsonValue{Value: field}.ToString()}
+	}
+	return IntegerValue{Value: int64(len(array))}, nil
+}
+
+// jsonKeys - JSON_KEYS(json [, path]) returns JSON array of sorted keys of JSON object, NULL is returned when value
+// isn't an object
+func jsonKeys(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	field, exists, err := getJsonPathArgument(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return NullValue{}, nil
+	}
+	object, isObject := field.(map[string]any)
+	if !isObject {
+		return NullValue{}, nil
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]any, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key)
+	}
+	return JsonValue{Value: result}, nil
+}
+
+// getJsonArgument - Return JSON from function argument, text is parsed as JSON
+func getJsonArgument(functionName string, argument ValueInterface) (JsonValue, error) {
+	switch value := argument.(type) {
+	case JsonValue:
+		return value, nil
+	case StringValue:
+		return parseJson(value.Value)
+	default:
+		return JsonValue{}, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.JSON, actualValue: argument.ToString()}
+	}
+}
+
+// getJsonPathArgument - Return field of JSON from the first argument selected by path from the second argument and
+// false if field doesn't exist, whole document is returned when there is no path
+func getJsonPathArgument(functionName string, arguments []ValueInterface) (any, bool, error) {
+	document, err := getJsonArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, false, err
+	}
+	if len(arguments) == 1 {
+		return document.Value, true, nil
+	}
+
+	path, err := getTextArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, false, err
+	}
+	steps, err := parseJsonPath(path)
+	if err != nil {
+		return nil, false, err
+	}
+	field := document.Value
+	for _, step := range steps {
+		var exists bool
+		field, exists = getJsonField(field, step)
+		if !exists {
+			return nil, false, nil
+		}
+	}
+	return field, true, nil
+}
@@ -0,0 +1,186 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// JsonValue - Implementation of ValueInterface that is containing JSON document, numbers are kept as json.Number, so
+// they don't lose precision
+type JsonValue struct {
+	Value any
+}
+
+// jsonPathStep - Single step of path to the JSON field, it's either key of object or index of array
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// ToString - Return document in compact form with object keys sorted
+func (value JsonValue) ToString() string {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(value.Value)
+	if err != nil {
+		log.Fatal("Can't encode JSON value: ", err)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func (value JsonValue) GetType() SupportedTypes { return JsonType }
+
+func (value JsonValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+
+// JSON documents are ordered by their text form
+func (value JsonValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	secondValueAsJson, isJson := secondValue.(JsonValue)
+	if !isJson {
+		log.Fatal("Can't compare JSON with other type")
+	}
+
+	return value.ToString() < secondValueAsJson.ToString()
+}
+
+func (value JsonValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	secondValueAsJson, isJson := secondValue.(JsonValue)
+	if !isJson {
+		log.Fatal("Can't compare JSON with other type")
+	}
+
+	return value.ToString() > secondValueAsJson.ToString()
+}
+
+// parseJson - Return JsonValue decoded from text or error if text isn't single valid JSON document
+func parseJson(text string) (JsonValue, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+
+	var document any
+	err := decoder.Decode(&document)
+	if err != nil {
+		return JsonValue{}, &InvalidJsonError{value: text}
+	}
+	// Nothing but whitespaces can follow the document
+	_, err = decoder.Token()
+	if err != io.EOF {
+		return JsonValue{}, &InvalidJsonError{value: text}
+	}
+	return JsonValue{Value: document}, nil
+}
+
+// convertFromJson - Return JSON document as SQL value, numbers are converted to INT, DECIMAL or FLOAT, strings to
+// TEXT, booleans to BOOLEAN and null to NULL, objects and arrays stay JSON
+func convertFromJson(document any) ValueInterface {
+	switch field := document.(type) {
+	case nil:
+		return NullValue{}
+	case bool:
+		return BooleanValue{Value: field}
+	case string:
+		return StringValue{Value: field}
+	case json.Number:
+		number, err := getInterfaceValue(token.Token{Type: token.LITERAL, Literal: field.String()})
+		if err != nil {
+			return StringValue{Value: field.String()}
+		}
+		return number
+	default:
+		return JsonValue{Value: field}
+	}
+}
+
+// getJsonField - Return field of JSON object or element of JSON array and false if it doesn't exist, negative
+// index counts elements from the end of array
+func getJsonField(document any, step jsonPathStep) (any, bool) {
+	if !step.isIndex {
+		object, isObject := document.(map[string]any)
+		if !isObject {
+			return nil, false
+		}
+		field, exists := object[step.key]
+		return field, exists
+	}
+
+	array, isArray := document.([]any)
+	if !isArray {
+		return nil, false
+	}
+	index := step.index
+	if index < 0 {
+		index += len(array)
+	}
+	if index < 0 || index >= len(array) {
+		return nil, false
+	}
+	return array[index], true
+}
+
+// parseJsonPath - Return steps of path written as $.key.nested[0]."key with spaces"
+func parseJsonPath(path string) ([]jsonPathStep, error) {
+	invalidPathError := &InvalidJsonPathError{path: path}
+	if !strings.HasPrefix(path, "$") {
+		return nil, invalidPathError
+	}
+
+	steps := make([]jsonPathStep, 0)
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			var key string
+			if strings.HasPrefix(rest, "\"") {
+				end := strings.IndexByte(rest[1:], '"')
+				if end < 0 {
+					return nil, invalidPathError
+				}
+				key, rest = rest[1:end+1], rest[end+2:]
+			} else {
+				end := strings.IndexAny(rest, ".[")
+				if end < 0 {
+					end = len(rest)
+				}
+				key, rest = rest[:end], rest[end:]
+				if key == "" {
+					return nil, invalidPathError
+				}
+			}
+			steps = append(steps, jsonPathStep{key: key})
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, invalidPathError
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, invalidPathError
+			}
+			steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, invalidPathError
+		}
+	}
+	return steps, nil
+}
@@ -0,0 +1,51 @@
+package engine
+
+// logicalValue - Result of evaluating condition, it can be logicalUnknown only when standard NULL semantics are used
+type logicalValue int
+
+const (
+	logicalFalse logicalValue = iota
+	logicalTrue
+	logicalUnknown
+)
+
+func toLogicalValue(value bool) logicalValue {
+	if value {
+		return logicalTrue
+	}
+	return logicalFalse
+}
+
+// and - Return conjunction of two logical values following SQL three-valued logic
+func (value logicalValue) and(secondValue logicalValue) logicalValue {
+	if value == logicalFalse || secondValue == logicalFalse {
+		return logicalFalse
+	}
+	if value == logicalUnknown || secondValue == logicalUnknown {
+		return logicalUnknown
+	}
+	return logicalTrue
+}
+
+// or - Return disjunction of two logical values following SQL three-valued logic
+func (value logicalValue) or(secondValue logicalValue) logicalValue {
+	if value == logicalTrue || secondValue == logicalTrue {
+		return logicalTrue
+	}
+	if value == logicalUnknown || secondValue == logicalUnknown {
+		return logicalUnknown
+	}
+	return logicalFalse
+}
+
+// not - Return negation of logical value, negation of UNKNOWN is still UNKNOWN
+func (value logicalValue) not() logicalValue {
+	switch value {
+	case logicalTrue:
+		return logicalFalse
+	case logicalFalse:
+		return logicalTrue
+	default:
+		return logicalUnknown
+	}
+}
@@ -0,0 +1,35 @@
+package engine
+
+func init() {
+	registerScalarFunction("COALESCE", scalarFunction{minArguments: 1, maxArguments: variadicArguments, propagatesNull: false, evaluate: coalesce})
+	registerScalarFunction("IFNULL", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: false, evaluate: coalesce})
+	registerScalarFunction("NULLIF", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: false, evaluate: nullIf})
+}
+
+// coalesce - COALESCE(value, ...) returns the first argument that is not NULL, or NULL if all of them are NULL,
+// also used by IFNULL(value, default), arguments have to be numbers or values of the same type, so results can be
+// compared with each other
+func coalesce(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	var result ValueInterface = NullValue{}
+	for _, argument := range arguments {
+		if argument.GetType() == NullType {
+			continue
+		}
+		if result.GetType() == NullType {
+			result = argument
+			continue
+		}
+		if argument.GetType() != result.GetType() && !(isNumeric(argument) && isNumeric(result)) {
+			return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: getTypeName(result), actualValue: argument.ToString()}
+		}
+	}
+	return result, nil
+}
+
+// nullIf - NULLIF(value, other) returns NULL if value is equal to other, otherwise returns value
+func nullIf(_ string, arguments []ValueInterface) (ValueInterface, error) {
+	if arguments[1].GetType() != NullType && arguments[0].IsEqual(arguments[1]) {
+		return NullValue{}, nil
+	}
+	return arguments[0], nil
+}
@@ -0,0 +1,335 @@
+package engine
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+)
+
+func init() {
+	registerScalarFunction("ABS", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: abs})
+	registerScalarFunction("ROUND", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: round})
+	registerScalarFunction("FLOOR", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: floor})
+	registerScalarFunction("CEIL", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: ceil})
+	registerScalarFunction("CEILING", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: ceil})
+	registerScalarFunction("MOD", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: mod})
+	registerScalarFunction("POWER", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: power})
+	registerScalarFunction("POW", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: power})
+	registerScalarFunction("SQRT", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: sqrt})
+	registerScalarFunction("SIGN", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: sign})
+	registerScalarFunction("GREATEST", scalarFunction{minArguments: 1, maxArguments: variadicArguments, propagatesNull: false, evaluate: greatest})
+	registerScalarFunction("LEAST", scalarFunction{minArguments: 1, maxArguments: variadicArguments, propagatesNull: false, evaluate: least})
+	registerScalarFunction("RANDOM", scalarFunction{minArguments: 0, maxArguments: 0, propagatesNull: true, evaluate: random})
+}
+
+// abs - ABS(number) returns absolute value of number
+func abs(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	argument, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	switch number := argument.(type) {
+	case FloatValue:
+		return FloatValue{Value: math.Abs(number.Value)}, nil
+	case DecimalValue:
+		return DecimalValue{Value: new(big.Int).Abs(number.Value), Scale: number.Scale}, nil
+	}
+
+	number := argument.(IntegerValue).Value
+	if number == math.MinInt64 {
+		return nil, &IntegerOverflowError{operation: functionName}
+	}
+	if number < 0 {
+		return IntegerValue{Value: -number}, nil
+	}
+	return IntegerValue{Value: number}, nil
+}
+
+// round - ROUND(number [, digits]) rounds number half away from zero, integers are changed only when digits is
+// negative, ex. ROUND(1250, -2) returns 1300, DECIMAL result has exactly digits places after decimal point
+func round(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	argument, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	digits := 0
+	if len(arguments) == 2 {
+		digits, err = getIntegerArgument(functionName, arguments[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch number := argument.(type) {
+	case FloatValue:
+		precision := math.Pow(10, float64(digits))
+		if math.IsInf(precision, 0) || precision == 0 {
+			return number, nil
+		}
+		return FloatValue{Value: math.Round(number.Value*precision) / precision}, nil
+	case DecimalValue:
+		if digits > maxDecimalDigits || digits < -maxDecimalDigits {
+			return nil, &InvalidFunctionArgumentError{functionName: functionName,
+				expectedType: "digits between -" + strconv.Itoa(maxDecimalDigits) + " and " + strconv.Itoa(maxDecimalDigits),
+				actualValue:  arguments[1].ToString()}
+		}
+		if digits >= 0 {
+			return number.rescale(digits), nil
+		}
+		// Moving decimal point left by -digits places lets rescale do the rounding
+		shifted := DecimalValue{Value: number.Value, Scale: number.Scale - digits}.rescale(0)
+		return DecimalValue{Value: shifted.Value.Mul(shifted.Value, pow10(-digits)), Scale: 0}, nil
+	}
+
+	number := argument.(IntegerValue).Value
+	if digits >= 0 {
+		return IntegerValue{Value: number}, nil
+	}
+
+	precision, overflow := checkedPower(10, int64(-digits))
+	if overflow {
+		return IntegerValue{Value: 0}, nil
+	}
+
+	quotient, remainder := number/precision, number%precision
+	if remainder >= precision-remainder {
+		quotient++
+	} else if -remainder >= precision+remainder {
+		quotient--
+	}
+
+	result, overflow := checkedMultiply(quotient, precision)
+	if overflow {
+		return nil, &IntegerOverflowError{operation: functionName}
+	}
+	return IntegerValue{Value: result}, nil
+}
+
+// floor - FLOOR(number) returns the largest integer not greater than number
+func floor(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	argument, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	switch number := argument.(type) {
+	case FloatValue:
+		return FloatValue{Value: math.Floor(number.Value)}, nil
+	case DecimalValue:
+		return floorDecimal(number), nil
+	default:
+		return argument, nil
+	}
+}
+
+// ceil - CEIL(number) returns the smallest integer not less than number
+func ceil(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	argument, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	switch number := argument.(type) {
+	case FloatValue:
+		return FloatValue{Value: math.Ceil(number.Value)}, nil
+	case DecimalValue:
+		// ceil(x) = -floor(-x)
+		negatedFloor := floorDecimal(DecimalValue{Value: new(big.Int).Neg(number.Value), Scale: number.Scale})
+		return DecimalValue{Value: negatedFloor.Value.Neg(negatedFloor.Value), Scale: 0}, nil
+	default:
+		return argument, nil
+	}
+}
+
+// mod - MOD(dividend, divisor) returns remainder of division, result has the same sign as dividend
+func mod(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	dividend, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	divisor, err := getNumericArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, err
+	}
+	if compareNumbers(divisor, IntegerValue{Value: 0}) == 0 {
+		return nil, &DivisionByZeroError{operation: functionName}
+	}
+
+	if dividend.GetType() == FloatType || divisor.GetType() == FloatType {
+		return FloatValue{Value: math.Mod(toFloat(dividend), toFloat(divisor))}, nil
+	}
+	if dividend.GetType() == DecimalType || divisor.GetType() == DecimalType {
+		decimalDividend, decimalDivisor := alignScales(toDecimal(dividend), toDecimal(divisor))
+		return DecimalValue{Value: new(big.Int).Rem(decimalDividend.Value, decimalDivisor.Value), Scale: decimalDividend.Scale}, nil
+	}
+	return IntegerValue{Value: dividend.(IntegerValue).Value % divisor.(IntegerValue).Value}, nil
+}
+
+// power - POWER(base, exponent) returns base raised to the power of exponent, result is calculated exactly for INT
+// and DECIMAL base with non-negative INT exponent, otherwise FLOAT is returned
+func power(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	base, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	exponent, err := getNumericArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, err
+	}
+
+	integerExponent, isIntegerExponent := exponent.(IntegerValue)
+	if isIntegerExponent && integerExponent.Value >= 0 {
+		switch number := base.(type) {
+		case IntegerValue:
+			result, overflow := checkedPower(number.Value, integerExponent.Value)
+			if overflow {
+				return nil, &IntegerOverflowError{operation: functionName}
+			}
+			return IntegerValue{Value: result}, nil
+		case DecimalValue:
+			if isDecimalPowerTooLarge(number, integerExponent.Value) {
+				return nil, &DecimalOverflowError{operation: functionName}
+			}
+			exponentValue := big.NewInt(integerExponent.Value)
+			return DecimalValue{Value: new(big.Int).Exp(number.Value, exponentValue, nil), Scale: number.Scale * int(integerExponent.Value)}, nil
+		}
+	}
+
+	if compareNumbers(base, IntegerValue{Value: 0}) == 0 && compareNumbers(exponent, IntegerValue{Value: 0}) < 0 {
+		return nil, &DivisionByZeroError{operation: functionName}
+	}
+	result := math.Pow(toFloat(base), toFloat(exponent))
+	if math.IsNaN(result) {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "INT exponent for negative base", actualValue: exponent.ToString()}
+	}
+	if math.IsInf(result, 0) {
+		return nil, &FloatOverflowError{operation: functionName}
+	}
+	return FloatValue{Value: result}, nil
+}
+
+// sqrt - SQRT(number) returns square root of number, for INT it's rounded down to integer and for FLOAT and DECIMAL
+// FLOAT is returned
+func sqrt(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	argument, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	if compareNumbers(argument, IntegerValue{Value: 0}) < 0 {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "non-negative NUMERIC", actualValue: arguments[0].ToString()}
+	}
+	if argument.GetType() != IntType {
+		return FloatValue{Value: math.Sqrt(toFloat(argument))}, nil
+	}
+
+	number := argument.(IntegerValue).Value
+	// float64 can't represent every int exactly, so result is corrected to the nearest integer square root
+	result := int64(math.Sqrt(float64(number)))
+	for result > 0 && result > number/result {
+		result--
+	}
+	for result+1 <= number/(result+1) {
+		result++
+	}
+	return IntegerValue{Value: result}, nil
+}
+
+// sign - SIGN(number) returns -1, 0 or 1 depending on sign of number
+func sign(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	number, err := getNumericArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: int64(compareNumbers(number, IntegerValue{Value: 0}))}, nil
+}
+
+// greatest - GREATEST(value, ...) returns the largest of not NULL values, or NULL if all of them are NULL
+func greatest(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return getExtremeArgument(functionName, arguments, func(first ValueInterface, second ValueInterface) bool {
+		return first.isGreaterThan(second)
+	})
+}
+
+// least - LEAST(value, ...) returns the smallest of not NULL values, or NULL if all of them are NULL
+func least(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return getExtremeArgument(functionName, arguments, func(first ValueInterface, second ValueInterface) bool {
+		return first.isSmallerThan(second)
+	})
+}
+
+func getExtremeArgument(functionName string, arguments []ValueInterface, isBetter func(ValueInterface, ValueInterface) bool) (ValueInterface, error) {
+	var result ValueInterface = NullValue{}
+	for _, argument := range arguments {
+		if argument.GetType() == NullType {
+			continue
+		}
+		if result.GetType() == NullType {
+			result = argument
+			continue
+		}
+		if argument.GetType() != result.GetType() && !(isNumeric(argument) && isNumeric(result)) {
+			return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: getTypeName(result), actualValue: argument.ToString()}
+		}
+		if isBetter(argument, result) {
+			result = argument
+		}
+	}
+	return result, nil
+}
+
+// random - RANDOM() returns random non-negative integer
+func random(_ string, _ []ValueInterface) (ValueInterface, error) {
+	return IntegerValue{Value: rand.Int63()}, nil
+}
+
+// checkedMultiply - Return product of integers and true if it doesn't fit into BIGINT
+func checkedMultiply(first int64, second int64) (int64, bool) {
+	if first == 0 || second == 0 {
+		return 0, false
+	}
+	result := first * second
+	if result/second != first || (first == -1 && second == math.MinInt64) || (second == -1 && first == math.MinInt64) {
+		return 0, true
+	}
+	return result, false
+}
+
+// checkedPower - Return base raised to not negative exponent and true if result doesn't fit into BIGINT
+func checkedPower(base int64, exponent int64) (int64, bool) {
+	result := int64(1)
+	for exponent > 0 {
+		if exponent%2 == 1 {
+			var overflow bool
+			result, overflow = checkedMultiply(result, base)
+			if overflow {
+				return 0, true
+			}
+		}
+		exponent /= 2
+		if exponent > 0 {
+			var overflow bool
+			base, overflow = checkedMultiply(base, base)
+			if overflow {
+				return 0, true
+			}
+		}
+	}
+	return result, false
+}
+
+// isDecimalPowerTooLarge - Return true if decimal raised to not negative exponent would have more than
+// maxDecimalDigits digits after decimal point or in total, it's checked before calculation, so huge exponent can't
+// block the engine
+func isDecimalPowerTooLarge(base DecimalValue, exponent int64) bool {
+	if base.Scale > 0 && exponent > int64(maxDecimalDigits/base.Scale) {
+		return true
+	}
+	// Value of at least 2^(bits - 1) raised to exponent has at least (bits - 1) * exponent * log10(2) digits
+	bits := base.Value.BitLen()
+	return bits > 1 && float64(bits-1)*float64(exponent)*math.Log10(2) > maxDecimalDigits
+}
+
+// floorDecimal - Return the largest integer not greater than decimal
+func floorDecimal(value DecimalValue) DecimalValue {
+	// Euclidean division by positive number rounds quotient down
+	return DecimalValue{Value: new(big.Int).Div(value.Value, pow10(value.Scale)), Scale: 0}
+}
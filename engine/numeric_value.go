@@ -0,0 +1,334 @@
+package engine
+
+import (
+	"log"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// divisionScale - Minimal number of fractional digits in result of DECIMAL division
+const divisionScale = 16
+
+// maxDecimalDigits - Maximal number of digits before and after decimal point of DECIMAL calculated by functions, the
+// same as maximal precision of DECIMAL column
+const maxDecimalDigits = 1000
+
+// FloatValue - Implementation of ValueInterface that is containing floating-point values
+type FloatValue struct {
+	Value float64
+}
+
+// DecimalValue - Implementation of ValueInterface that is containing fixed-precision values, number is stored as
+// integer without decimal point (Value) and count of digits after decimal point (Scale), ex. 12.50 is {1250, 2}
+type DecimalValue struct {
+	Value *big.Int
+	Scale int
+}
+
+// ToString implementations
+func (value FloatValue) ToString() string { return strconv.FormatFloat(value.Value, 'g', -1, 64) }
+func (value DecimalValue) ToString() string {
+	digits := new(big.Int).Abs(value.Value).String()
+	if value.Scale > 0 {
+		if len(digits) <= value.Scale {
+			digits = strings.Repeat("0", value.Scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-value.Scale] + "." + digits[len(digits)-value.Scale:]
+	}
+	if value.Value.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// GetType implementations
+func (value FloatValue) GetType() SupportedTypes   { return FloatType }
+func (value DecimalValue) GetType() SupportedTypes { return DecimalType }
+
+// IsEqual implementations
+func (value FloatValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+func (value DecimalValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+
+// isSmallerThan implementations
+func (value FloatValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isNumberSmallerThan(value, secondValue)
+}
+func (value DecimalValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isNumberSmallerThan(value, secondValue)
+}
+
+// isGreaterThan implementations
+func (value FloatValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isNumberGreaterThan(value, secondValue)
+}
+func (value DecimalValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isNumberGreaterThan(value, secondValue)
+}
+
+func isNumberSmallerThan(value ValueInterface, secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+	if !isNumeric(secondValue) {
+		log.Fatal("Can't compare number with other type")
+	}
+	return compareNumbers(value, secondValue) < 0
+}
+
+func isNumberGreaterThan(value ValueInterface, secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+	if !isNumeric(secondValue) {
+		log.Fatal("Can't compare number with other type")
+	}
+	return compareNumbers(value, secondValue) > 0
+}
+
+// isNumeric - Return true if value is INT, FLOAT or DECIMAL
+func isNumeric(value ValueInterface) bool {
+	switch value.GetType() {
+	case IntType, FloatType, DecimalType:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareNumbers - Return -1, 0 or 1 if first number is smaller, equal or greater than second one, FLOAT is used
+// for comparison if any of numbers is FLOAT, otherwise numbers are compared precisely
+func compareNumbers(first ValueInterface, second ValueInterface) int {
+	if first.GetType() == FloatType || second.GetType() == FloatType {
+		firstFloat, secondFloat := toFloat(first), toFloat(second)
+		switch {
+		case firstFloat < secondFloat:
+			return -1
+		case firstFloat > secondFloat:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	firstDecimal, secondDecimal := alignScales(toDecimal(first), toDecimal(second))
+	return firstDecimal.Value.Cmp(secondDecimal.Value)
+}
+
+// toFloat - Return number converted to float64
+func toFloat(value ValueInterface) float64 {
+	switch number := value.(type) {
+	case IntegerValue:
+		return float64(number.Value)
+	case FloatValue:
+		return number.Value
+	case DecimalValue:
+		result, _ := new(big.Rat).SetFrac(number.Value, pow10(number.Scale)).Float64()
+		return result
+	default:
+		return math.NaN()
+	}
+}
+
+// toDecimal - Return number converted to DecimalValue, FLOAT is converted using the shortest representation that
+// can be parsed back to the same float
+func toDecimal(value ValueInterface) DecimalValue {
+	switch number := value.(type) {
+	case IntegerValue:
+		return DecimalValue{Value: big.NewInt(int64(number.Value)), Scale: 0}
+	case DecimalValue:
+		return number
+	case FloatValue:
+		decimal, _ := parseDecimal(strconv.FormatFloat(number.Value, 'f', -1, 64))
+		return decimal
+	default:
+		return DecimalValue{Value: new(big.Int), Scale: 0}
+	}
+}
+
+// parseDecimal - Return DecimalValue created from text like -12.50, false is returned if text isn't valid number
+func parseDecimal(text string) (DecimalValue, bool) {
+	integerPart, fractionalPart, _ := strings.Cut(text, ".")
+	digits := integerPart + fractionalPart
+	if strings.HasPrefix(integerPart, "-") || strings.HasPrefix(integerPart, "+") {
+		digits = integerPart[1:] + fractionalPart
+	}
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return DecimalValue{}, false
+	}
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return DecimalValue{}, false
+	}
+	if strings.HasPrefix(integerPart, "-") {
+		value.Neg(value)
+	}
+	return DecimalValue{Value: value, Scale: len(fractionalPart)}, true
+}
+
+// rescale - Return decimal with given number of digits after decimal point, value is rounded half away from zero
+// when digits are removed
+func (value DecimalValue) rescale(scale int) DecimalValue {
+	if scale >= value.Scale {
+		return DecimalValue{Value: new(big.Int).Mul(value.Value, pow10(scale-value.Scale)), Scale: scale}
+	}
+	return DecimalValue{Value: divideRounded(value.Value, pow10(value.Scale-scale)), Scale: scale}
+}
+
+// trimTrailingZeros - Return decimal without trailing zeros after decimal point, but keeping at least minScale
+// digits
+func (value DecimalValue) trimTrailingZeros(minScale int) DecimalValue {
+	result := value
+	ten := big.NewInt(10)
+	for result.Scale > minScale {
+		quotient, remainder := new(big.Int).QuoRem(result.Value, ten, new(big.Int))
+		if remainder.Sign() != 0 {
+			break
+		}
+		result = DecimalValue{Value: quotient, Scale: result.Scale - 1}
+	}
+	return result
+}
+
+// countIntegerDigits - Return number of digits before decimal point
+func (value DecimalValue) countIntegerDigits() int {
+	integerPart := new(big.Int).Quo(new(big.Int).Abs(value.Value), pow10(value.Scale))
+	if integerPart.Sign() == 0 {
+		return 0
+	}
+	return len(integerPart.String())
+}
+
+// alignScales - Return both decimals with the same scale, so their Values can be compared, added or subtracted
+func alignScales(first DecimalValue, second DecimalValue) (DecimalValue, DecimalValue) {
+	scale := max(first.Scale, second.Scale)
+	return first.rescale(scale), second.rescale(scale)
+}
+
+// divideRounded - Return quotient rounded half away from zero
+func divideRounded(dividend *big.Int, divisor *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	doubledRemainder := new(big.Int).Abs(remainder)
+	doubledRemainder.Lsh(doubledRemainder, 1)
+	if doubledRemainder.Cmp(new(big.Int).Abs(divisor)) >= 0 {
+		if dividend.Sign()*divisor.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// decimalToInteger - Return decimal rounded to integer and false if it doesn't fit into BIGINT
+func decimalToInteger(value DecimalValue) (int64, bool) {
+	rounded := value.rescale(0).Value
+	if !rounded.IsInt64() {
+		return 0, false
+	}
+	return rounded.Int64(), true
+}
+
+// calculate - Return result of arithmetic operation (token.PLUS, token.MINUS, token.ASTERISK or token.SLASH) on two
+// numbers, both are promoted to FLOAT if any of them is FLOAT, otherwise to DECIMAL if any of them is DECIMAL
+func calculate(operator string, first ValueInterface, second ValueInterface) (ValueInterface, error) {
+	if first.GetType() == FloatType || second.GetType() == FloatType {
+		return calculateFloats(operator, toFloat(first), toFloat(second))
+	}
+	if first.GetType() == DecimalType || second.GetType() == DecimalType {
+		return calculateDecimals(operator, toDecimal(first), toDecimal(second))
+	}
+	return calculateIntegers(operator, first.(IntegerValue).Value, second.(IntegerValue).Value)
+}
+
+func calculateIntegers(operator string, first int64, second int64) (ValueInterface, error) {
+	var result int64
+	overflow := false
+	switch operator {
+	case "+":
+		result = first + second
+		overflow = (second > 0 && result < first) || (second < 0 && result > first)
+	case "-":
+		result = first - second
+		overflow = (second < 0 && result < first) || (second > 0 && result > first)
+	case "*":
+		result, overflow = checkedMultiply(first, second)
+	default:
+		if second == 0 {
+			return nil, &DivisionByZeroError{operation: operator}
+		}
+		overflow = first == math.MinInt64 && second == -1
+		result = first / second
+	}
+
+	if overflow {
+		return nil, &IntegerOverflowError{operation: operator}
+	}
+	return IntegerValue{Value: result}, nil
+}
+
+func calculateFloats(operator string, first float64, second float64) (ValueInterface, error) {
+	var result float64
+	switch operator {
+	case "+":
+		result = first + second
+	case "-":
+		result = first - second
+	case "*":
+		result = first * second
+	default:
+		if second == 0 {
+			return nil, &DivisionByZeroError{operation: operator}
+		}
+		result = first / second
+	}
+
+	if math.IsInf(result, 0) {
+		return nil, &FloatOverflowError{operation: operator}
+	}
+	return FloatValue{Value: result}, nil
+}
+
+func calculateDecimals(operator string, first DecimalValue, second DecimalValue) (ValueInterface, error) {
+	switch operator {
+	case "+":
+		first, second = alignScales(first, second)
+		return DecimalValue{Value: new(big.Int).Add(first.Value, second.Value), Scale: first.Scale}, nil
+	case "-":
+		first, second = alignScales(first, second)
+		return DecimalValue{Value: new(big.Int).Sub(first.Value, second.Value), Scale: first.Scale}, nil
+	case "*":
+		return DecimalValue{Value: new(big.Int).Mul(first.Value, second.Value), Scale: first.Scale + second.Scale}, nil
+	default:
+		return divideDecimals(operator, first, second, max(divisionScale, first.Scale, second.Scale))
+	}
+}
+
+// divideDecimals - Return quotient with given scale, rounded half away from zero
+func divideDecimals(operation string, dividend DecimalValue, divisor DecimalValue, scale int) (DecimalValue, error) {
+	if divisor.Value.Sign() == 0 {
+		return DecimalValue{}, &DivisionByZeroError{operation: operation}
+	}
+	// dividend * 10^(scale + divisor.Scale - dividend.Scale) / divisor gives result multiplied by 10^scale
+	shift := scale + divisor.Scale - dividend.Scale
+	numerator := new(big.Int).Set(dividend.Value)
+	denominator := new(big.Int).Set(divisor.Value)
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+	return DecimalValue{Value: divideRounded(numerator, denominator), Scale: scale}, nil
+}
@@ -26,6 +26,15 @@ func getRow(table *Table, rowIndex int) map[string]ValueInterface {
 	return row
 }
 
+// getRowValues - Return values of the row ordered the same way as columns of the table
+func getRowValues(table *Table, rowIndex int) []ValueInterface {
+	values := make([]ValueInterface, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		values = append(values, column.Values[rowIndex])
+	}
+	return values
+}
+
 func getEmptyRow(table *Table) map[string]ValueInterface {
 	row := make(map[string]ValueInterface)
 	for _, column := range table.Columns {
@@ -0,0 +1,605 @@
+package engine
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// secondaryIndex - Index created with CREATE INDEX, it's used to find rows fulfilling WHERE conditions without
+// scanning the whole table, positions of rows are stored in hash map or ordered by values depending on method
+type secondaryIndex struct {
+	name    string
+	columns []int // positions of indexed columns in the table
+	// includedColumns - positions of columns listed in INCLUDE, their values are stored in entries, so queries using
+	// only stored columns don't have to read columns of the table
+	includedColumns []int
+	method          ast.IndexMethod
+	// hashRows - positions of rows mapped by key of their values, it's used by ast.HashIndex
+	hashRows map[string][]int
+	// entries - rows ordered by values of indexed columns and then by their positions, NULL is ordered before other
+	// values, it's used by ast.BTreeIndex
+	entries []indexEntry
+}
+
+// indexEntry - Row stored in ordered index with values of its indexed columns followed by values of included columns
+type indexEntry struct {
+	row    int
+	values []ValueInterface
+}
+
+func newSecondaryIndex(name string, columns []int, includedColumns []int, method ast.IndexMethod) *secondaryIndex {
+	return &secondaryIndex{name: name, columns: columns, includedColumns: includedColumns, method: method,
+		hashRows: make(map[string][]int)}
+}
+
+// getStoredColumns - Return positions of indexed columns followed by positions of included columns
+func (index *secondaryIndex) getStoredColumns() []int {
+	return append(slices.Clone(index.columns), index.includedColumns...)
+}
+
+// getEntry - Return entry of row with provided position, values are taken in order of stored columns
+func (index *secondaryIndex) getEntry(row []ValueInterface, rowIndex int) indexEntry {
+	storedColumns := index.getStoredColumns()
+	values := make([]ValueInterface, len(storedColumns))
+	for i, column := range storedColumns {
+		values[i] = row[column]
+	}
+	return indexEntry{row: rowIndex, values: values}
+}
+
+// getHashKey - Return key under which row is stored in hash index
+func (index *secondaryIndex) getHashKey(row []ValueInterface) string {
+	values := make([]ValueInterface, len(index.columns))
+	for i, column := range index.columns {
+		values[i] = row[column]
+	}
+	return getKeyOfValues(values)
+}
+
+// add - Add row with provided position in the table to the index, row isn't added to columns of the table yet, so
+// its values are taken from the row
+func (index *secondaryIndex) add(row []ValueInterface, rowIndex int) {
+	if index.method == ast.HashIndex {
+		key := index.getHashKey(row)
+		index.hashRows[key] = append(index.hashRows[key], rowIndex)
+		return
+	}
+	// Entry is placed after entries with equal values and smaller positions
+	entry := index.getEntry(row, rowIndex)
+	position := sort.Search(len(index.entries), func(i int) bool {
+		return index.compareEntries(index.entries[i], entry) > 0
+	})
+	index.entries = slices.Insert(index.entries, position, entry)
+}
+
+// addRows - Add rows with provided positions to the index, rows contain their values, many entries are sorted and
+// merged with entries of the index at once
+func (index *secondaryIndex) addRows(rowIndexes []int, rows [][]ValueInterface) {
+	if index.method == ast.HashIndex || len(rows) == 1 {
+		for i, row := range rows {
+			index.add(row, rowIndexes[i])
+		}
+		return
+	}
+
+	addedEntries := make([]indexEntry, 0, len(rows))
+	for i, row := range rows {
+		addedEntries = append(addedEntries, index.getEntry(row, rowIndexes[i]))
+	}
+	slices.SortFunc(addedEntries, index.compareEntries)
+
+	entries := make([]indexEntry, 0, len(index.entries)+len(addedEntries))
+	i, j := 0, 0
+	for i < len(index.entries) && j < len(addedEntries) {
+		if index.compareEntries(index.entries[i], addedEntries[j]) < 0 {
+			entries = append(entries, index.entries[i])
+			i++
+		} else {
+			entries = append(entries, addedEntries[j])
+			j++
+		}
+	}
+	entries = append(entries, index.entries[i:]...)
+	index.entries = append(entries, addedEntries[j:]...)
+}
+
+// removeRows - Remove rows with provided positions from the index, rows contain their values, so their keys can be
+// found in hash index
+func (index *secondaryIndex) removeRows(rowIndexes []int, rows [][]ValueInterface) {
+	isRemoved := make(map[int]bool, len(rowIndexes))
+	for _, rowIndex := range rowIndexes {
+		isRemoved[rowIndex] = true
+	}
+	if index.method != ast.HashIndex {
+		index.entries = slices.DeleteFunc(index.entries, func(entry indexEntry) bool { return isRemoved[entry.row] })
+		return
+	}
+
+	keys := make(map[string]bool)
+	for _, row := range rows {
+		keys[index.getHashKey(row)] = true
+	}
+	for key := range keys {
+		index.hashRows[key] = slices.DeleteFunc(index.hashRows[key], func(rowIndex int) bool { return isRemoved[rowIndex] })
+		if len(index.hashRows[key]) == 0 {
+			delete(index.hashRows, key)
+		}
+	}
+}
+
+// shiftRows - Change positions of all rows stored in the index, it's used when rows before them were removed or
+// inserted, shift returns new position of the row and has to keep order of rows
+func (index *secondaryIndex) shiftRows(shift func(int) int) {
+	for _, rows := range index.hashRows {
+		for i := range rows {
+			rows[i] = shift(rows[i])
+		}
+	}
+	for i := range index.entries {
+		index.entries[i].row = shift(index.entries[i].row)
+	}
+}
+
+// rebuild - Fill the index with all rows of the table, it's used when index is created or values of the whole column
+// were changed
+func (index *secondaryIndex) rebuild(table *Table) {
+	rowsCount := len(table.Columns[0].Values)
+	if index.method == ast.HashIndex {
+		index.hashRows = make(map[string][]int, len(index.hashRows))
+		for rowIndex := 0; rowIndex < rowsCount; rowIndex++ {
+			key := index.getHashKey(getRowValues(table, rowIndex))
+			index.hashRows[key] = append(index.hashRows[key], rowIndex)
+		}
+		return
+	}
+
+	index.entries = make([]indexEntry, 0, rowsCount)
+	for rowIndex := 0; rowIndex < rowsCount; rowIndex++ {
+		index.entries = append(index.entries, index.getEntry(getRowValues(table, rowIndex), rowIndex))
+	}
+	slices.SortFunc(index.entries, index.compareEntries)
+}
+
+// compareEntries - Compare entries by values of indexed columns and then by positions of their rows
+func (index *secondaryIndex) compareEntries(first indexEntry, second indexEntry) int {
+	for i := range index.columns {
+		result := compareIndexValues(first.values[i], second.values[i])
+		if result != 0 {
+			return result
+		}
+	}
+	return cmp.Compare(first.row, second.row)
+}
+
+// compareIndexValues - Return 0 if values are equal, negative number if the first one is smaller and positive number
+// otherwise, NULL is smaller than other values the same way as in ORDER BY
+func compareIndexValues(first ValueInterface, second ValueInterface) int {
+	switch {
+	case first.IsEqual(second):
+		return 0
+	case first.isSmallerThan(second):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// indexCondition - Condition comparing column with constant value, which can be looked up in indexes, ex. age > 18
+type indexCondition struct {
+	column   int // position of the column in the table
+	operator token.Type
+	value    ValueInterface
+}
+
+// getRows - Return positions of rows which can fulfill conditions, false is returned when the index can't be used for
+// any condition, ex. hash index for range condition
+func (index *secondaryIndex) getRows(table *Table, conditions []indexCondition) ([]int, bool) {
+	if index.method == ast.HashIndex {
+		values := make([]ValueInterface, 0, len(index.columns))
+		for _, column := range index.columns {
+			value, found := getHashLookupValue(table.Columns[column], column, conditions)
+			if !found {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+		return index.hashRows[getKeyOfValues(values)], true
+	}
+
+	entries, _, usedColumns := index.getEntries(table, conditions)
+	if usedColumns == 0 {
+		return nil, false
+	}
+	rows := make([]int, len(entries))
+	for i, entry := range entries {
+		rows[i] = entry.row
+	}
+	return rows, true
+}
+
+// getHashLookupValue - Return value of EQUAL condition on the column in the form it's stored in the column, it's
+// possible only when conversion doesn't change it, ex. 1.5 can't be looked up in INT column
+func getHashLookupValue(column *Column, position int, conditions []indexCondition) (ValueInterface, bool) {
+	for _, condition := range conditions {
+		if condition.column != position || condition.operator != token.EQUAL {
+			continue
+		}
+		storedValue, err := convertToColumnType(condition.value, column, token.SELECT)
+		if err == nil && isEqualInCondition(storedValue, condition.value) {
+			return storedValue, true
+		}
+	}
+	return nil, false
+}
+
+// getEntries - Return ordered entries which can fulfill conditions, number of leading index columns compared with
+// EQUAL and number of all index columns used to narrow entries, conditions can use columns only as long as previous
+// columns are compared with EQUAL, ex. index on (a, b) is used by a = 1 AND b > 2, but only a is used by a > 1 AND b = 2
+func (index *secondaryIndex) getEntries(table *Table, conditions []indexCondition) ([]indexEntry, int, int) {
+	low, high := 0, len(index.entries)
+	equalColumns, usedColumns := 0, 0
+	for position, column := range index.columns {
+		isEqual, isRange := false, false
+		for _, condition := range conditions {
+			if condition.column != column {
+				continue
+			}
+			value, isComparable := index.getComparableValue(position, condition.value)
+			if !isComparable {
+				continue
+			}
+			low, high = index.narrowEntries(low, high, position, condition.operator, value)
+			isEqual = isEqual || condition.operator == token.EQUAL
+			isRange = isRange || condition.operator != token.EQUAL
+		}
+		if !isEqual && !isRange {
+			break
+		}
+		usedColumns++
+		if isRange {
+			break
+		}
+		equalColumns++
+	}
+	return index.entries[low:high], equalColumns, usedColumns
+}
+
+// narrowEntries - Return range of entries between low and high which values in provided position fulfill the
+// condition, entries in the range have to be ordered by these values
+func (index *secondaryIndex) narrowEntries(low int, high int, position int, operator token.Type, value ValueInterface) (int, int) {
+	firstNotSmaller := low + sort.Search(high-low, func(i int) bool {
+		return compareIndexValues(index.entries[low+i].values[position], value) >= 0
+	})
+	firstGreater := low + sort.Search(high-low, func(i int) bool {
+		return compareIndexValues(index.entries[low+i].values[position], value) > 0
+	})
+	switch operator {
+	case token.EQUAL:
+		return firstNotSmaller, firstGreater
+	case token.LT:
+		return low, firstNotSmaller
+	case token.LTE:
+		return low, firstGreater
+	case token.GT:
+		return firstGreater, high
+	default:
+		return firstNotSmaller, high
+	}
+}
+
+// getComparableValue - Return value converted the same way as in conditions, so it can be compared with values of
+// indexed column in provided position, ex. '2024-01-31' is compared as DATE with DATE column, false is returned for
+// NULL and values which can't be compared with the column
+func (index *secondaryIndex) getComparableValue(position int, value ValueInterface) (ValueInterface, bool) {
+	if value.GetType() == NullType {
+		return nil, false
+	}
+	// NULL is ordered first, so stored value is searched from the end
+	for i := len(index.entries) - 1; i >= 0; i-- {
+		storedValue := index.entries[i].values[position]
+		if storedValue.GetType() == NullType {
+			continue
+		}
+		storedValue, value, err := coerceText(storedValue, value)
+		if err != nil || !areComparable(storedValue, value) {
+			return nil, false
+		}
+		return value, true
+	}
+	return nil, false
+}
+
+// isOrderedBy - Return true if entries with equal values of first indexed columns are ordered by sort patterns
+func (index *secondaryIndex) isOrderedBy(table *Table, firstColumn int, sortPatterns []ast.SortPattern) bool {
+	if firstColumn+len(sortPatterns) > len(index.columns) {
+		return false
+	}
+	for i, sortPattern := range sortPatterns {
+		if sortPattern.Function != nil || sortPattern.Order.Type != sortPatterns[0].Order.Type ||
+			table.Columns[index.columns[firstColumn+i]].Name != sortPattern.ColumnName.Literal {
+			return false
+		}
+	}
+	return true
+}
+
+// isCovering - Return true if values of all provided columns are stored in the index
+func (index *secondaryIndex) isCovering(table *Table, columnNames []string) bool {
+	for _, columnName := range columnNames {
+		if !slices.ContainsFunc(index.getStoredColumns(), func(column int) bool { return table.Columns[column].Name == columnName }) {
+			return false
+		}
+	}
+	return true
+}
+
+// isEqualInCondition - Return true if values are equal when compared with EQUAL in WHERE
+func isEqualInCondition(first ValueInterface, second ValueInterface) bool {
+	first, second, err := coerceText(first, second)
+	return err == nil && first.IsEqual(second)
+}
+
+// createIndex - Create index on columns of the table, fill it with existing rows and return its name, index without
+// name is named the same way as in PostgreSQL, ex. tbl_col_idx
+func (engine *DbEngine) createIndex(command *ast.CreateIndexCommand) (string, error) {
+	tableName := command.TableName.Token.Literal
+	table, exist := engine.Tables[tableName]
+	if !exist {
+		return "", &TableDoesNotExistError{tableName: tableName}
+	}
+	if command.Method == ast.HashIndex && len(command.IncludedColumnNames) > 0 {
+		return "", &UnsupportedIndexIncludeError{method: string(command.Method)}
+	}
+	storedColumns, err := getKeyColumns(table, append(slices.Clone(command.ColumnNames), command.IncludedColumnNames...), tableName, "index")
+	if err != nil {
+		return "", err
+	}
+	columns, includedColumns := storedColumns[:len(command.ColumnNames)], storedColumns[len(command.ColumnNames):]
+	for _, column := range columns {
+		// Equal JSON values and arrays can be written in different forms, so they wouldn't have the same key
+		if table.Columns[column].IsArray || table.Columns[column].Type.Type == token.JSON {
+			return "", &UnsupportedIndexColumnTypeError{columnName: table.Columns[column].Name, columnType: table.Columns[column].getTypeName()}
+		}
+	}
+
+	var name string
+	if command.Name != nil {
+		name = command.Name.Token.Literal
+		if _, _, exists := engine.findIndex(name); exists {
+			return "", &IndexAlreadyExistsError{indexName: name}
+		}
+	} else {
+		baseName := tableName + "_" + strings.Join(command.ColumnNames, "_") + "_idx"
+		name = baseName
+		for i := 1; ; i++ {
+			if _, _, exists := engine.findIndex(name); !exists {
+				break
+			}
+			name = baseName + strconv.Itoa(i)
+		}
+	}
+
+	index := newSecondaryIndex(name, columns, includedColumns, command.Method)
+	index.rebuild(table)
+	table.indexes = append(table.indexes, index)
+	return name, nil
+}
+
+// dropIndex - Remove index with provided name from its table
+func (engine *DbEngine) dropIndex(command *ast.DropIndexCommand) error {
+	table, position, exists := engine.findIndex(command.Name.Token.Literal)
+	if !exists {
+		return &IndexDoesNotExistError{indexName: command.Name.Token.Literal}
+	}
+	table.indexes = slices.Delete(table.indexes, position, position+1)
+	return nil
+}
+
+// findIndex - Return table containing index with provided name and position of the index in this table, names of
+// indexes are unique in the whole database
+func (engine *DbEngine) findIndex(name string) (*Table, int, bool) {
+	for _, table := range engine.Tables {
+		position := slices.IndexFunc(table.indexes, func(index *secondaryIndex) bool { return index.name == name })
+		if position >= 0 {
+			return table, position, true
+		}
+	}
+	return nil, 0, false
+}
+
+// getCandidateRows - Return sorted positions of rows which can fulfill the condition, indexes of the table are used
+// when possible, otherwise all rows are returned, every row still has to be checked with the whole condition
+func (engine *DbEngine) getCandidateRows(table *Table, expression ast.Expression) []int {
+	rows, isIndexed := engine.getIndexedRows(table, expression)
+	if !isIndexed {
+		rows = make([]int, len(table.Columns[0].Values))
+		for rowIndex := range rows {
+			rows[rowIndex] = rowIndex
+		}
+		return rows
+	}
+	// Rows are copied, because they can be part of the index
+	rows = slices.Clone(rows)
+	slices.Sort(rows)
+	return slices.Compact(rows)
+}
+
+// getIndexedRows - Return positions of rows found in indexes which can fulfill the expression, false is returned when
+// indexes can't be used for the expression, rows can repeat and aren't sorted
+func (engine *DbEngine) getIndexedRows(table *Table, expression ast.Expression) ([]int, bool) {
+	if len(table.indexes) == 0 {
+		return nil, false
+	}
+
+	switch mappedExpression := expression.(type) {
+	case *ast.ConditionExpression:
+		return engine.getRowsOfConjuncts(table, []ast.Expression{mappedExpression})
+	case *ast.ContainExpression:
+		return engine.getRowsOfContainExpression(table, mappedExpression)
+	case *ast.OperationExpression:
+		if mappedExpression.Operation.Type == token.AND {
+			return engine.getRowsOfConjuncts(table, getConjuncts(mappedExpression))
+		}
+		leftRows, isLeftIndexed := engine.getIndexedRows(table, mappedExpression.Left)
+		rightRows, isRightIndexed := engine.getIndexedRows(table, mappedExpression.Right)
+		if !isLeftIndexed || !isRightIndexed {
+			return nil, false
+		}
+		return append(slices.Clone(leftRows), rightRows...), true
+	default:
+		return nil, false
+	}
+}
+
+// getRowsOfConjuncts - Return rows which can fulfill all expressions joined with AND, conditions comparing columns
+// with constant values are looked up together, so they can use index on many columns, ex. a = 1 AND b > 2 uses index
+// on (a, b), rows found for conditions and other expressions are intersected
+func (engine *DbEngine) getRowsOfConjuncts(table *Table, conjuncts []ast.Expression) ([]int, bool) {
+	rows, isIndexed := getRowsOfConditions(table, engine.getIndexConditions(table, conjuncts))
+	for _, conjunct := range conjuncts {
+		if _, isCondition := conjunct.(*ast.ConditionExpression); isCondition {
+			continue
+		}
+		conjunctRows, isConjunctIndexed := engine.getIndexedRows(table, conjunct)
+		switch {
+		case isConjunctIndexed && isIndexed:
+			rows = getCommonRows(rows, conjunctRows)
+		case isConjunctIndexed:
+			rows, isIndexed = conjunctRows, true
+		}
+	}
+	return rows, isIndexed
+}
+
+// getConjuncts - Return expressions joined with AND, ex. a, b and c for a AND (b AND c)
+func getConjuncts(expression ast.Expression) []ast.Expression {
+	operationExpression, isOperation := expression.(*ast.OperationExpression)
+	if !isOperation || operationExpression.Operation.Type != token.AND {
+		return []ast.Expression{expression}
+	}
+	return append(getConjuncts(operationExpression.Left), getConjuncts(operationExpression.Right)...)
+}
+
+// getIndexConditions - Return conditions comparing column with constant value from provided expressions
+func (engine *DbEngine) getIndexConditions(table *Table, expressions []ast.Expression) []indexCondition {
+	conditions := make([]indexCondition, 0)
+	for _, expression := range expressions {
+		conditionExpression, isCondition := expression.(*ast.ConditionExpression)
+		if !isCondition {
+			continue
+		}
+		condition, isIndexCondition := engine.getIndexCondition(table, conditionExpression)
+		if isIndexCondition {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// getIndexCondition - Return condition comparing column with constant value, ex. age > 18
+func (engine *DbEngine) getIndexCondition(table *Table, condition *ast.ConditionExpression) (indexCondition, bool) {
+	if condition.Quantifier != nil {
+		return indexCondition{}, false
+	}
+	column, value := condition.Left, condition.Right
+	operator := condition.Condition.Type
+	if _, isIdentifier := column.(ast.Identifier); !isIdentifier {
+		// Condition is written the other way round, ex. 18 < age
+		column, value = value, column
+		operator = map[token.Type]token.Type{token.EQUAL: token.EQUAL, token.LT: token.GT, token.GT: token.LT,
+			token.LTE: token.GTE, token.GTE: token.LTE}[operator]
+	}
+	if _, isIdentifier := column.(ast.Identifier); !isIdentifier || !isIndexOperator(operator) {
+		return indexCondition{}, false
+	}
+
+	position := slices.IndexFunc(table.Columns, func(tableColumn *Column) bool { return tableColumn.Name == column.GetToken().Literal })
+	if position < 0 {
+		return indexCondition{}, false
+	}
+	constant, isConstant := engine.getConstantValue(value)
+	if !isConstant {
+		return indexCondition{}, false
+	}
+	return indexCondition{column: position, operator: operator, value: constant}, true
+}
+
+// isIndexOperator - Return true if rows fulfilling condition with operator can be found in ordered index
+func isIndexOperator(operator token.Type) bool {
+	return slices.Contains([]token.Type{token.EQUAL, token.LT, token.GT, token.LTE, token.GTE}, operator)
+}
+
+// getRowsOfContainExpression - Return rows found in indexes of column compared with values of IN list
+func (engine *DbEngine) getRowsOfContainExpression(table *Table, containExpression *ast.ContainExpression) ([]int, bool) {
+	if _, isIdentifier := containExpression.Left.(ast.Identifier); !isIdentifier || !containExpression.Contains {
+		return nil, false
+	}
+	columnName := containExpression.Left.GetToken().Literal
+	column := slices.IndexFunc(table.Columns, func(tableColumn *Column) bool { return tableColumn.Name == columnName })
+	if column < 0 {
+		return nil, false
+	}
+	rows := make([]int, 0)
+	for _, element := range containExpression.Right {
+		value, err := getInterfaceValue(element.Token)
+		if err != nil {
+			return nil, false
+		}
+		elementRows, isIndexed := getRowsOfConditions(table, []indexCondition{{column: column, operator: token.EQUAL, value: value}})
+		if !isIndexed {
+			return nil, false
+		}
+		rows = append(rows, elementRows...)
+	}
+	return rows, true
+}
+
+// getRowsOfConditions - Return rows found in all indexes which can be used for conditions
+func getRowsOfConditions(table *Table, conditions []indexCondition) ([]int, bool) {
+	var rows []int
+	isIndexed := false
+	for _, index := range table.indexes {
+		indexRows, isUsed := index.getRows(table, conditions)
+		switch {
+		case isUsed && isIndexed:
+			rows = getCommonRows(rows, indexRows)
+		case isUsed:
+			rows, isIndexed = indexRows, true
+		}
+	}
+	return rows, isIndexed
+}
+
+// getConstantValue - Return value of expression which doesn't depend on row, false is returned for expressions using
+// columns or changing state of the engine, which have to be evaluated for every row
+func (engine *DbEngine) getConstantValue(tifier ast.Tifier) (ValueInterface, bool) {
+	if len(ast.GetTifierIdentifiers(tifier)) > 0 || callsEngineFunction(tifier) {
+		return nil, false
+	}
+	value, err := engine.getTifierValue(tifier, map[string]ValueInterface{})
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// getCommonRows - Return rows present in both lists
+func getCommonRows(firstRows []int, secondRows []int) []int {
+	firstSet := make(map[int]bool, len(firstRows))
+	for _, row := range firstRows {
+		firstSet[row] = true
+	}
+	commonRows := make([]int, 0)
+	for _, row := range secondRows {
+		if firstSet[row] {
+			commonRows = append(commonRows, row)
+		}
+	}
+	return commonRows
+}
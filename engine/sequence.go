@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/LissaGreense/GO4SQL/ast"
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// Sequence - Generator of unique integers created with CREATE SEQUENCE or for SERIAL and identity columns, values
+// are increased by Increment and stay between MinValue and MaxValue
+type Sequence struct {
+	Name      string
+	Increment int64
+	MinValue  int64
+	MaxValue  int64
+	Start     int64
+	Cycle     bool // sequence starts again from MinValue, or MaxValue when descending, after reaching its limit
+	// OwnerTable - table of SERIAL or identity column using the sequence, sequence is dropped together with the
+	// table, it's empty for sequences created with CREATE SEQUENCE
+	OwnerTable string
+	// OwnerColumn - SERIAL or identity column of OwnerTable using the sequence, sequence is dropped together with it
+	OwnerColumn string
+	lastValue   int64
+	isCalled    bool // lastValue was already returned, so NEXTVAL returns the following value
+	hasValue    bool // NEXTVAL or SETVAL was used, so CURRVAL can return lastValue
+}
+
+// newSequence - Return Sequence with options from command, missing options are set the same way as in PostgreSQL:
+// ascending sequence starts from its MinValue equal 1 and descending one from its MaxValue equal -1
+func newSequence(command *ast.CreateSequenceCommand) (*Sequence, error) {
+	sequence := &Sequence{Name: command.Name.Token.Literal, Increment: 1, Cycle: command.Cycle}
+	if command.Increment != nil {
+		sequence.Increment = *command.Increment
+	}
+	if sequence.Increment == 0 {
+		return nil, &InvalidSequenceOptionsError{sequenceName: sequence.Name, description: "INCREMENT must not be zero"}
+	}
+
+	sequence.MinValue, sequence.MaxValue = 1, math.MaxInt64
+	if sequence.Increment < 0 {
+		sequence.MinValue, sequence.MaxValue = math.MinInt64, -1
+	}
+	if command.MinValue != nil {
+		sequence.MinValue = *command.MinValue
+	}
+	if command.MaxValue != nil {
+		sequence.MaxValue = *command.MaxValue
+	}
+	if sequence.MinValue >= sequence.MaxValue {
+		return nil, &InvalidSequenceOptionsError{sequenceName: sequence.Name, description: "MINVALUE (" +
+			strconv.FormatInt(sequence.MinValue, 10) + ") must be less than MAXVALUE (" + strconv.FormatInt(sequence.MaxValue, 10) + ")"}
+	}
+
+	sequence.Start = sequence.MinValue
+	if sequence.Increment < 0 {
+		sequence.Start = sequence.MaxValue
+	}
+	if command.Start != nil {
+		sequence.Start = *command.Start
+	}
+	if sequence.Start < sequence.MinValue || sequence.Start > sequence.MaxValue {
+		return nil, &InvalidSequenceOptionsError{sequenceName: sequence.Name, description: "START value (" +
+			strconv.FormatInt(sequence.Start, 10) + ") must be between MINVALUE and MAXVALUE"}
+	}
+
+	sequence.lastValue = sequence.Start
+	return sequence, nil
+}
+
+// nextValue - Move sequence to the next value and return it, the first call returns Start
+func (sequence *Sequence) nextValue() (int64, error) {
+	sequence.hasValue = true
+	if !sequence.isCalled {
+		sequence.isCalled = true
+		return sequence.lastValue, nil
+	}
+
+	// Distance to the limit is compared as unsigned number, so it can't overflow
+	if sequence.Increment > 0 && uint64(sequence.MaxValue-sequence.lastValue) < uint64(sequence.Increment) {
+		if !sequence.Cycle {
+			return 0, &SequenceLimitError{sequenceName: sequence.Name, limit: "maximum", value: sequence.MaxValue}
+		}
+		sequence.lastValue = sequence.MinValue
+		return sequence.lastValue, nil
+	}
+	if sequence.Increment < 0 && uint64(sequence.lastValue-sequence.MinValue) < uint64(-sequence.Increment) {
+		if !sequence.Cycle {
+			return 0, &SequenceLimitError{sequenceName: sequence.Name, limit: "minimum", value: sequence.MinValue}
+		}
+		sequence.lastValue = sequence.MaxValue
+		return sequence.lastValue, nil
+	}
+
+	sequence.lastValue += sequence.Increment
+	return sequence.lastValue, nil
+}
+
+// currentValue - Return value most recently returned by NEXTVAL or set by SETVAL
+func (sequence *Sequence) currentValue() (int64, error) {
+	if !sequence.hasValue {
+		return 0, &SequenceValueNotDefinedError{sequenceName: sequence.Name}
+	}
+	return sequence.lastValue, nil
+}
+
+// setValue - Set current value of the sequence, when isCalled is false the next NEXTVAL returns this value,
+// otherwise it returns the following one
+func (sequence *Sequence) setValue(value int64, isCalled bool) error {
+	if value < sequence.MinValue || value > sequence.MaxValue {
+		return &SequenceValueOutOfRangeError{sequenceName: sequence.Name, value: value, minValue: sequence.MinValue, maxValue: sequence.MaxValue}
+	}
+	sequence.lastValue = value
+	sequence.isCalled = isCalled
+	sequence.hasValue = true
+	return nil
+}
+
+// restart - Move sequence back to its Start, so the next NEXTVAL returns Start again
+func (sequence *Sequence) restart() {
+	sequence.lastValue = sequence.Start
+	sequence.isCalled = false
+	sequence.hasValue = false
+}
+
+// createSequence - register new sequence in engine with specified name and options
+func (engine *DbEngine) createSequence(command *ast.CreateSequenceCommand) error {
+	_, exist := engine.Sequences[command.Name.Token.Literal]
+	if exist {
+		return &SequenceAlreadyExistsError{sequenceName: command.Name.Token.Literal}
+	}
+
+	sequence, err := newSequence(command)
+	if err != nil {
+		return err
+	}
+	engine.Sequences[command.Name.Token.Literal] = sequence
+	return nil
+}
+
+// getOwnedSequence - Return new sequence generating values of SERIAL or identity column and set default value of the
+// column to NEXTVAL of this sequence, sequence is named the same way as in PostgreSQL, ex. tbl_id_seq, names which
+// are already reserved for other columns of the table are skipped
+func (engine *DbEngine) getOwnedSequence(tableName string, column *Column, identity ast.Identity, reservedNames map[string]bool) (*Sequence, error) {
+	if column.Type.Type == token.SERIAL {
+		column.Type = token.Token{Type: token.INT, Literal: token.INT}
+	}
+	isIntegerType := column.Type.Type == token.SMALLINT || column.Type.Type == token.INT || column.Type.Type == token.BIGINT
+	if !isIntegerType || column.IsArray {
+		return nil, &InvalidIdentityTypeError{columnName: column.Name, columnType: column.getTypeName()}
+	}
+	if column.Default != nil {
+		return nil, &MultipleDefaultValuesError{columnName: column.Name}
+	}
+
+	baseName := tableName + "_" + column.Name + "_seq"
+	name := baseName
+	for i := 1; reservedNames[name] || engine.Sequences[name] != nil; i++ {
+		name = baseName + strconv.Itoa(i)
+	}
+	reservedNames[name] = true
+
+	sequence, err := newSequence(&ast.CreateSequenceCommand{Name: ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: name}}})
+	if err != nil {
+		return nil, err
+	}
+	sequence.OwnerTable = tableName
+	sequence.OwnerColumn = column.Name
+
+	column.Default = ast.FunctionCall{
+		Name:      token.Token{Type: token.IDENT, Literal: "NEXTVAL"},
+		Arguments: []ast.Tifier{ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: name}}},
+	}
+	column.NotNull = true
+	column.Identity = identity
+	return sequence, nil
+}
+
+// dropOwnedSequences - Remove sequences of SERIAL and identity columns of dropped table
+func (engine *DbEngine) dropOwnedSequences(tableName string) {
+	for name, sequence := range engine.Sequences {
+		if sequence.OwnerTable == tableName {
+			delete(engine.Sequences, name)
+		}
+	}
+}
+
+// getColumnSequence - Return sequence generating values of SERIAL or identity column, it's nil for other columns
+func (engine *DbEngine) getColumnSequence(tableName string, columnName string) *Sequence {
+	for _, sequence := range engine.Sequences {
+		if sequence.OwnerTable == tableName && sequence.OwnerColumn == columnName {
+			return sequence
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+func init() {
+	registerScalarFunction("NEXTVAL", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluateInEngine: nextVal})
+	registerScalarFunction("CURRVAL", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluateInEngine: currVal})
+	registerScalarFunction("SETVAL", scalarFunction{minArguments: 2, maxArguments: 3, propagatesNull: true, evaluateInEngine: setVal})
+}
+
+// nextVal - NEXTVAL(sequence) moves sequence to its next value and returns it
+func nextVal(engine *DbEngine, _ string, arguments []ValueInterface) (ValueInterface, error) {
+	sequence, err := engine.getSequenceArgument(arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	value, err := sequence.nextValue()
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: value}, nil
+}
+
+// currVal - CURRVAL(sequence) returns value most recently returned by NEXTVAL or set by SETVAL, it isn't stored per
+// client, so it can return value obtained by other client
+func currVal(engine *DbEngine, _ string, arguments []ValueInterface) (ValueInterface, error) {
+	sequence, err := engine.getSequenceArgument(arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	value, err := sequence.currentValue()
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: value}, nil
+}
+
+// setVal - SETVAL(sequence, value [, is_called]) sets current value of sequence and returns it, NEXTVAL returns the
+// following value unless is_called is FALSE
+func setVal(engine *DbEngine, functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	sequence, err := engine.getSequenceArgument(arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	value, isInteger := arguments[1].(IntegerValue)
+	if !isInteger {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.INT, actualValue: arguments[1].ToString()}
+	}
+	isCalled := true
+	if len(arguments) == 3 {
+		isCalledValue, isBoolean := arguments[2].(BooleanValue)
+		if !isBoolean {
+			return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.BOOLEAN, actualValue: arguments[2].ToString()}
+		}
+		isCalled = isCalledValue.Value
+	}
+
+	err = sequence.setValue(value.Value, isCalled)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// getSequenceArgument - Return sequence which name is passed as function argument
+func (engine *DbEngine) getSequenceArgument(argument ValueInterface) (*Sequence, error) {
+	sequence, exist := engine.Sequences[argument.ToString()]
+	if !exist {
+		return nil, &SequenceDoesNotExistError{sequenceName: argument.ToString()}
+	}
+	return sequence, nil
+}
@@ -0,0 +1,299 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+// maxPaddedLength - Limit of length passed to LPAD and RPAD, the same as maximum length of VARCHAR in PostgreSQL,
+// so padding can't allocate unlimited memory
+const maxPaddedLength = 10485760
+
+func init() {
+	registerScalarFunction("UPPER", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: upper})
+	registerScalarFunction("LOWER", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: lower})
+	registerScalarFunction("LENGTH", scalarFunction{minArguments: 1, maxArguments: 1, propagatesNull: true, evaluate: length})
+	registerScalarFunction("SUBSTR", scalarFunction{minArguments: 2, maxArguments: 3, propagatesNull: true, evaluate: substr})
+	registerScalarFunction("SUBSTRING", scalarFunction{minArguments: 2, maxArguments: 3, propagatesNull: true, evaluate: substr})
+	registerScalarFunction("TRIM", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: trim})
+	registerScalarFunction("LTRIM", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: ltrim})
+	registerScalarFunction("RTRIM", scalarFunction{minArguments: 1, maxArguments: 2, propagatesNull: true, evaluate: rtrim})
+	registerScalarFunction("REPLACE", scalarFunction{minArguments: 3, maxArguments: 3, propagatesNull: true, evaluate: replace})
+	registerScalarFunction("CONCAT", scalarFunction{minArguments: 1, maxArguments: variadicArguments, propagatesNull: false, evaluate: concat})
+	registerScalarFunction("||", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: concat})
+	registerScalarFunction("POSITION", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: position})
+	registerScalarFunction("INSTR", scalarFunction{minArguments: 2, maxArguments: 2, propagatesNull: true, evaluate: instr})
+	registerScalarFunction("LPAD", scalarFunction{minArguments: 2, maxArguments: 3, propagatesNull: true, evaluate: lpad})
+	registerScalarFunction("RPAD", scalarFunction{minArguments: 2, maxArguments: 3, propagatesNull: true, evaluate: rpad})
+	registerScalarFunction("SPLIT_PART", scalarFunction{minArguments: 3, maxArguments: 3, propagatesNull: true, evaluate: splitPart})
+}
+
+// upper - UPPER(text) converts all letters to upper case
+func upper(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	text, err := getTextArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	return StringValue{Value: strings.ToUpper(text)}, nil
+}
+
+// lower - LOWER(text) converts all letters to lower case
+func lower(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	text, err := getTextArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	return StringValue{Value: strings.ToLower(text)}, nil
+}
+
+// length - LENGTH(text) returns number of characters, for BLOB it returns number of bytes
+func length(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	if blob, isBlob := arguments[0].(BlobValue); isBlob {
+		return IntegerValue{Value: int64(len(blob.Value))}, nil
+	}
+	text, err := getTextArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: int64(utf8.RuneCountInString(text))}, nil
+}
+
+// substr - SUBSTR(text, start [, count]) returns count characters beginning from start position, positions are
+// counted from 1, for BLOB bytes are returned instead of characters
+func substr(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	if blob, isBlob := arguments[0].(BlobValue); isBlob {
+		start, end, err := getSubstringBounds(functionName, arguments, len(blob.Value))
+		if err != nil {
+			return nil, err
+		}
+		return BlobValue{Value: blob.Value[start:end]}, nil
+	}
+
+	argument, err := getTextArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	text := []rune(argument)
+	start, end, err := getSubstringBounds(functionName, arguments, len(text))
+	if err != nil {
+		return nil, err
+	}
+	return StringValue{Value: string(text[start:end])}, nil
+}
+
+// getSubstringBounds - Return zero-based start and end of the part selected by SUBSTR arguments, end is exclusive
+func getSubstringBounds(functionName string, arguments []ValueInterface, length int) (int, int, error) {
+	start, err := getIntegerArgument(functionName, arguments[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end := length + 1
+	if len(arguments) == 3 {
+		count, err := getIntegerArgument(functionName, arguments[2])
+		if err != nil {
+			return 0, 0, err
+		}
+		if count < 0 {
+			return 0, 0, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "non-negative INT", actualValue: arguments[2].ToString()}
+		}
+		end = min(end, start+count)
+	}
+	start = max(start, 1)
+
+	if start >= end {
+		return 0, 0, nil
+	}
+	return start - 1, end - 1, nil
+}
+
+// trim - TRIM(text [, characters]) removes characters (space by default) from both ends of text
+func trim(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return trimText(functionName, arguments, strings.Trim)
+}
+
+// ltrim - LTRIM(text [, characters]) removes characters (space by default) from the beginning of text
+func ltrim(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return trimText(functionName, arguments, strings.TrimLeft)
+}
+
+// rtrim - RTRIM(text [, characters]) removes characters (space by default) from the end of text
+func rtrim(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return trimText(functionName, arguments, strings.TrimRight)
+}
+
+func trimText(functionName string, arguments []ValueInterface, trimFunction func(string, string) string) (ValueInterface, error) {
+	texts, err := getTextArguments(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	characters := " "
+	if len(texts) == 2 {
+		characters = texts[1]
+	}
+	return StringValue{Value: trimFunction(texts[0], characters)}, nil
+}
+
+// replace - REPLACE(text, from, to) replaces all occurrences of from with to
+func replace(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	texts, err := getTextArguments(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	text, from, to := texts[0], texts[1], texts[2]
+	if from == "" {
+		return StringValue{Value: text}, nil
+	}
+	return StringValue{Value: strings.ReplaceAll(text, from, to)}, nil
+}
+
+// concat - CONCAT(text, ...) joins all not NULL arguments, also used by || operator which returns NULL instead
+func concat(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	for _, argument := range arguments {
+		if argument.GetType() == BlobType {
+			return concatBlobs(functionName, arguments)
+		}
+		if argument.GetType() != NullType {
+			break
+		}
+	}
+
+	var builder strings.Builder
+	for _, argument := range arguments {
+		if argument.GetType() == NullType {
+			continue
+		}
+		text, err := getTextArgument(functionName, argument)
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(text)
+	}
+	return StringValue{Value: builder.String()}, nil
+}
+
+// concatBlobs - Return BLOB with bytes of all not NULL arguments joined, used by concat when the first joined
+// argument is BLOB, so other arguments have to be BLOB too
+func concatBlobs(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	bytes := make([]byte, 0)
+	for _, argument := range arguments {
+		if argument.GetType() == NullType {
+			continue
+		}
+		blob, isBlob := argument.(BlobValue)
+		if !isBlob {
+			return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: token.BLOB, actualValue: argument.ToString()}
+		}
+		bytes = append(bytes, blob.Value...)
+	}
+	return BlobValue{Value: bytes}, nil
+}
+
+// position - POSITION(substring, text) returns position of the first occurrence of substring or 0 if not found
+func position(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	texts, err := getTextArguments(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: int64(getPosition(texts[1], texts[0]))}, nil
+}
+
+// instr - INSTR(text, substring) works like POSITION with reversed order of arguments
+func instr(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	texts, err := getTextArguments(functionName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return IntegerValue{Value: int64(getPosition(texts[0], texts[1]))}, nil
+}
+
+func getPosition(text string, substring string) int {
+	index := strings.Index(text, substring)
+	if index < 0 {
+		return 0
+	}
+	return utf8.RuneCountInString(text[:index]) + 1
+}
+
+// lpad - LPAD(text, length [, fill]) fills text from the left with fill (space by default) up to length, longer
+// text is truncated
+func lpad(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return pad(functionName, arguments, true)
+}
+
+// rpad - RPAD(text, length [, fill]) fills text from the right with fill (space by default) up to length, longer
+// text is truncated
+func rpad(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	return pad(functionName, arguments, false)
+}
+
+func pad(functionName string, arguments []ValueInterface, fromLeft bool) (ValueInterface, error) {
+	argument, err := getTextArgument(functionName, arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	text := []rune(argument)
+	targetLength, err := getIntegerArgument(functionName, arguments[1])
+	if err != nil {
+		return nil, err
+	}
+	if targetLength > maxPaddedLength {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "length not greater than " + strconv.Itoa(maxPaddedLength), actualValue: arguments[1].ToString()}
+	}
+	fill := []rune(" ")
+	if len(arguments) == 3 {
+		fillArgument, err := getTextArgument(functionName, arguments[2])
+		if err != nil {
+			return nil, err
+		}
+		fill = []rune(fillArgument)
+	}
+
+	targetLength = max(targetLength, 0)
+	if len(text) >= targetLength || len(fill) == 0 {
+		return StringValue{Value: string(text[:min(len(text), targetLength)])}, nil
+	}
+
+	padding := make([]rune, 0, targetLength-len(text))
+	for i := 0; len(padding) < targetLength-len(text); i++ {
+		padding = append(padding, fill[i%len(fill)])
+	}
+
+	if fromLeft {
+		return StringValue{Value: string(padding) + string(text)}, nil
+	}
+	return StringValue{Value: string(text) + string(padding)}, nil
+}
+
+// splitPart - SPLIT_PART(text, delimiter, n) splits text on delimiter and returns n-th part counting from 1, negative
+// n counts parts from the end
+func splitPart(functionName string, arguments []ValueInterface) (ValueInterface, error) {
+	texts, err := getTextArguments(functionName, arguments[:2])
+	if err != nil {
+		return nil, err
+	}
+	text, delimiter := texts[0], texts[1]
+	n, err := getIntegerArgument(functionName, arguments[2])
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, &InvalidFunctionArgumentError{functionName: functionName, expectedType: "non-zero INT", actualValue: arguments[2].ToString()}
+	}
+
+	parts := []string{text}
+	if delimiter != "" {
+		parts = strings.Split(text, delimiter)
+	}
+
+	if n < 0 {
+		n = len(parts) + n + 1
+	}
+	if n < 1 || n > len(parts) {
+		return StringValue{Value: ""}, nil
+	}
+	return StringValue{Value: parts[n-1]}, nil
+}
@@ -3,11 +3,22 @@ package engine
 import (
 	"github.com/LissaGreense/GO4SQL/token"
 	"hash/adler32"
+	"strings"
 )
 
 // Table - Contain Columns that store values in engine
 type Table struct {
 	Columns []*Column
+	// primaryKey - index of values of primary key columns, it's nil when table has no primary key
+	primaryKey *uniqueIndex
+	// uniqueConstraints - indexes of columns declared with UNIQUE
+	uniqueConstraints []*uniqueIndex
+	// foreignKeys - columns which values have to exist in other tables
+	foreignKeys []*foreignKey
+	// checks - conditions declared with CHECK which have to be fulfilled by every row
+	checks []checkConstraint
+	// indexes - indexes created with CREATE INDEX, they are used to find rows fulfilling WHERE conditions
+	indexes []*secondaryIndex
 }
 
 func (table *Table) isEqual(secondTable *Table) bool {
@@ -38,6 +49,49 @@ func (table *Table) isEqual(secondTable *Table) bool {
 	return true
 }
 
+// setRows - Change values of changed columns in rows with provided sorted positions and replace their keys in
+// indexes, oldRows and newRows contain values of rows before and after change, rows are left unchanged if any new key
+// is duplicated
+func (table *Table) setRows(tableName string, rowIndexes []int, oldRows [][]ValueInterface, newRows [][]ValueInterface, changedColumns map[int]bool) error {
+	setValues := func(rows [][]ValueInterface) {
+		for i, rowIndex := range rowIndexes {
+			for colIndex := range changedColumns {
+				table.Columns[colIndex].Values[rowIndex] = rows[i][colIndex]
+			}
+		}
+	}
+
+	setValues(newRows)
+	// Only keys of changed rows are checked, all of them at once, so rows can swap their keys in one update
+	err := table.updateIndexes(tableName, rowIndexes, oldRows, newRows, changedColumns)
+	if err != nil {
+		setValues(oldRows)
+		return err
+	}
+	return nil
+}
+
+// insertRows - Insert rows back to provided sorted positions, which they had before they were removed, and add them
+// to indexes
+func (table *Table) insertRows(rowIndexes []int, rows [][]ValueInterface) {
+	for colIndex, column := range table.Columns {
+		values := make([]ValueInterface, 0, len(column.Values)+len(rows))
+		next := 0
+		for _, value := range column.Values {
+			for next < len(rowIndexes) && rowIndexes[next] == len(values) {
+				values = append(values, rows[next][colIndex])
+				next++
+			}
+			values = append(values, value)
+		}
+		for ; next < len(rowIndexes); next++ {
+			values = append(values, rows[next][colIndex])
+		}
+		column.Values = values
+	}
+	table.insertIntoIndexes(rowIndexes, rows)
+}
+
 // getDistinctTable - Takes input table, and returns new one without any duplicates
 func (table *Table) getDistinctTable() *Table {
 	distinctTable := getCopyOfTableWithoutRows(table)
@@ -51,7 +105,7 @@ func (table *Table) getDistinctTable() *Table {
 		mergedColumnValues := ""
 		for iColumn := range table.Columns {
 			fieldValue := table.Columns[iColumn].Values[iRow].ToString()
-			if table.Columns[iColumn].Type.Literal == token.TEXT {
+			if isTextColumn(table.Columns[iColumn]) {
 				fieldValue = "'" + fieldValue + "'"
 			}
 			mergedColumnValues += fieldValue
@@ -74,47 +128,46 @@ func (table *Table) getDistinctTable() *Table {
 func (table *Table) ToString() string {
 	columWidths := getColumWidths(table.Columns)
 	bar := getBar(columWidths)
-	result := bar + "\n"
+	// Builder keeps rendering linear, so long values don't make printing slow
+	var result strings.Builder
+	result.WriteString(bar + "\n")
 
-	result += "|"
+	result.WriteString("|")
 	for i := range table.Columns {
-		result += " "
-		for j := 0; j < columWidths[i]-len(table.Columns[i].Name); j++ {
-			result += " "
-		}
-		result += table.Columns[i].Name
-		result += " |"
+		result.WriteString(" ")
+		result.WriteString(strings.Repeat(" ", max(columWidths[i]-len(table.Columns[i].Name), 0)))
+		result.WriteString(table.Columns[i].Name)
+		result.WriteString(" |")
 	}
-	result += "\n" + bar + "\n"
+	result.WriteString("\n" + bar + "\n")
 
 	if len(table.Columns) == 0 {
-		return result
+		return result.String()
 	}
 
 	rowsCount := len(table.Columns[0].Values)
 
 	for iRow := 0; iRow < rowsCount; iRow++ {
-		result += "|"
+		result.WriteString("|")
 
 		for iColumn := range table.Columns {
-			result += " "
+			result.WriteString(" ")
 
 			printedValue := table.Columns[iColumn].Values[iRow].ToString()
-			if table.Columns[iColumn].Type.Literal == token.TEXT &&
+			if isTextColumn(table.Columns[iColumn]) &&
 				table.Columns[iColumn].Values[iRow].GetType() != NullType {
 				printedValue = "'" + printedValue + "'"
 			}
-			for i := 0; i < columWidths[iColumn]-len(printedValue); i++ {
-				result += " "
-			}
+			result.WriteString(strings.Repeat(" ", max(columWidths[iColumn]-len(printedValue), 0)))
 
-			result += printedValue + " |"
+			result.WriteString(printedValue + " |")
 		}
 
-		result += "\n"
+		result.WriteString("\n")
 	}
 
-	return result + bar
+	result.WriteString(bar)
+	return result.String()
 }
 
 func (table *Table) getTableCopyWithAddedPrefixToColumnNames(columnNamePrefix string) *Table {
@@ -123,9 +176,12 @@ func (table *Table) getTableCopyWithAddedPrefixToColumnNames(columnNamePrefix st
 	for _, column := range table.Columns {
 		newTable.Columns = append(newTable.Columns,
 			&Column{
-				Type:   column.Type,
-				Values: column.Values,
-				Name:   columnNamePrefix + column.Name,
+				Type:           column.Type,
+				TypeParameters: column.TypeParameters,
+				Enum:           column.Enum,
+				IsArray:        column.IsArray,
+				Values:         column.Values,
+				Name:           columnNamePrefix + column.Name,
 			})
 	}
 
@@ -133,17 +189,16 @@ func (table *Table) getTableCopyWithAddedPrefixToColumnNames(columnNamePrefix st
 }
 
 func getBar(columWidths []int) string {
-	bar := "+"
+	var bar strings.Builder
+	bar.WriteString("+")
 
 	for i := 0; i < len(columWidths); i++ {
-		bar += "-"
-		for j := 0; j < columWidths[i]; j++ {
-			bar += "-"
-		}
-		bar += "-+"
+		bar.WriteString("-")
+		bar.WriteString(strings.Repeat("-", columWidths[i]))
+		bar.WriteString("-+")
 	}
 
-	return bar
+	return bar.String()
 }
 
 func getColumWidths(columns []*Column) []int {
@@ -153,7 +208,7 @@ func getColumWidths(columns []*Column) []int {
 		maxLength := len(columns[iColumn].Name)
 		for iRow := range columns[iColumn].Values {
 			valueLength := len(columns[iColumn].Values[iRow].ToString())
-			if columns[iColumn].Type.Literal == token.TEXT {
+			if isTextColumn(columns[iColumn]) {
 				valueLength += 2 // double '
 			}
 			if valueLength > maxLength {
@@ -165,3 +220,9 @@ func getColumWidths(columns []*Column) []int {
 
 	return widths
 }
+
+// isTextColumn - Return true if values of column are printed in apostrophes, arrays are printed without them
+func isTextColumn(column *Column) bool {
+	columnType := column.Type.Type
+	return !column.IsArray && (columnType == token.TEXT || columnType == token.VARCHAR || columnType == token.CHAR)
+}
@@ -0,0 +1,399 @@
+package engine
+
+import (
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LissaGreense/GO4SQL/token"
+)
+
+const (
+	dateLayout      = "2006-01-02"
+	timeLayout      = "15:04:05.999999"
+	timestampLayout = dateLayout + " " + timeLayout
+	timeZoneLayout  = "-07"
+)
+
+// DateValue - Implementation of ValueInterface that is containing calendar date, time of the day is always midnight
+// in UTC
+type DateValue struct {
+	Value time.Time
+}
+
+// TimeValue - Implementation of ValueInterface that is containing time of the day, date part is always 0000-01-01
+type TimeValue struct {
+	Value time.Time
+}
+
+// TimestampValue - Implementation of ValueInterface that is containing date with time of the day, values with time
+// zone are stored in UTC
+type TimestampValue struct {
+	Value        time.Time
+	WithTimeZone bool
+}
+
+// IntervalValue - Implementation of ValueInterface that is containing period of time, months and days are kept
+// separately from Duration, because their length depends on the date they're added to
+type IntervalValue struct {
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// ToString implementations
+func (value DateValue) ToString() string { return value.Value.Format(dateLayout) }
+func (value TimeValue) ToString() string { return value.Value.Format(timeLayout) }
+func (value TimestampValue) ToString() string {
+	if value.WithTimeZone {
+		return value.Value.Format(timestampLayout + timeZoneLayout)
+	}
+	return value.Value.Format(timestampLayout)
+}
+
+// ToString - Return interval in form used by PostgreSQL, ex. 1 year 2 mons 3 days 04:05:06
+func (value IntervalValue) ToString() string {
+	parts := make([]string, 0, 4)
+	years, months := value.Months/12, value.Months%12
+	parts = appendIntervalPart(parts, years, "year")
+	parts = appendIntervalPart(parts, months, "mon")
+	parts = appendIntervalPart(parts, value.Days, "day")
+
+	if value.Duration != 0 || len(parts) == 0 {
+		duration := value.Duration
+		sign := ""
+		if duration < 0 {
+			sign = "-"
+			duration = -duration
+		}
+		clock := time.Time{}.Add(duration % (24 * time.Hour)).Format(timeLayout)
+		hours := int(duration / time.Hour)
+		parts = append(parts, sign+padTwoDigits(hours)+clock[2:])
+	}
+	return strings.Join(parts, " ")
+}
+
+func appendIntervalPart(parts []string, amount int, unit string) []string {
+	if amount == 0 {
+		return parts
+	}
+	if amount != 1 {
+		unit += "s"
+	}
+	return append(parts, strconv.Itoa(amount)+" "+unit)
+}
+
+func padTwoDigits(number int) string {
+	if number < 10 {
+		return "0" + strconv.Itoa(number)
+	}
+	return strconv.Itoa(number)
+}
+
+// GetType implementations
+func (value DateValue) GetType() SupportedTypes      { return DateType }
+func (value TimeValue) GetType() SupportedTypes      { return TimeType }
+func (value TimestampValue) GetType() SupportedTypes { return TimestampType }
+func (value IntervalValue) GetType() SupportedTypes  { return IntervalType }
+
+// IsEqual implementations
+func (value DateValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+func (value TimeValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+func (value TimestampValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+func (value IntervalValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+
+// isSmallerThan implementations
+func (value DateValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isTemporalSmallerThan(value, secondValue)
+}
+func (value TimeValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isTemporalSmallerThan(value, secondValue)
+}
+func (value TimestampValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isTemporalSmallerThan(value, secondValue)
+}
+func (value IntervalValue) isSmallerThan(secondValue ValueInterface) bool {
+	return isTemporalSmallerThan(value, secondValue)
+}
+
+// isGreaterThan implementations
+func (value DateValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isTemporalGreaterThan(value, secondValue)
+}
+func (value TimeValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isTemporalGreaterThan(value, secondValue)
+}
+func (value TimestampValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isTemporalGreaterThan(value, secondValue)
+}
+func (value IntervalValue) isGreaterThan(secondValue ValueInterface) bool {
+	return isTemporalGreaterThan(value, secondValue)
+}
+
+func isTemporalSmallerThan(value ValueInterface, secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	comparison, isComparable := compareTemporals(value, secondValue)
+	if !isComparable {
+		log.Fatal("Can't compare " + getTypeName(value) + " with other type")
+	}
+	return comparison < 0
+}
+
+func isTemporalGreaterThan(value ValueInterface, secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	comparison, isComparable := compareTemporals(value, secondValue)
+	if !isComparable {
+		log.Fatal("Can't compare " + getTypeName(value) + " with other type")
+	}
+	return comparison > 0
+}
+
+func isTemporal(value ValueInterface) bool {
+	switch value.GetType() {
+	case DateType, TimeType, TimestampType, IntervalType:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareTemporals - Return -1, 0 or 1 if first value is respectively earlier, equal or later than second one, DATE
+// can be compared with TIMESTAMP, second result is false if values can't be compared
+func compareTemporals(first ValueInterface, second ValueInterface) (int, bool) {
+	if firstInterval, isInterval := first.(IntervalValue); isInterval {
+		secondInterval, isSecondInterval := second.(IntervalValue)
+		if !isSecondInterval {
+			return 0, false
+		}
+		return compareDurations(firstInterval.approximateDuration(), secondInterval.approximateDuration()), true
+	}
+
+	firstTime, isFirstPointInTime := toPointInTime(first)
+	secondTime, isSecondPointInTime := toPointInTime(second)
+	if !isFirstPointInTime || !isSecondPointInTime || (first.GetType() == TimeType) != (second.GetType() == TimeType) {
+		return 0, false
+	}
+	return firstTime.Compare(secondTime), true
+}
+
+// toPointInTime - Return time.Time stored by DATE, TIME or TIMESTAMP value
+func toPointInTime(value ValueInterface) (time.Time, bool) {
+	switch temporal := value.(type) {
+	case DateValue:
+		return temporal.Value, true
+	case TimeValue:
+		return temporal.Value, true
+	case TimestampValue:
+		return temporal.Value, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func compareDurations(first time.Duration, second time.Duration) int {
+	if first < second {
+		return -1
+	}
+	if first > second {
+		return 1
+	}
+	return 0
+}
+
+// approximateDuration - Return length of interval assuming that month has 30 days and day has 24 hours, the same
+// assumption is used by PostgreSQL to compare intervals
+func (value IntervalValue) approximateDuration() time.Duration {
+	return time.Duration(value.Months*30+value.Days)*24*time.Hour + value.Duration
+}
+
+// parseTemporal - Return text converted to DATE, TIME, TIMESTAMP, TIMESTAMPTZ or INTERVAL value
+func parseTemporal(text string, typeName string) (ValueInterface, error) {
+	text = strings.TrimSpace(text)
+	var value ValueInterface
+	isValid := false
+
+	switch typeName {
+	case token.DATE:
+		var parsed time.Time
+		parsed, isValid = parseTimestamp(text)
+		value = DateValue{Value: truncateToDate(parsed)}
+	case token.TIME:
+		value, isValid = parseTime(text)
+	case token.TIMESTAMP, token.TIMESTAMPTZ:
+		var parsed time.Time
+		parsed, isValid = parseTimestamp(text)
+		if typeName == token.TIMESTAMP {
+			// time zone of TIMESTAMP without time zone is ignored, like in PostgreSQL
+			parsed = time.Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(),
+				parsed.Second(), parsed.Nanosecond(), time.UTC)
+		}
+		value = TimestampValue{Value: parsed.UTC(), WithTimeZone: typeName == token.TIMESTAMPTZ}
+	case token.INTERVAL:
+		value, isValid = parseInterval(text)
+	}
+
+	if !isValid {
+		return nil, &InvalidTemporalValueError{value: text, typeName: typeName}
+	}
+	return value, nil
+}
+
+// parseTimestamp - Parse ISO-8601 date optionally followed by time of the day and time zone, date and time can be
+// separated either with space or with letter T, ex. 2024-01-31, 2024-01-31 10:30:00 or 2024-01-31T10:30:00.5+02:00
+func parseTimestamp(text string) (time.Time, bool) {
+	text = strings.Replace(text, "T", " ", 1)
+	for _, clockLayout := range []string{"", " 15:04", " 15:04:05"} {
+		for _, zoneLayout := range []string{"", "Z07:00", "Z0700", "Z07"} {
+			parsed, err := time.Parse(dateLayout+clockLayout+zoneLayout, text)
+			if err == nil {
+				return parsed.Truncate(time.Microsecond), true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseTime - Parse ISO-8601 time of the day, ex. 10:30 or 10:30:00.123456
+func parseTime(text string) (TimeValue, bool) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		parsed, err := time.Parse(layout, text)
+		if err == nil {
+			return TimeValue{Value: parsed.Truncate(time.Microsecond)}, true
+		}
+	}
+	return TimeValue{}, false
+}
+
+func truncateToDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// parseInterval - Parse interval written as list of amounts with units optionally followed by time of the day,
+// ex. 1 day, 2 hours 30 minutes, -1 year 3 mons or 1 day 04:05:06
+func parseInterval(text string) (IntervalValue, bool) {
+	interval := IntervalValue{}
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return interval, false
+	}
+
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			clock, isValid := parseIntervalClock(fields[i])
+			if !isValid {
+				return interval, false
+			}
+			interval.Duration += clock
+			continue
+		}
+
+		if i+1 == len(fields) {
+			return interval, false
+		}
+		amount, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return interval, false
+		}
+		if !interval.addAmount(amount, strings.ToLower(fields[i+1])) {
+			return interval, false
+		}
+		i++
+	}
+	return interval, true
+}
+
+// addAmount - Add amount of the unit to the interval, only units shorter than a day can have fractional amounts
+func (interval *IntervalValue) addAmount(amount float64, unit string) bool {
+	unit = strings.TrimSuffix(unit, "s")
+	calendarUnits := map[string]int{"year": 12, "mon": 1, "month": 1}
+	dayUnits := map[string]int{"week": 7, "day": 1}
+	clockUnits := map[string]time.Duration{"hour": time.Hour, "minute": time.Minute, "min": time.Minute,
+		"second": time.Second, "sec": time.Second, "millisecond": time.Millisecond, "microsecond": time.Microsecond}
+
+	if months, isCalendarUnit := calendarUnits[unit]; isCalendarUnit && amount == math.Trunc(amount) {
+		interval.Months += int(amount) * months
+		return true
+	}
+	if days, isDayUnit := dayUnits[unit]; isDayUnit && amount == math.Trunc(amount) {
+		interval.Days += int(amount) * days
+		return true
+	}
+	if duration, isClockUnit := clockUnits[unit]; isClockUnit {
+		interval.Duration += time.Duration(math.Round(amount * float64(duration)))
+		return true
+	}
+	return false
+}
+
+// parseIntervalClock - Parse time part of interval, ex. 04:05:06 or -01:30, hours can exceed 24
+func parseIntervalClock(text string) (time.Duration, bool) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(text, "-") {
+		sign = -1
+		text = text[1:]
+	}
+
+	parts := strings.Split(text, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+	hours, hoursErr := strconv.Atoi(parts[0])
+	minutes, minutesErr := strconv.Atoi(parts[1])
+	seconds := 0.0
+	var secondsErr error
+	if len(parts) == 3 {
+		seconds, secondsErr = strconv.ParseFloat(parts[2], 64)
+	}
+	if hoursErr != nil || minutesErr != nil || secondsErr != nil || hours < 0 || minutes < 0 || minutes > 59 ||
+		seconds < 0 || seconds >= 60 {
+		return 0, false
+	}
+
+	duration := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(math.Round(seconds*float64(time.Second)))
+	return sign * duration, true
+}
+
+// convertTemporal - Return DATE, TIME or TIMESTAMP value converted to the other of these types, TIMESTAMP is
+// truncated when converted to DATE or TIME, DATE is converted to midnight
+func convertTemporal(value ValueInterface, typeName string) (ValueInterface, bool) {
+	if value.GetType() == StringType {
+		converted, err := parseTemporal(value.ToString(), typeName)
+		return converted, err == nil
+	}
+
+	pointInTime, isPointInTime := toPointInTime(value)
+	switch {
+	case typeName == token.INTERVAL && value.GetType() == IntervalType:
+		return value, true
+	case typeName == token.TIME && isPointInTime && value.GetType() != DateType:
+		return TimeValue{Value: time.Date(0, 1, 1, pointInTime.Hour(), pointInTime.Minute(), pointInTime.Second(),
+			pointInTime.Nanosecond(), time.UTC)}, true
+	case value.GetType() == TimeType || !isPointInTime:
+		return nil, false
+	case typeName == token.DATE:
+		return DateValue{Value: truncateToDate(pointInTime)}, true
+	case typeName == token.TIMESTAMP, typeName == token.TIMESTAMPTZ:
+		return TimestampValue{Value: pointInTime, WithTimeZone: typeName == token.TIMESTAMPTZ}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"github.com/LissaGreense/GO4SQL/ast"
+)
+
+// truncatedTable - Table emptied by TRUNCATE together with number of removed rows
+type truncatedTable struct {
+	name      string
+	rowsCount int
+}
+
+// truncateTables - Remove all rows of tables from the command at once, without checking rows one by one and doing
+// actions of foreign keys, and restart sequences of their SERIAL and identity columns. With CASCADE tables referring
+// to truncated ones are truncated too, otherwise error is returned when any of them isn't listed in the command
+func (engine *DbEngine) truncateTables(command *ast.TruncateCommand) ([]truncatedTable, error) {
+	tableNames := make([]string, 0, len(command.Names))
+	truncated := make(map[string]bool)
+	for _, name := range command.Names {
+		if _, exist := engine.Tables[name.Token.Literal]; !exist {
+			return nil, &TableDoesNotExistError{name.Token.Literal}
+		}
+		if !truncated[name.Token.Literal] {
+			truncated[name.Token.Literal] = true
+			tableNames = append(tableNames, name.Token.Literal)
+		}
+	}
+
+	// Tables added by CASCADE are checked as well, so references between them are followed too
+	for i := 0; i < len(tableNames); i++ {
+		for _, reference := range engine.getReferences(tableNames[i]) {
+			if truncated[reference.tableName] {
+				continue
+			}
+			if !command.Cascade {
+				return nil, &TruncatedTableReferencedError{tableName: tableNames[i], constraintName: reference.foreignKey.name, referencingTable: reference.tableName}
+			}
+			truncated[reference.tableName] = true
+			tableNames = append(tableNames, reference.tableName)
+		}
+	}
+
+	truncatedTables := make([]truncatedTable, 0, len(tableNames))
+	for _, tableName := range tableNames {
+		table := engine.Tables[tableName]
+		rowsCount := 0
+		if len(table.Columns) > 0 {
+			rowsCount = len(table.Columns[0].Values)
+		}
+		for _, column := range table.Columns {
+			column.Values = make([]ValueInterface, 0)
+		}
+		err := table.rebuildIndexes(tableName, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sequence := range engine.Sequences {
+			if sequence.OwnerTable == tableName {
+				sequence.restart()
+			}
+		}
+		truncatedTables = append(truncatedTables, truncatedTable{name: tableName, rowsCount: rowsCount})
+	}
+	return truncatedTables, nil
+}
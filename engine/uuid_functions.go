@@ -0,0 +1,22 @@
+package engine
+
+import (
+	"crypto/rand"
+)
+
+func init() {
+	registerScalarFunction("GEN_RANDOM_UUID", scalarFunction{minArguments: 0, maxArguments: 0, propagatesNull: true, evaluate: genRandomUuid})
+}
+
+// genRandomUuid - GEN_RANDOM_UUID() returns random UUID in version 4
+func genRandomUuid(_ string, _ []ValueInterface) (ValueInterface, error) {
+	var uuid UuidValue
+	_, err := rand.Read(uuid.Value[:])
+	if err != nil {
+		return nil, err
+	}
+	// Version 4 and variant defined by RFC 4122
+	uuid.Value[6] = uuid.Value[6]&0x0f | 0x40
+	uuid.Value[8] = uuid.Value[8]&0x3f | 0x80
+	return uuid, nil
+}
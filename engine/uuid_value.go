@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"strings"
+)
+
+// UuidValue - Implementation of ValueInterface that is containing universally unique identifier stored in 16 bytes
+type UuidValue struct {
+	Value [16]byte
+}
+
+// ToString - Return UUID in canonical form, ex. a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11
+func (value UuidValue) ToString() string {
+	text := hex.EncodeToString(value.Value[:])
+	return text[0:8] + "-" + text[8:12] + "-" + text[12:16] + "-" + text[16:20] + "-" + text[20:32]
+}
+
+func (value UuidValue) GetType() SupportedTypes { return UuidType }
+
+func (value UuidValue) IsEqual(valueInterface ValueInterface) bool {
+	return areEqual(value, valueInterface)
+}
+
+// UUIDs are compared byte by byte
+func (value UuidValue) isSmallerThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isGreaterThan(value)
+	}
+
+	secondValueAsUuid, isUuid := secondValue.(UuidValue)
+	if !isUuid {
+		log.Fatal("Can't compare UUID with other type")
+	}
+
+	return bytes.Compare(value.Value[:], secondValueAsUuid.Value[:]) < 0
+}
+
+func (value UuidValue) isGreaterThan(secondValue ValueInterface) bool {
+	nullValue, isNull := secondValue.(NullValue)
+	if isNull {
+		return nullValue.isSmallerThan(value)
+	}
+
+	secondValueAsUuid, isUuid := secondValue.(UuidValue)
+	if !isUuid {
+		log.Fatal("Can't compare UUID with other type")
+	}
+
+	return bytes.Compare(value.Value[:], secondValueAsUuid.Value[:]) > 0
+}
+
+// parseUuid - Return UuidValue from text in canonical form (hyphens after 8, 12, 16 and 20 digits), digits can be
+// written in upper or lower case, hyphens can be omitted
+func parseUuid(text string) (UuidValue, error) {
+	digits := text
+	if len(text) == 36 {
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			return UuidValue{}, &InvalidUuidError{value: text}
+		}
+		digits = strings.ReplaceAll(text, "-", "")
+	}
+	if len(digits) != 32 {
+		return UuidValue{}, &InvalidUuidError{value: text}
+	}
+
+	var uuid UuidValue
+	_, err := hex.Decode(uuid.Value[:], []byte(digits))
+	if err != nil {
+		return UuidValue{}, &InvalidUuidError{value: text}
+	}
+	return uuid, nil
+}
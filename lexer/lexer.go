@@ -2,6 +2,7 @@ package lexer
 
 import (
 	"bytes"
+	"regexp"
 
 	"github.com/LissaGreense/GO4SQL/token"
 )
@@ -9,10 +10,11 @@ import (
 // Lexer - Represent the text input (commands) provided by client before tokenization
 type Lexer struct {
 	input             string
-	position          int  // current position in input (points to current char)
-	readPosition      int  // current reading position in input (after current char)
-	character         byte // current char under examination
-	insideApostrophes bool // flag which tells if lexer is between apostrophes
+	position          int        // current position in input (points to current char)
+	readPosition      int        // current reading position in input (after current char)
+	character         byte       // current char under examination
+	insideApostrophes bool       // flag which tells if lexer is between apostrophes
+	previousToken     token.Type // type of the last returned token
 }
 
 // RunLexer - Start lexer by moving to the first position
@@ -24,6 +26,12 @@ func RunLexer(input string) *Lexer {
 
 // NextToken - Return the next token structure which is appearing after current position.
 func (lexer *Lexer) NextToken() token.Token {
+	tok := lexer.readToken()
+	lexer.previousToken = tok.Type
+	return tok
+}
+
+func (lexer *Lexer) readToken() token.Token {
 	var tok token.Token
 
 	lexer.skipWhitespace()
@@ -39,26 +47,119 @@ func (lexer *Lexer) NextToken() token.Token {
 		tok = newToken(token.LPAREN, string(lexer.character))
 	case ')':
 		tok = newToken(token.RPAREN, string(lexer.character))
+	case '[', ']':
+		if lexer.insideApostrophes {
+			return lexer.readWord()
+		}
+		tok = newToken(token.Type(lexer.character), string(lexer.character))
 	case '\'':
 		lexer.insideApostrophes = !lexer.insideApostrophes
 		tok = newToken(token.APOSTROPHE, string(lexer.character))
+	case '|':
+		if lexer.insideApostrophes || lexer.getNextChar() != '|' {
+			return lexer.readWord()
+		}
+		lexer.readChar()
+		tok = newToken(token.CONCAT, token.CONCAT)
+	case '+', '-':
+		if !lexer.insideApostrophes && lexer.character == '-' && lexer.getNextChar() == '>' {
+			return lexer.readArrow()
+		}
+		if lexer.insideApostrophes || (lexer.isSignOfNumber() && (isDigit(lexer.getNextChar()) || lexer.getNextChar() == '.')) {
+			return lexer.readWord()
+		}
+		tok = newToken(token.Type(lexer.character), string(lexer.character))
+	case '/':
+		if lexer.insideApostrophes {
+			return lexer.readWord()
+		}
+		tok = newToken(token.SLASH, string(lexer.character))
+	case '<', '>':
+		if lexer.insideApostrophes {
+			return lexer.readWord()
+		}
+		operator := string(lexer.character)
+		if lexer.getNextChar() == '=' {
+			lexer.readChar()
+			operator += "="
+		}
+		tok = newToken(token.Type(operator), operator)
+	case ':':
+		if lexer.insideApostrophes || lexer.getNextChar() != ':' {
+			return lexer.readWord()
+		}
+		lexer.readChar()
+		tok = newToken(token.TYPECAST, token.TYPECAST)
+	case 'X', 'x':
+		if lexer.insideApostrophes || lexer.getNextChar() != '\'' {
+			return lexer.readWord()
+		}
+		return lexer.readHexLiteral()
 	case 0:
 		tok.Literal = ""
 		tok.Type = token.EOF
 	default:
-		if lexer.insideApostrophes {
-			characters := lexer.processCharacters([]byte{'\''}, []byte{' ', '\n', '\t', '\r'})
-			return characters
-		} else {
-			characters := lexer.processCharacters([]byte{'\'', '(', ',', ';', '*', ')'}, []byte{})
-			return characters
-		}
+		return lexer.readWord()
 	}
 
 	lexer.readChar()
 	return tok
 }
 
+// readArrow - Return token.ARROW or token.DOUBLE_ARROW
+func (lexer *Lexer) readArrow() token.Token {
+	// Skip - and >
+	lexer.readChar()
+	lexer.readChar()
+
+	if lexer.character != '>' {
+		return newToken(token.ARROW, token.ARROW)
+	}
+	lexer.readChar()
+	return newToken(token.DOUBLE_ARROW, token.DOUBLE_ARROW)
+}
+
+// readHexLiteral - Return token.HEX containing characters between apostrophes of X'DEADBEEF', token.ILLEGAL is
+// returned when closing apostrophe is missing
+func (lexer *Lexer) readHexLiteral() token.Token {
+	// Skip X and opening apostrophe
+	lexer.readChar()
+	lexer.readChar()
+
+	position := lexer.position
+	for lexer.character != '\'' && lexer.character != 0 {
+		lexer.readChar()
+	}
+	characters := lexer.input[position:lexer.position]
+	if lexer.character == 0 {
+		return newToken(token.ILLEGAL, characters)
+	}
+
+	// Skip closing apostrophe
+	lexer.readChar()
+	return newToken(token.HEX, characters)
+}
+
+// isSignOfNumber - Return true if '+' or '-' is a sign of number literal instead of binary operator, which is the case
+// when it doesn't follow an operand, ex. -5 in VALUES(-5) or one EQUAL -5, but not in one -5
+func (lexer *Lexer) isSignOfNumber() bool {
+	switch lexer.previousToken {
+	case token.IDENT, token.LITERAL, token.HEX, token.RPAREN, token.RBRACKET, token.APOSTROPHE, token.NULL, token.TRUE, token.FALSE:
+		return false
+	default:
+		return true
+	}
+}
+
+// readWord - Return token made of characters up to the next delimiter, whitespaces are part of the word only
+// inside apostrophes, '+' and '-' end the word unless they are sign of exponent, ex. a-1 is read as a, - and 1
+func (lexer *Lexer) readWord() token.Token {
+	if lexer.insideApostrophes {
+		return lexer.processCharacters([]byte{'\''}, []byte{' ', '\n', '\t', '\r'})
+	}
+	return lexer.processCharacters([]byte{'\'', '(', ',', ';', '*', ')', '|', ':', '/', '<', '>', '[', ']', '+', '-'}, []byte{})
+}
+
 func (lexer *Lexer) skipWhitespace() {
 	for isWhitespace(lexer.character) && !lexer.insideApostrophes {
 		lexer.readChar()
@@ -71,6 +172,16 @@ func (lexer *Lexer) readChar() {
 	lexer.readPosition += 1
 }
 
+// isExponentSignAhead - Return true if next character is sign of exponent of number literal starting at position,
+// ex. - in 1.5e-10, so it doesn't end the word
+func (lexer *Lexer) isExponentSignAhead(position int) bool {
+	nextChar := lexer.getNextChar()
+	if lexer.insideApostrophes || (nextChar != '+' && nextChar != '-') || (lexer.character != 'e' && lexer.character != 'E') {
+		return false
+	}
+	return mantissaPattern.MatchString(lexer.input[position:lexer.position])
+}
+
 func (lexer *Lexer) getNextChar() byte {
 	if lexer.readPosition >= len(lexer.input) {
 		return 0
@@ -85,7 +196,7 @@ func (lexer *Lexer) processCharacters(blacklist []byte, whitelist []byte) token.
 	hasDigit := isDigit(lexer.character)
 	hasLetter := isLetter(lexer.character)
 
-	for validChar(nextChar, whitelist) && !bytes.ContainsAny(blacklist, string(nextChar)) {
+	for validChar(nextChar, whitelist) && (!bytes.ContainsAny(blacklist, string(nextChar)) || lexer.isExponentSignAhead(position)) {
 		lexer.readChar()
 		nextChar = lexer.getNextChar()
 
@@ -100,7 +211,13 @@ func (lexer *Lexer) processCharacters(blacklist []byte, whitelist []byte) token.
 func (lexer *Lexer) evaluateToken(position int, hasLetter bool, hasDigit bool) token.Token {
 	characters := lexer.input[position:lexer.position]
 
-	if (hasLetter && hasDigit) || lexer.insideApostrophes {
+	if lexer.insideApostrophes {
+		return newToken(token.IDENT, characters)
+	}
+	if hasDigit && numberPattern.MatchString(characters) {
+		return newToken(token.LITERAL, characters)
+	}
+	if hasLetter && hasDigit {
 		return newToken(token.IDENT, characters)
 	}
 	if hasLetter && !hasDigit {
@@ -113,6 +230,12 @@ func (lexer *Lexer) evaluateToken(position int, hasLetter bool, hasDigit bool) t
 	return newToken(token.ILLEGAL, characters)
 }
 
+// numberPattern - Matches numeric literals with optional sign, decimal point and exponent, ex. -1.5e10
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// mantissaPattern - Matches part of numeric literal before exponent, ex. -1.5 in -1.5e10
+var mantissaPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)$`)
+
 func validChar(nextChar byte, whitelist []byte) bool {
 	return '!' <= nextChar && nextChar <= '~' || bytes.ContainsAny(whitelist, string(nextChar))
 }
@@ -323,6 +323,54 @@ func TestDropStatement(t *testing.T) {
 	runLexerTestSuite(t, input, tests)
 }
 
+func TestIfExistsStatements(t *testing.T) {
+	input := `CREATE TABLE IF NOT EXISTS tbl( one INT ); DROP TABLE IF EXISTS tbl;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IF, "IF"},
+		{token.NOT, "NOT"},
+		{token.EXISTS, "EXISTS"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.DROP, "DROP"},
+		{token.TABLE, "TABLE"},
+		{token.IF, "IF"},
+		{token.EXISTS, "EXISTS"},
+		{token.IDENT, "tbl"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestTruncateStatement(t *testing.T) {
+	input := `TRUNCATE TABLE tbl, other CASCADE;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.TRUNCATE, "TRUNCATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.COMMA, ","},
+		{token.IDENT, "other"},
+		{token.CASCADE, "CASCADE"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
 func TestLimitAndOffsetStatement(t *testing.T) {
 	input := `LIMIT 5 OFFSET 6;`
 	tests := []struct {
@@ -555,6 +603,186 @@ func TestHandlingNullValues(t *testing.T) {
 	runLexerTestSuite(t, input, tests)
 }
 
+func TestScalarFunctions(t *testing.T) {
+	input := `SELECT UPPER(one), first||' | '||last FROM tbl WHERE SUBSTR(two, 1, 3) EQUAL 'abc';`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "UPPER"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.COMMA, ","},
+		{token.IDENT, "first"},
+		{token.CONCAT, "||"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, " | "},
+		{token.APOSTROPHE, "'"},
+		{token.CONCAT, "||"},
+		{token.IDENT, "last"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "SUBSTR"},
+		{token.LPAREN, "("},
+		{token.IDENT, "two"},
+		{token.COMMA, ","},
+		{token.LITERAL, "1"},
+		{token.COMMA, ","},
+		{token.LITERAL, "3"},
+		{token.RPAREN, ")"},
+		{token.EQUAL, "EQUAL"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "abc"},
+		{token.APOSTROPHE, "'"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestTypeConversion(t *testing.T) {
+	input := `SELECT CAST(one AS INT), two::TEXT FROM tbl WHERE three EQUAL 'a::b';`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.SELECT, "SELECT"},
+		{token.CAST, "CAST"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.AS, "AS"},
+		{token.INT, "INT"},
+		{token.RPAREN, ")"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.TYPECAST, "::"},
+		{token.TEXT, "TEXT"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "three"},
+		{token.EQUAL, "EQUAL"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "a::b"},
+		{token.APOSTROPHE, "'"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestNumericTypesAndArithmetic(t *testing.T) {
+	input := `CREATE TABLE tbl( one DECIMAL(10, 2), two FLOAT, three REAL );
+INSERT INTO tbl VALUES( 3.14, -2.5e-3, .5 );
+SELECT one + 1.5, two - three, one*2/ -4 FROM tbl WHERE one EQUAL '1.5+2';`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.DECIMAL, "DECIMAL"},
+		{token.LPAREN, "("},
+		{token.LITERAL, "10"},
+		{token.COMMA, ","},
+		{token.LITERAL, "2"},
+		{token.RPAREN, ")"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.FLOAT, "FLOAT"},
+		{token.COMMA, ","},
+		{token.IDENT, "three"},
+		{token.REAL, "REAL"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.INSERT, "INSERT"},
+		{token.INTO, "INTO"},
+		{token.IDENT, "tbl"},
+		{token.VALUES, "VALUES"},
+		{token.LPAREN, "("},
+		{token.LITERAL, "3.14"},
+		{token.COMMA, ","},
+		{token.LITERAL, "-2.5e-3"},
+		{token.COMMA, ","},
+		{token.LITERAL, ".5"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "one"},
+		{token.PLUS, "+"},
+		{token.LITERAL, "1.5"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.MINUS, "-"},
+		{token.IDENT, "three"},
+		{token.COMMA, ","},
+		{token.IDENT, "one"},
+		{token.ASTERISK, "*"},
+		{token.LITERAL, "2"},
+		{token.SLASH, "/"},
+		{token.LITERAL, "-4"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "one"},
+		{token.EQUAL, "EQUAL"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "1.5+2"},
+		{token.APOSTROPHE, "'"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestBooleanType(t *testing.T) {
+	input := `CREATE TABLE tbl( one BOOLEAN ); INSERT INTO tbl VALUES( TRUE ); SELECT BOOL_AND(one), BOOL_OR(one) FROM tbl WHERE one;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.BOOLEAN, "BOOLEAN"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.INSERT, "INSERT"},
+		{token.INTO, "INTO"},
+		{token.IDENT, "tbl"},
+		{token.VALUES, "VALUES"},
+		{token.LPAREN, "("},
+		{token.TRUE, "TRUE"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.BOOL_AND, "BOOL_AND"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.COMMA, ","},
+		{token.BOOL_OR, "BOOL_OR"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "one"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
 func runLexerTestSuite(t *testing.T, input string, tests []struct {
 	expectedType    token.Type
 	expectedLiteral string
@@ -575,3 +803,574 @@ func runLexerTestSuite(t *testing.T, input string, tests []struct {
 		}
 	}
 }
+
+func TestTemporalTypesAndComparisons(t *testing.T) {
+	input := `CREATE TABLE tbl( one DATE, two TIMESTAMP WITH TIME ZONE ); SELECT EXTRACT(year FROM one) FROM tbl WHERE one>=DATE '2024-01-31' AND two < '2024-01-31 10:00:00+02:00' OR one<=NOW() OR one>two;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.DATE, "DATE"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.TIMESTAMP, "TIMESTAMP"},
+		{token.WITH, "WITH"},
+		{token.TIME, "TIME"},
+		{token.ZONE, "ZONE"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.EXTRACT, "EXTRACT"},
+		{token.LPAREN, "("},
+		{token.IDENT, "year"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "one"},
+		{token.GTE, ">="},
+		{token.DATE, "DATE"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "2024-01-31"},
+		{token.APOSTROPHE, "'"},
+		{token.AND, "AND"},
+		{token.IDENT, "two"},
+		{token.LT, "<"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "2024-01-31 10:00:00+02:00"},
+		{token.APOSTROPHE, "'"},
+		{token.OR, "OR"},
+		{token.IDENT, "one"},
+		{token.LTE, "<="},
+		{token.IDENT, "NOW"},
+		{token.LPAREN, "("},
+		{token.RPAREN, ")"},
+		{token.OR, "OR"},
+		{token.IDENT, "one"},
+		{token.GT, ">"},
+		{token.IDENT, "two"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestIntegerTypesAndNegativeNumbers(t *testing.T) {
+	input := `CREATE TABLE tbl( one SMALLINT, two BIGINT );
+INSERT INTO tbl VALUES( -5, +3 );
+SELECT one -5, (two) +1, -two FROM tbl WHERE one EQUAL -5 OR 'a' EQUAL -1;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.SMALLINT, "SMALLINT"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.BIGINT, "BIGINT"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.INSERT, "INSERT"},
+		{token.INTO, "INTO"},
+		{token.IDENT, "tbl"},
+		{token.VALUES, "VALUES"},
+		{token.LPAREN, "("},
+		{token.LITERAL, "-5"},
+		{token.COMMA, ","},
+		{token.LITERAL, "+3"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "one"},
+		{token.MINUS, "-"},
+		{token.LITERAL, "5"},
+		{token.COMMA, ","},
+		{token.LPAREN, "("},
+		{token.IDENT, "two"},
+		{token.RPAREN, ")"},
+		{token.PLUS, "+"},
+		{token.LITERAL, "1"},
+		{token.COMMA, ","},
+		{token.MINUS, "-"},
+		{token.IDENT, "two"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "one"},
+		{token.EQUAL, "EQUAL"},
+		{token.LITERAL, "-5"},
+		{token.OR, "OR"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "a"},
+		{token.APOSTROPHE, "'"},
+		{token.EQUAL, "EQUAL"},
+		{token.LITERAL, "-1"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestArithmeticOperatorsWithoutSpaces(t *testing.T) {
+	input := `SELECT a+1, a-1, 1e-3-2E+2 FROM n WHERE a EQUAL 6-1;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "a"},
+		{token.PLUS, "+"},
+		{token.LITERAL, "1"},
+		{token.COMMA, ","},
+		{token.IDENT, "a"},
+		{token.MINUS, "-"},
+		{token.LITERAL, "1"},
+		{token.COMMA, ","},
+		{token.LITERAL, "1e-3"},
+		{token.MINUS, "-"},
+		{token.LITERAL, "2E+2"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "n"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "a"},
+		{token.EQUAL, "EQUAL"},
+		{token.LITERAL, "6"},
+		{token.MINUS, "-"},
+		{token.LITERAL, "1"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestBlobTypeAndHexLiterals(t *testing.T) {
+	input := `CREATE TABLE tbl( x BLOB, two BYTEA );
+INSERT INTO tbl VALUES( X'DEADBEEF', x'' );
+SELECT x, 'X' FROM tbl WHERE x EQUAL X'00ff' AND xyz IN (x'AB');
+SELECT X'ab`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "x"},
+		{token.BLOB, "BLOB"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.BLOB, "BYTEA"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.INSERT, "INSERT"},
+		{token.INTO, "INTO"},
+		{token.IDENT, "tbl"},
+		{token.VALUES, "VALUES"},
+		{token.LPAREN, "("},
+		{token.HEX, "DEADBEEF"},
+		{token.COMMA, ","},
+		{token.HEX, ""},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "x"},
+		{token.COMMA, ","},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "X"},
+		{token.APOSTROPHE, "'"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "x"},
+		{token.EQUAL, "EQUAL"},
+		{token.HEX, "00ff"},
+		{token.AND, "AND"},
+		{token.IDENT, "xyz"},
+		{token.IN, "IN"},
+		{token.LPAREN, "("},
+		{token.HEX, "AB"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.ILLEGAL, "ab"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestJsonTypeAndOperators(t *testing.T) {
+	input := `CREATE TABLE tbl( payload JSON );
+SELECT payload->'a'->>0, payload -> 'b' FROM tbl WHERE payload->>'c' > -1;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "payload"},
+		{token.JSON, "JSON"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "payload"},
+		{token.ARROW, "->"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "a"},
+		{token.APOSTROPHE, "'"},
+		{token.DOUBLE_ARROW, "->>"},
+		{token.LITERAL, "0"},
+		{token.COMMA, ","},
+		{token.IDENT, "payload"},
+		{token.ARROW, "->"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "b"},
+		{token.APOSTROPHE, "'"},
+		{token.FROM, "FROM"},
+		{token.IDENT, "tbl"},
+		{token.WHERE, "WHERE"},
+		{token.IDENT, "payload"},
+		{token.DOUBLE_ARROW, "->>"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "c"},
+		{token.APOSTROPHE, "'"},
+		{token.GT, ">"},
+		{token.LITERAL, "-1"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestArrayTypeAndOperators(t *testing.T) {
+	input := `CREATE TABLE tbl( tags TEXT[] );
+SELECT tags[1], ARRAY['a', -1] FROM UNNEST(tags) WHERE 'a' EQUAL ANY(tags) AND tags[-1] < ALL(tags);`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "tags"},
+		{token.TEXT, "TEXT"},
+		{token.LBRACKET, "["},
+		{token.RBRACKET, "]"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.SELECT, "SELECT"},
+		{token.IDENT, "tags"},
+		{token.LBRACKET, "["},
+		{token.LITERAL, "1"},
+		{token.RBRACKET, "]"},
+		{token.COMMA, ","},
+		{token.ARRAY, "ARRAY"},
+		{token.LBRACKET, "["},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "a"},
+		{token.APOSTROPHE, "'"},
+		{token.COMMA, ","},
+		{token.LITERAL, "-1"},
+		{token.RBRACKET, "]"},
+		{token.FROM, "FROM"},
+		{token.UNNEST, "UNNEST"},
+		{token.LPAREN, "("},
+		{token.IDENT, "tags"},
+		{token.RPAREN, ")"},
+		{token.WHERE, "WHERE"},
+		{token.APOSTROPHE, "'"},
+		{token.IDENT, "a"},
+		{token.APOSTROPHE, "'"},
+		{token.EQUAL, "EQUAL"},
+		{token.ANY, "ANY"},
+		{token.LPAREN, "("},
+		{token.IDENT, "tags"},
+		{token.RPAREN, ")"},
+		{token.AND, "AND"},
+		{token.IDENT, "tags"},
+		{token.LBRACKET, "["},
+		{token.LITERAL, "-1"},
+		{token.RBRACKET, "]"},
+		{token.LT, "<"},
+		{token.ALL, "ALL"},
+		{token.LPAREN, "("},
+		{token.IDENT, "tags"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestPrimaryKey(t *testing.T) {
+	input := `CREATE TABLE tbl( one INT PRIMARY KEY, two TEXT, PRIMARY KEY (one, two) );`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.PRIMARY, "PRIMARY"},
+		{token.KEY, "KEY"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.TEXT, "TEXT"},
+		{token.COMMA, ","},
+		{token.PRIMARY, "PRIMARY"},
+		{token.KEY, "KEY"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.RPAREN, ")"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestColumnConstraints(t *testing.T) {
+	input := `CREATE TABLE tbl( one INT NOT NULL DEFAULT -1, CONSTRAINT positive CHECK (one > 0) );
+INSERT INTO tbl (one) VALUES(DEFAULT);`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.NOT, "NOT"},
+		{token.NULL, "NULL"},
+		{token.DEFAULT, "DEFAULT"},
+		{token.LITERAL, "-1"},
+		{token.COMMA, ","},
+		{token.CONSTRAINT, "CONSTRAINT"},
+		{token.IDENT, "positive"},
+		{token.CHECK, "CHECK"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.GT, ">"},
+		{token.LITERAL, "0"},
+		{token.RPAREN, ")"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.INSERT, "INSERT"},
+		{token.INTO, "INTO"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.VALUES, "VALUES"},
+		{token.LPAREN, "("},
+		{token.DEFAULT, "DEFAULT"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestUniqueConstraint(t *testing.T) {
+	input := `CREATE TABLE tbl( one INT UNIQUE NULLS NOT DISTINCT );`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.UNIQUE, "UNIQUE"},
+		{token.NULLS, "NULLS"},
+		{token.NOT, "NOT"},
+		{token.DISTINCT, "DISTINCT"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestForeignKey(t *testing.T) {
+	input := `CREATE TABLE tbl( one INT, FOREIGN KEY (one) REFERENCES other ON DELETE CASCADE ON UPDATE RESTRICT );
+DROP TABLE other CASCADE;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.COMMA, ","},
+		{token.FOREIGN, "FOREIGN"},
+		{token.KEY, "KEY"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.REFERENCES, "REFERENCES"},
+		{token.IDENT, "other"},
+		{token.ON, "ON"},
+		{token.DELETE, "DELETE"},
+		{token.CASCADE, "CASCADE"},
+		{token.ON, "ON"},
+		{token.UPDATE, "UPDATE"},
+		{token.RESTRICT, "RESTRICT"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.DROP, "DROP"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "other"},
+		{token.CASCADE, "CASCADE"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestSequenceAndIdentity(t *testing.T) {
+	input := `CREATE SEQUENCE ids INCREMENT BY 2 MINVALUE 1 MAXVALUE 9 START WITH 3 NO CYCLE;
+CREATE TABLE tbl( one SERIAL, two INT GENERATED ALWAYS AS IDENTITY );`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.SEQUENCE, "SEQUENCE"},
+		{token.IDENT, "ids"},
+		{token.INCREMENT, "INCREMENT"},
+		{token.BY, "BY"},
+		{token.LITERAL, "2"},
+		{token.MINVALUE, "MINVALUE"},
+		{token.LITERAL, "1"},
+		{token.MAXVALUE, "MAXVALUE"},
+		{token.LITERAL, "9"},
+		{token.START, "START"},
+		{token.WITH, "WITH"},
+		{token.LITERAL, "3"},
+		{token.NO, "NO"},
+		{token.CYCLE, "CYCLE"},
+		{token.SEMICOLON, ";"},
+		{token.CREATE, "CREATE"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.SERIAL, "SERIAL"},
+		{token.COMMA, ","},
+		{token.IDENT, "two"},
+		{token.INT, "INT"},
+		{token.GENERATED, "GENERATED"},
+		{token.ALWAYS, "ALWAYS"},
+		{token.AS, "AS"},
+		{token.IDENTITY, "IDENTITY"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestCreateAndDropIndex(t *testing.T) {
+	input := `CREATE INDEX idx ON tbl USING HASH (one);
+CREATE INDEX ON tbl USING BTREE (two, three) INCLUDE (four);
+DROP INDEX idx;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.CREATE, "CREATE"},
+		{token.INDEX, "INDEX"},
+		{token.IDENT, "idx"},
+		{token.ON, "ON"},
+		{token.IDENT, "tbl"},
+		{token.USING, "USING"},
+		{token.HASH, "HASH"},
+		{token.LPAREN, "("},
+		{token.IDENT, "one"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.CREATE, "CREATE"},
+		{token.INDEX, "INDEX"},
+		{token.ON, "ON"},
+		{token.IDENT, "tbl"},
+		{token.USING, "USING"},
+		{token.BTREE, "BTREE"},
+		{token.LPAREN, "("},
+		{token.IDENT, "two"},
+		{token.COMMA, ","},
+		{token.IDENT, "three"},
+		{token.RPAREN, ")"},
+		{token.INCLUDE, "INCLUDE"},
+		{token.LPAREN, "("},
+		{token.IDENT, "four"},
+		{token.RPAREN, ")"},
+		{token.SEMICOLON, ";"},
+		{token.DROP, "DROP"},
+		{token.INDEX, "INDEX"},
+		{token.IDENT, "idx"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
+
+func TestAlterTable(t *testing.T) {
+	input := `ALTER TABLE tbl ADD COLUMN one INT;
+ALTER TABLE tbl RENAME one TO two;`
+	tests := []struct {
+		expectedType    token.Type
+		expectedLiteral string
+	}{
+		{token.ALTER, "ALTER"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.ADD, "ADD"},
+		{token.COLUMN, "COLUMN"},
+		{token.IDENT, "one"},
+		{token.INT, "INT"},
+		{token.SEMICOLON, ";"},
+		{token.ALTER, "ALTER"},
+		{token.TABLE, "TABLE"},
+		{token.IDENT, "tbl"},
+		{token.RENAME, "RENAME"},
+		{token.IDENT, "one"},
+		{token.TO, "TO"},
+		{token.IDENT, "two"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, ""},
+	}
+
+	runLexerTestSuite(t, input, tests)
+}
@@ -13,9 +13,11 @@ func main() {
 	streamMode := flag.Bool("stream", false, "Use to redirect stdin to stdout")
 	socketMode := flag.Bool("socket", false, "Use to start socket server")
 	port := flag.Int("port", 1433, "States on which port socket server will listen")
+	standardNulls := flag.Bool("standard-nulls", false, "Use SQL three-valued logic while comparing NULL values")
 
 	flag.Parse()
 	engineSQL := engine.New()
+	engineSQL.StandardNullSemantics = *standardNulls
 	var err error
 
 	if len(*filePath) > 0 {
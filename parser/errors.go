@@ -125,3 +125,33 @@ type NoApostropheOnLeftParserError struct {
 func (m *NoApostropheOnLeftParserError) Error() string {
 	return "syntax error, Identifier: {" + m.ident + "} has no apostrophe on left"
 }
+
+// InvalidTypeParameterParserError - error thrown when column type has parameter out of allowed range,
+// ex. DECIMAL with scale greater than precision
+type InvalidTypeParameterParserError struct {
+	columnType string
+	parameter  string
+}
+
+func (m *InvalidTypeParameterParserError) Error() string {
+	return "invalid parameter of " + m.columnType + " type: {" + m.parameter + "}"
+}
+
+// InvalidSequenceOptionParserError - error thrown when option of CREATE SEQUENCE isn't integer
+type InvalidSequenceOptionParserError struct {
+	option string
+	value  string
+}
+
+func (m *InvalidSequenceOptionParserError) Error() string {
+	return "invalid value of sequence option " + m.option + ": {" + m.value + "}"
+}
+
+// MultiplePrimaryKeysParserError - error thrown when primary key is declared more than once in CREATE TABLE
+type MultiplePrimaryKeysParserError struct {
+	tableName string
+}
+
+func (m *MultiplePrimaryKeysParserError) Error() string {
+	return "syntax error, multiple primary keys for table {" + m.tableName + "} are not allowed"
+}
@@ -67,7 +67,9 @@ func validateToken(tokenType token.Type, expectedTokens []token.Type) error {
 // parseCreateCommand - Return ast.CreateCommand created from tokens and validate the syntax
 //
 // Example of input parsable to the ast.CreateCommand:
-// create table tbl( one TEXT , two INT );
+// create table tbl( one TEXT NOT NULL DEFAULT 'a', two INT PRIMARY KEY CHECK (two > 0) );
+// create table tbl( one TEXT , two INT, PRIMARY KEY (one, two), CONSTRAINT positive CHECK (two > 0) );
+// create table if not exists tbl( one TEXT );
 func (parser *Parser) parseCreateCommand() (ast.Command, error) {
 	// token.CREATE already at current position in parser
 	createCommand := &ast.CreateCommand{Token: parser.currentToken}
@@ -75,11 +77,35 @@ func (parser *Parser) parseCreateCommand() (ast.Command, error) {
 	// Skip token.CREATE
 	parser.nextToken()
 
-	err := validateTokenAndSkip(parser, []token.Type{token.TABLE})
+	if parser.currentToken.Type == token.TYPE {
+		return parser.parseCreateTypeCommand(createCommand.Token)
+	}
+	if parser.currentToken.Type == token.SEQUENCE {
+		return parser.parseCreateSequenceCommand(createCommand.Token)
+	}
+	if parser.currentToken.Type == token.INDEX {
+		return parser.parseCreateIndexCommand(createCommand.Token)
+	}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.TABLE, token.TYPE, token.SEQUENCE, token.INDEX})
 	if err != nil {
 		return nil, err
 	}
 
+	if parser.currentToken.Type == token.IF {
+		// Skip token.IF
+		parser.nextToken()
+		err = validateTokenAndSkip(parser, []token.Type{token.NOT})
+		if err != nil {
+			return nil, err
+		}
+		err = validateTokenAndSkip(parser, []token.Type{token.EXISTS})
+		if err != nil {
+			return nil, err
+		}
+		createCommand.IfNotExists = true
+	}
+
 	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 	if err != nil {
 		return nil, err
@@ -99,24 +125,25 @@ func (parser *Parser) parseCreateCommand() (ast.Command, error) {
 	}
 
 	// Begin of inside Paren
-	for parser.currentToken.Type == token.IDENT {
-		err = validateToken(parser.peekToken.Type, []token.Type{token.TEXT, token.INT})
-		if err != nil {
-			return nil, err
+	for parser.currentToken.Type == token.IDENT || isTableConstraint(parser.currentToken.Type) {
+		if isTableConstraint(parser.currentToken.Type) {
+			err = parser.getTableConstraint(createCommand)
+			if err != nil {
+				return nil, err
+			}
+			if parser.currentToken.Type != token.COMMA {
+				break
+			}
+			// Skip token.COMMA
+			parser.nextToken()
+			continue
 		}
 
-		if strings.Contains(parser.currentToken.Literal, ".") {
-			return nil, &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
+		err = parser.getColumnDefinition(createCommand)
+		if err != nil {
+			return nil, err
 		}
 
-		createCommand.ColumnNames = append(createCommand.ColumnNames, parser.currentToken.Literal)
-		createCommand.ColumnTypes = append(createCommand.ColumnTypes, parser.peekToken)
-
-		// Skip token.IDENT
-		parser.nextToken()
-		// Skip token.TEXT or token.INT
-		parser.nextToken()
-
 		if parser.currentToken.Type != token.COMMA {
 			break
 		}
@@ -138,647 +165,2137 @@ func (parser *Parser) parseCreateCommand() (ast.Command, error) {
 	return createCommand, nil
 }
 
-func (parser *Parser) skipIfCurrentTokenIsApostrophe() bool {
-	if parser.currentToken.Type == token.APOSTROPHE {
-		parser.nextToken()
-		return true
+// getColumnDefinition - Add column with its type and constraints to the command, ex. one DECIMAL(10, 2) NOT NULL
+func (parser *Parser) getColumnDefinition(createCommand *ast.CreateCommand) error {
+	err := validateToken(parser.peekToken.Type, columnTypes)
+	if err != nil {
+		return err
 	}
-	return false
-}
 
-func (parser *Parser) skipIfCurrentTokenIsSemicolon() {
-	if parser.currentToken.Type == token.SEMICOLON {
-		parser.nextToken()
+	if strings.Contains(parser.currentToken.Literal, ".") {
+		return &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
 	}
-}
-
-// parseInsertCommand - Return ast.InsertCommand created from tokens and validate the syntax
-//
-// Example of input parsable to the ast.InsertCommand:
-// insert into tbl values( 'hello',	 10 );
-func (parser *Parser) parseInsertCommand() (ast.Command, error) {
-	// token.INSERT already at current position in parser
-	insertCommand := &ast.InsertCommand{Token: parser.currentToken}
+	createCommand.ColumnNames = append(createCommand.ColumnNames, parser.currentToken.Literal)
 
-	// Ignore token.INSERT
+	// Skip token.IDENT
 	parser.nextToken()
 
-	err := validateTokenAndSkip(parser, []token.Type{token.INTO})
+	columnType, typeParameters, isArray, err := parser.getColumnType()
 	if err != nil {
-		return nil, err
+		return err
 	}
+	createCommand.ColumnTypes = append(createCommand.ColumnTypes, columnType)
+	createCommand.ColumnTypeParameters = append(createCommand.ColumnTypeParameters, typeParameters)
+	createCommand.ColumnIsArray = append(createCommand.ColumnIsArray, isArray)
 
-	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	return parser.getColumnConstraints(createCommand)
+}
+
+// columnTypes - Tokens which can be used as type of the column, token.IDENT is name of ENUM type
+var columnTypes = []token.Type{token.TEXT, token.VARCHAR, token.CHAR, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT,
+	token.REAL, token.DECIMAL, token.BOOLEAN, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.BLOB,
+	token.JSON, token.UUID, token.SERIAL, token.IDENT}
+
+// getColumnType - Return type of the column with its optional parameters and true if it's an array type, ex.
+// TIMESTAMP WITH TIME ZONE, DECIMAL(10, 2) or TEXT[]
+func (parser *Parser) getColumnType() (token.Token, []int, bool, error) {
+	err := validateToken(parser.currentToken.Type, columnTypes)
 	if err != nil {
-		return nil, err
+		return token.Token{}, nil, false, err
 	}
-	insertCommand.Name = ast.Identifier{Token: parser.currentToken}
-	// Ignore token.INDENT
+	columnType := parser.currentToken
+
+	// Skip column type
 	parser.nextToken()
 
-	err = validateTokenAndSkip(parser, []token.Type{token.VALUES})
+	columnType, err = parser.getTimeZoneType(columnType)
 	if err != nil {
-		return nil, err
+		return token.Token{}, nil, false, err
 	}
-	err = validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	typeParameters, err := parser.getTypeParameters(columnType)
+	if err != nil {
+		return token.Token{}, nil, false, err
+	}
+	isArray, err := parser.skipArrayTypeSuffix()
+	if err != nil {
+		return token.Token{}, nil, false, err
+	}
+	return columnType, typeParameters, isArray, nil
+}
+
+// parseCreateSequenceCommand - Return ast.CreateSequenceCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.CreateSequenceCommand:
+// CREATE SEQUENCE ids INCREMENT BY 10 MINVALUE 1 NO MAXVALUE START WITH 100 CYCLE;
+func (parser *Parser) parseCreateSequenceCommand(createToken token.Token) (ast.Command, error) {
+	// token.SEQUENCE already at current position in parser
+	createSequenceCommand := &ast.CreateSequenceCommand{Token: createToken}
+
+	// Skip token.SEQUENCE
+	parser.nextToken()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 	if err != nil {
 		return nil, err
 	}
+	if strings.Contains(parser.currentToken.Literal, ".") {
+		return nil, &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
+	}
+	createSequenceCommand.Name = ast.Identifier{Token: parser.currentToken}
 
-	for parser.currentToken.Type == token.IDENT || parser.currentToken.Type == token.LITERAL || parser.currentToken.Type == token.NULL || parser.currentToken.Type == token.APOSTROPHE {
-		startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+	// Skip token.IDENT
+	parser.nextToken()
 
-		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.LITERAL, token.NULL})
+	for parser.currentToken.Type != token.SEMICOLON {
+		err = validateToken(parser.currentToken.Type, []token.Type{token.INCREMENT, token.MINVALUE, token.MAXVALUE, token.START,
+			token.CYCLE, token.NO, token.SEMICOLON})
 		if err != nil {
 			return nil, err
 		}
-		value := parser.currentToken
-		insertCommand.Values = append(insertCommand.Values, value)
-		// Ignore token.IDENT, token.LITERAL or token.NULL
+		option := parser.currentToken.Type
+		// Skip option keyword
 		parser.nextToken()
 
-		finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
-
-		err = validateApostropheWrapping(startedWithApostrophe, finishedWithApostrophe, value)
-
+		switch option {
+		case token.INCREMENT:
+			createSequenceCommand.Increment, err = parser.getSequenceOptionValue(option, token.BY)
+		case token.MINVALUE:
+			createSequenceCommand.MinValue, err = parser.getSequenceOptionValue(option, "")
+		case token.MAXVALUE:
+			createSequenceCommand.MaxValue, err = parser.getSequenceOptionValue(option, "")
+		case token.START:
+			createSequenceCommand.Start, err = parser.getSequenceOptionValue(option, token.WITH)
+		case token.CYCLE:
+			createSequenceCommand.Cycle = true
+		default:
+			// NO MINVALUE and NO MAXVALUE set default limits, NO CYCLE is default behaviour
+			err = validateToken(parser.currentToken.Type, []token.Type{token.MINVALUE, token.MAXVALUE, token.CYCLE})
+			if err != nil {
+				return nil, err
+			}
+			switch parser.currentToken.Type {
+			case token.MINVALUE:
+				createSequenceCommand.MinValue = nil
+			case token.MAXVALUE:
+				createSequenceCommand.MaxValue = nil
+			default:
+				createSequenceCommand.Cycle = false
+			}
+			// Skip token.MINVALUE, token.MAXVALUE or token.CYCLE
+			parser.nextToken()
+		}
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		if parser.currentToken.Type != token.COMMA {
-			break
-		}
+	// Skip token.SEMICOLON
+	parser.nextToken()
 
-		// Ignore token.COMMA
+	return createSequenceCommand, nil
+}
+
+// getSequenceOptionValue - Return integer written after option of sequence, optional keyword can be written before
+// the number, ex. BY in INCREMENT BY 10
+func (parser *Parser) getSequenceOptionValue(option token.Type, optionalKeyword token.Type) (*int64, error) {
+	if optionalKeyword != "" && parser.currentToken.Type == optionalKeyword {
+		// Skip optional keyword
 		parser.nextToken()
 	}
 
-	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
 	if err != nil {
 		return nil, err
 	}
-
-	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	value, err := strconv.ParseInt(parser.currentToken.Literal, 10, 64)
 	if err != nil {
-		return nil, err
+		return nil, &InvalidSequenceOptionParserError{option: string(option), value: parser.currentToken.Literal}
 	}
+	// Skip token.LITERAL
+	parser.nextToken()
 
-	return insertCommand, nil
-}
-
-func validateApostropheWrapping(startedWithApostrophe bool, finishedWithApostrophe bool, value token.Token) error {
-	if startedWithApostrophe && !finishedWithApostrophe {
-		return &NoApostropheOnRightParserError{ident: value.Literal}
-	} else if !startedWithApostrophe && finishedWithApostrophe {
-		return &NoApostropheOnLeftParserError{ident: value.Literal}
-	}
-	return nil
+	return &value, nil
 }
 
-// parseSelectCommand - Return ast.SelectCommand created from tokens and validate the syntax
+// parseCreateIndexCommand - Return ast.CreateIndexCommand created from tokens and validate the syntax
 //
-// Example of input parsable to the ast.SelectCommand:
-// SELECT col1, col2, col3 FROM tbl;
-func (parser *Parser) parseSelectCommand() (ast.Command, error) {
-	// token.SELECT already at current position in parser
-	selectCommand := &ast.SelectCommand{Token: parser.currentToken}
-
-	// Ignore token.SELECT
+// CREATE INDEX idx ON tbl USING HASH (one);
+// CREATE INDEX ON tbl (one, two) INCLUDE (three);
+// CREATE INDEX idx ON tbl USING HASH (one);
+func (parser *Parser) parseCreateIndexCommand(createToken token.Token) (ast.Command, error) {
+	// token.INDEX already at current position in parser
+	createIndexCommand := &ast.CreateIndexCommand{Token: createToken, Method: ast.BTreeIndex}
+
+	// Skip token.INDEX
 	parser.nextToken()
 
-	// optional DISTINCT
-	if parser.currentToken.Type == token.DISTINCT {
-		selectCommand.HasDistinct = true
-
-		// Ignore token.DISTINCT
-		parser.nextToken()
-	}
-
-	err := validateToken(parser.currentToken.Type, []token.Type{token.ASTERISK, token.IDENT, token.MAX, token.MIN, token.SUM, token.AVG, token.COUNT})
-	if err != nil {
-		return nil, err
-	}
-
-	if parser.currentToken.Type == token.ASTERISK {
-		selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken})
-		parser.nextToken()
-	} else {
-		for parser.currentToken.Type == token.IDENT || isAggregateFunction(parser.currentToken.Type) {
-			if parser.currentToken.Type != token.IDENT {
-				aggregateFunction := parser.currentToken
-				parser.nextToken()
-				err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
-				if err != nil {
-					return nil, err
-				}
-				if aggregateFunction.Type == token.COUNT {
-					err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.ASTERISK})
-				} else {
-					err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
-				}
-				if err != nil {
-					return nil, err
-				}
-				selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken, AggregateFunc: &aggregateFunction})
-				parser.nextToken()
-
-				err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				// Get column name
-				err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
-				if err != nil {
-					return nil, err
-				}
-				selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken})
-				parser.nextToken()
-			}
-
-			if parser.currentToken.Type != token.COMMA {
-				break
-			}
-			// Ignore token.COMMA
-			parser.nextToken()
+	if parser.currentToken.Type == token.IDENT {
+		if strings.Contains(parser.currentToken.Literal, ".") {
+			return nil, &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
 		}
+		createIndexCommand.Name = &ast.Identifier{Token: parser.currentToken}
+		// Skip token.IDENT
+		parser.nextToken()
 	}
 
-	err = validateTokenAndSkip(parser, []token.Type{token.FROM})
+	err := validateTokenAndSkip(parser, []token.Type{token.ON})
 	if err != nil {
 		return nil, err
 	}
-
 	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 	if err != nil {
 		return nil, err
 	}
-
-	selectCommand.Name = ast.Identifier{Token: parser.currentToken}
-	// Ignore token.IDENT
+	createIndexCommand.TableName = ast.Identifier{Token: parser.currentToken}
+	// Skip token.IDENT
 	parser.nextToken()
 
-	// expect SEMICOLON or other keywords expected in SELECT statement
-	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.WHERE, token.ORDER, token.LIMIT, token.OFFSET, token.JOIN, token.LEFT, token.RIGHT, token.INNER, token.FULL})
+	if parser.currentToken.Type == token.USING {
+		// Skip token.USING
+		parser.nextToken()
+		err = validateToken(parser.currentToken.Type, []token.Type{token.BTREE, token.HASH})
+		if err != nil {
+			return nil, err
+		}
+		createIndexCommand.Method = ast.IndexMethod(parser.currentToken.Type)
+		// Skip token.BTREE or token.HASH
+		parser.nextToken()
+	}
+
+	createIndexCommand.ColumnNames, err = parser.getColumnNameList()
 	if err != nil {
 		return nil, err
 	}
 
-	if parser.currentToken.Type == token.SEMICOLON {
+	if parser.currentToken.Type == token.INCLUDE {
+		// Skip token.INCLUDE
 		parser.nextToken()
+		createIndexCommand.IncludedColumnNames, err = parser.getColumnNameList()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return selectCommand, nil
-}
-
-func (parser *Parser) getColumnName(err error, selectCommand *ast.SelectCommand, aggregateFunction token.Token) error {
-	// Get column name
-	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.ASTERISK})
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken, AggregateFunc: &aggregateFunction})
-	parser.nextToken()
-	return nil
-}
 
-func isAggregateFunction(t token.Type) bool {
-	return t == token.MIN || t == token.MAX || t == token.COUNT || t == token.SUM || t == token.AVG
+	return createIndexCommand, nil
 }
 
-// parseWhereCommand - Return ast.WhereCommand created from tokens and validate the syntax
+// parseCreateTypeCommand - Return ast.CreateTypeCommand created from tokens and validate the syntax
 //
-// Example of input parsable to the ast.WhereCommand:
-// WHERE colName EQUAL 'potato'
-func (parser *Parser) parseWhereCommand() (ast.Command, error) {
-	// token.WHERE already at current position in parser
-	whereCommand := &ast.WhereCommand{Token: parser.currentToken}
-	expressionIsValid := false
+// Example of input parsable to the ast.CreateTypeCommand:
+// create type status as enum ( 'new', 'active', 'closed' );
+func (parser *Parser) parseCreateTypeCommand(createToken token.Token) (ast.Command, error) {
+	// token.TYPE already at current position in parser
+	createTypeCommand := &ast.CreateTypeCommand{Token: createToken}
 
-	// Ignore token.WHERE
+	// Skip token.TYPE
 	parser.nextToken()
-	var err error
-	expressionIsValid, whereCommand.Expression, err = parser.getExpression()
-	if err != nil {
-		return nil, err
-	}
-
-	if !expressionIsValid {
-		return nil, &LogicalExpressionParsingError{}
-	}
 
-	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.ORDER})
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 	if err != nil {
 		return nil, err
 	}
+	if strings.Contains(parser.currentToken.Literal, ".") {
+		return nil, &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
+	}
+	createTypeCommand.Name = ast.Identifier{Token: parser.currentToken}
 
-	parser.skipIfCurrentTokenIsSemicolon()
-
-	return whereCommand, nil
-}
-
-// parseDeleteCommand - Return ast.DeleteCommand created from tokens and validate the syntax
-//
-// Example of input parsable to the ast.DeleteCommand:
-// DELETE FROM table;
-func (parser *Parser) parseDeleteCommand() (ast.Command, error) {
-	// token.DELETE already at current position in parser
-	deleteCommand := &ast.DeleteCommand{Token: parser.currentToken}
-
-	// token.DELETE no longer needed
+	// Skip token.IDENT
 	parser.nextToken()
 
-	err := validateTokenAndSkip(parser, []token.Type{token.FROM})
+	err = validateTokenAndSkip(parser, []token.Type{token.AS})
 	if err != nil {
 		return nil, err
 	}
-
-	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	err = validateTokenAndSkip(parser, []token.Type{token.ENUM})
 	if err != nil {
 		return nil, err
 	}
-	deleteCommand.Name = ast.Identifier{Token: parser.currentToken}
+	err = validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err = validateTokenAndSkip(parser, []token.Type{token.APOSTROPHE})
+		if err != nil {
+			return nil, err
+		}
+		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+		createTypeCommand.Labels = append(createTypeCommand.Labels, parser.currentToken.Literal)
+		// Skip token.IDENT
+		parser.nextToken()
+
+		err = validateTokenAndSkip(parser, []token.Type{token.APOSTROPHE})
+		if err != nil {
+			return nil, err
+		}
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Skip token.COMMA
+		parser.nextToken()
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
+	}
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	if err != nil {
+		return nil, err
+	}
+
+	return createTypeCommand, nil
+}
+
+// maxDecimalPrecision - Maximal precision of DECIMAL type, the same as in PostgreSQL
+const maxDecimalPrecision = 1000
+
+// getTypeParameters - Return optional parameters of column type written in parentheses, ex. DECIMAL(10, 2) or
+// VARCHAR(255)
+func (parser *Parser) getTypeParameters(columnType token.Token) ([]int, error) {
+	maxParameters := 0
+	switch columnType.Type {
+	case token.DECIMAL:
+		maxParameters = 2
+	case token.VARCHAR, token.CHAR:
+		maxParameters = 1
+	}
+	if maxParameters == 0 || parser.currentToken.Type != token.LPAREN {
+		return nil, nil
+	}
+
+	// Skip token.LPAREN
+	parser.nextToken()
+
+	parameters := make([]int, 0, maxParameters)
+	for len(parameters) < maxParameters {
+		err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
+		if err != nil {
+			return nil, err
+		}
+		parameter, err := strconv.Atoi(parser.currentToken.Literal)
+		if err != nil {
+			return nil, &InvalidTypeParameterParserError{columnType: columnType.Literal, parameter: parser.currentToken.Literal}
+		}
+		parameters = append(parameters, parameter)
+
+		// Skip token.LITERAL
+		parser.nextToken()
+
+		if parser.currentToken.Type != token.COMMA || len(parameters) == maxParameters {
+			break
+		}
+		// Skip token.COMMA
+		parser.nextToken()
+	}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
+	}
+
+	// precision and length have to be positive, precision can't exceed maxDecimalPrecision and scale can't be
+	// greater than precision
+	precision := parameters[0]
+	if precision < 1 || (columnType.Type == token.DECIMAL && precision > maxDecimalPrecision) {
+		return nil, &InvalidTypeParameterParserError{columnType: columnType.Literal, parameter: strconv.Itoa(precision)}
+	}
+	if len(parameters) == 2 && (parameters[1] < 0 || parameters[1] > precision) {
+		return nil, &InvalidTypeParameterParserError{columnType: columnType.Literal, parameter: strconv.Itoa(parameters[1])}
+	}
+	return parameters, nil
+}
+
+// getTimeZoneType - Return token.TIMESTAMPTZ if token.TIMESTAMP is followed by WITH TIME ZONE, otherwise type is
+// returned unchanged
+func (parser *Parser) getTimeZoneType(columnType token.Token) (token.Token, error) {
+	if columnType.Type != token.TIMESTAMP || parser.currentToken.Type != token.WITH {
+		return columnType, nil
+	}
+
+	// Skip token.WITH
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.TIME})
+	if err != nil {
+		return token.Token{}, err
+	}
+	err = validateTokenAndSkip(parser, []token.Type{token.ZONE})
+	if err != nil {
+		return token.Token{}, err
+	}
+	return token.Token{Type: token.TIMESTAMPTZ, Literal: token.TIMESTAMPTZ}, nil
+}
+
+// getColumnConstraints - Set constraints written after type of the last column, ex. one INT NOT NULL DEFAULT 1
+func (parser *Parser) getColumnConstraints(createCommand *ast.CreateCommand) error {
+	columnName := createCommand.ColumnNames[len(createCommand.ColumnNames)-1]
+	createCommand.ColumnNotNull = append(createCommand.ColumnNotNull, false)
+	createCommand.ColumnDefaults = append(createCommand.ColumnDefaults, nil)
+	createCommand.ColumnIdentities = append(createCommand.ColumnIdentities, ast.NoIdentity)
+	columnIndex := len(createCommand.ColumnNames) - 1
+
+	for {
+		var err error
+		switch parser.currentToken.Type {
+		case token.PRIMARY:
+			err = parser.skipPrimaryKeyKeywords(createCommand)
+			createCommand.PrimaryKey = []string{columnName}
+		case token.NOT:
+			// Skip token.NOT
+			parser.nextToken()
+			err = validateTokenAndSkip(parser, []token.Type{token.NULL})
+			createCommand.ColumnNotNull[columnIndex] = true
+		case token.DEFAULT:
+			// Skip token.DEFAULT
+			parser.nextToken()
+			createCommand.ColumnDefaults[columnIndex], err = parser.getTifierWithoutTrailingApostrophe()
+		case token.GENERATED:
+			createCommand.ColumnIdentities[columnIndex], err = parser.getIdentity()
+		case token.CHECK, token.CONSTRAINT, token.UNIQUE, token.REFERENCES:
+			err = parser.getNamedConstraint(createCommand, columnName)
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// getIdentity - Return kind of identity column, ex. GENERATED ALWAYS AS IDENTITY or GENERATED BY DEFAULT AS IDENTITY
+func (parser *Parser) getIdentity() (ast.Identity, error) {
+	// Skip token.GENERATED
+	parser.nextToken()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.ALWAYS, token.BY})
+	if err != nil {
+		return ast.NoIdentity, err
+	}
+	identity := ast.IdentityAlways
+	if parser.currentToken.Type == token.BY {
+		identity = ast.IdentityByDefault
+		// Skip token.BY
+		parser.nextToken()
+		err = validateToken(parser.currentToken.Type, []token.Type{token.DEFAULT})
+		if err != nil {
+			return ast.NoIdentity, err
+		}
+	}
+	// Skip token.ALWAYS or token.DEFAULT
+	parser.nextToken()
+
+	err = validateTokenAndSkip(parser, []token.Type{token.AS})
+	if err != nil {
+		return ast.NoIdentity, err
+	}
+	return identity, validateTokenAndSkip(parser, []token.Type{token.IDENTITY})
+}
+
+// isTableConstraint - Return true if token starts constraint written after columns of the table
+func isTableConstraint(t token.Type) bool {
+	return t == token.PRIMARY || t == token.CHECK || t == token.UNIQUE || t == token.FOREIGN || t == token.CONSTRAINT
+}
+
+// getTableConstraint - Set constraint written after columns of the table, ex. PRIMARY KEY (one, two),
+// UNIQUE (one, two), FOREIGN KEY (one) REFERENCES other (id) or CHECK (one < two)
+func (parser *Parser) getTableConstraint(createCommand *ast.CreateCommand) error {
+	if parser.currentToken.Type == token.PRIMARY {
+		return parser.getTablePrimaryKey(createCommand)
+	}
+	return parser.getNamedConstraint(createCommand, "")
+}
+
+// getNamedConstraint - Set CHECK, UNIQUE or FOREIGN KEY constraint with optional name, ex. CONSTRAINT positive
+// CHECK (one > 0), columnName is empty for constraints written after columns of the table
+func (parser *Parser) getNamedConstraint(createCommand *ast.CreateCommand, columnName string) error {
+	name := ""
+	if parser.currentToken.Type == token.CONSTRAINT {
+		// Skip token.CONSTRAINT
+		parser.nextToken()
+
+		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return err
+		}
+		name = parser.currentToken.Literal
+		// Skip token.IDENT
+		parser.nextToken()
+	}
+
+	// Foreign key written after the column starts with REFERENCES, after all columns it starts with FOREIGN KEY
+	foreignKeyStart := token.Type(token.FOREIGN)
+	if columnName != "" {
+		foreignKeyStart = token.REFERENCES
+	}
+	err := validateToken(parser.currentToken.Type, []token.Type{token.CHECK, token.UNIQUE, foreignKeyStart})
+	if err != nil {
+		return err
+	}
+
+	if parser.currentToken.Type == foreignKeyStart {
+		foreignKey, err := parser.getForeignKeyConstraint(columnName)
+		if err != nil {
+			return err
+		}
+		foreignKey.Name = name
+		createCommand.ForeignKeys = append(createCommand.ForeignKeys, foreignKey)
+		return nil
+	}
+
+	if parser.currentToken.Type == token.UNIQUE {
+		unique, err := parser.getUniqueConstraint(columnName)
+		if err != nil {
+			return err
+		}
+		unique.Name = name
+		createCommand.UniqueConstraints = append(createCommand.UniqueConstraints, unique)
+		return nil
+	}
+
+	check, err := parser.getCheckConstraint()
+	if err != nil {
+		return err
+	}
+	check.Name = name
+	check.ColumnName = columnName
+	createCommand.Checks = append(createCommand.Checks, check)
+	return nil
+}
+
+// getCheckConstraint - Return condition written in CHECK, ex. CHECK (one > 0)
+func (parser *Parser) getCheckConstraint() (ast.CheckConstraint, error) {
+	check := ast.CheckConstraint{}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.CHECK})
+	if err != nil {
+		return check, err
+	}
+	err = validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	if err != nil {
+		return check, err
+	}
+
+	expressionIsValid, condition, err := parser.getExpression()
+	if err != nil {
+		return check, err
+	}
+	if !expressionIsValid {
+		return check, &LogicalExpressionParsingError{}
+	}
+	check.Condition = condition
+
+	return check, validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+}
+
+// getUniqueConstraint - Return columns written in UNIQUE, ex. UNIQUE NULLS NOT DISTINCT (one, two), list of columns
+// is omitted when constraint is written after the column
+func (parser *Parser) getUniqueConstraint(columnName string) (ast.UniqueConstraint, error) {
+	unique := ast.UniqueConstraint{}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.UNIQUE})
+	if err != nil {
+		return unique, err
+	}
+
+	if parser.currentToken.Type == token.NULLS {
+		// Skip token.NULLS
+		parser.nextToken()
+		if parser.currentToken.Type == token.NOT {
+			unique.NullsNotDistinct = true
+			// Skip token.NOT
+			parser.nextToken()
+		}
+		err = validateTokenAndSkip(parser, []token.Type{token.DISTINCT})
+		if err != nil {
+			return unique, err
+		}
+	}
+
+	if columnName != "" {
+		unique.ColumnNames = []string{columnName}
+		return unique, nil
+	}
+	unique.ColumnNames, err = parser.getColumnNameList()
+	return unique, err
+}
+
+// getForeignKeyConstraint - Return foreign key with referenced table and actions, ex. FOREIGN KEY (one) REFERENCES
+// other (id) ON DELETE CASCADE, FOREIGN KEY and list of columns are omitted when constraint is written after the column
+func (parser *Parser) getForeignKeyConstraint(columnName string) (ast.ForeignKeyConstraint, error) {
+	foreignKey := ast.ForeignKeyConstraint{OnDelete: ast.Restrict, OnUpdate: ast.Restrict}
+
+	var err error
+	if columnName != "" {
+		foreignKey.ColumnNames = []string{columnName}
+	} else {
+		// Skip token.FOREIGN
+		parser.nextToken()
+		err = validateTokenAndSkip(parser, []token.Type{token.KEY})
+		if err != nil {
+			return foreignKey, err
+		}
+		foreignKey.ColumnNames, err = parser.getColumnNameList()
+		if err != nil {
+			return foreignKey, err
+		}
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.REFERENCES})
+	if err != nil {
+		return foreignKey, err
+	}
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return foreignKey, err
+	}
+	foreignKey.ReferencedTable = parser.currentToken.Literal
+	// Skip token.IDENT
+	parser.nextToken()
+
+	if parser.currentToken.Type == token.LPAREN {
+		foreignKey.ReferencedColumnNames, err = parser.getColumnNameList()
+		if err != nil {
+			return foreignKey, err
+		}
+	}
+
+	for parser.currentToken.Type == token.ON {
+		// Skip token.ON
+		parser.nextToken()
+		err = validateToken(parser.currentToken.Type, []token.Type{token.DELETE, token.UPDATE})
+		if err != nil {
+			return foreignKey, err
+		}
+		isDelete := parser.currentToken.Type == token.DELETE
+		// Skip token.DELETE or token.UPDATE
+		parser.nextToken()
+
+		action, err := parser.getReferentialAction()
+		if err != nil {
+			return foreignKey, err
+		}
+		if isDelete {
+			foreignKey.OnDelete = action
+		} else {
+			foreignKey.OnUpdate = action
+		}
+	}
+	return foreignKey, nil
+}
+
+// getReferentialAction - Return action written after ON DELETE or ON UPDATE, ex. CASCADE or SET NULL
+func (parser *Parser) getReferentialAction() (ast.ReferentialAction, error) {
+	err := validateToken(parser.currentToken.Type, []token.Type{token.CASCADE, token.RESTRICT, token.SET})
+	if err != nil {
+		return "", err
+	}
+	actionType := parser.currentToken.Type
+	// Skip token.CASCADE, token.RESTRICT or token.SET
+	parser.nextToken()
+
+	switch actionType {
+	case token.CASCADE:
+		return ast.Cascade, nil
+	case token.RESTRICT:
+		return ast.Restrict, nil
+	default:
+		return ast.SetNull, validateTokenAndSkip(parser, []token.Type{token.NULL})
+	}
+}
+
+// getTablePrimaryKey - Set primary key declared after columns of the table, ex. PRIMARY KEY (one, two)
+func (parser *Parser) getTablePrimaryKey(createCommand *ast.CreateCommand) error {
+	err := parser.skipPrimaryKeyKeywords(createCommand)
+	if err != nil {
+		return err
+	}
+
+	createCommand.PrimaryKey, err = parser.getColumnNameList()
+	return err
+}
+
+// getColumnNameList - Return names of columns written in parentheses, ex. (one, two)
+func (parser *Parser) getColumnNameList() ([]string, error) {
+	err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	if err != nil {
+		return nil, err
+	}
+
+	columnNames := make([]string, 0)
+	for {
+		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+		columnNames = append(columnNames, parser.currentToken.Literal)
+		// Skip token.IDENT
+		parser.nextToken()
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Skip token.COMMA
+		parser.nextToken()
+	}
+
+	return columnNames, validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+}
+
+// skipPrimaryKeyKeywords - Skip PRIMARY KEY keywords and return error if table already has primary key
+func (parser *Parser) skipPrimaryKeyKeywords(createCommand *ast.CreateCommand) error {
+	if len(createCommand.PrimaryKey) > 0 {
+		return &MultiplePrimaryKeysParserError{tableName: createCommand.Name.Token.Literal}
+	}
+	// Skip token.PRIMARY
+	parser.nextToken()
+
+	return validateTokenAndSkip(parser, []token.Type{token.KEY})
+}
+
+// skipArrayTypeSuffix - Return true if column type is followed by [], which makes it an array type, ex. TEXT[]
+func (parser *Parser) skipArrayTypeSuffix() (bool, error) {
+	if parser.currentToken.Type != token.LBRACKET {
+		return false, nil
+	}
+	// Skip token.LBRACKET
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.RBRACKET})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (parser *Parser) skipIfCurrentTokenIsApostrophe() bool {
+	if parser.currentToken.Type == token.APOSTROPHE {
+		parser.nextToken()
+		return true
+	}
+	return false
+}
+
+func (parser *Parser) skipIfCurrentTokenIsSemicolon() {
+	if parser.currentToken.Type == token.SEMICOLON {
+		parser.nextToken()
+	}
+}
+
+// parseInsertCommand - Return ast.InsertCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.InsertCommand:
+// insert into tbl values( 'hello',	 10 );
+func (parser *Parser) parseInsertCommand() (ast.Command, error) {
+	// token.INSERT already at current position in parser
+	insertCommand := &ast.InsertCommand{Token: parser.currentToken}
+
+	// Ignore token.INSERT
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.INTO})
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	insertCommand.Name = ast.Identifier{Token: parser.currentToken}
+	// Ignore token.INDENT
+	parser.nextToken()
+
+	if parser.currentToken.Type == token.LPAREN {
+		insertCommand.ColumnNames, err = parser.getInsertColumnNames()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.VALUES})
+	if err != nil {
+		return nil, err
+	}
+	err = validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.currentToken.Type == token.IDENT || parser.currentToken.Type == token.LITERAL || parser.currentToken.Type == token.NULL || parser.currentToken.Type == token.APOSTROPHE || parser.currentToken.Type == token.DEFAULT || isUnquotedLiteral(parser.currentToken.Type) || startsExpression(parser.currentToken.Type) {
+		if parser.currentToken.Type == token.ARRAY {
+			value, err := parser.getArrayValue()
+			if err != nil {
+				return nil, err
+			}
+			insertCommand.Values = append(insertCommand.Values, ast.Anonymitifier{Token: value})
+		} else if parser.currentToken.Type == token.DEFAULT {
+			insertCommand.Values = append(insertCommand.Values, ast.Anonymitifier{Token: parser.currentToken})
+			// Skip token.DEFAULT
+			parser.nextToken()
+		} else {
+			if parser.currentToken.Type == token.APOSTROPHE && parser.peekToken.Type == token.APOSTROPHE {
+				return nil, &SyntaxError{expecting: []string{token.IDENT, token.LITERAL, token.NULL}, got: token.APOSTROPHE}
+			}
+			value, err := parser.getTifierWithoutTrailingApostrophe()
+			if err != nil {
+				return nil, err
+			}
+			insertCommand.Values = append(insertCommand.Values, value)
+		}
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+
+		// Ignore token.COMMA
+		parser.nextToken()
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	if err != nil {
+		return nil, err
+	}
+
+	return insertCommand, nil
+}
+
+// getInsertColumnNames - Return names of columns written in parens before VALUES, ex. (one, two)
+func (parser *Parser) getInsertColumnNames() ([]string, error) {
+	// Skip token.LPAREN
+	parser.nextToken()
+
+	columnNames := make([]string, 0)
+	for {
+		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+		columnNames = append(columnNames, parser.currentToken.Literal)
+		// Skip token.IDENT
+		parser.nextToken()
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Skip token.COMMA
+		parser.nextToken()
+	}
+
+	return columnNames, validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+}
+
+// getArrayValue - Return token.ARRAY containing array literal written in VALUES, ex. ARRAY['a', NULL], in the text
+// form used by engine, ex. {"a",NULL}, only simple values can be elements of the array
+func (parser *Parser) getArrayValue() (token.Token, error) {
+	arrayLiteral, err := parser.getArrayLiteral()
+	if err != nil {
+		return token.Token{}, err
+	}
+
+	elements := make([]string, 0)
+	for _, element := range arrayLiteral.(ast.FunctionCall).Arguments {
+		value, isAnonymitifier := element.(ast.Anonymitifier)
+		if !isAnonymitifier {
+			return token.Token{}, &SyntaxError{expecting: []string{token.APOSTROPHE, token.LITERAL, token.NULL}, got: ast.TifierToString(element)}
+		}
+		switch value.Token.Type {
+		case token.IDENT:
+			elements = append(elements, quoteArrayElement(value.Token.Literal))
+		case token.HEX:
+			elements = append(elements, ast.TifierToString(value))
+		default:
+			elements = append(elements, value.Token.Literal)
+		}
+	}
+	return token.Token{Type: token.ARRAY, Literal: "{" + strings.Join(elements, ",") + "}"}, nil
+}
+
+// quoteArrayElement - Return text wrapped with double quotes, so it's read as text element of array, double quotes and
+// backslashes inside are escaped with backslash
+func quoteArrayElement(text string) string {
+	text = strings.ReplaceAll(text, "\\", "\\\\")
+	text = strings.ReplaceAll(text, "\"", "\\\"")
+	return "\"" + text + "\""
+}
+
+func validateApostropheWrapping(startedWithApostrophe bool, finishedWithApostrophe bool, value token.Token) error {
+	if startedWithApostrophe && !finishedWithApostrophe {
+		return &NoApostropheOnRightParserError{ident: value.Literal}
+	} else if !startedWithApostrophe && finishedWithApostrophe {
+		return &NoApostropheOnLeftParserError{ident: value.Literal}
+	}
+	return nil
+}
+
+// parseSelectCommand - Return ast.SelectCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.SelectCommand:
+// SELECT col1, col2, col3 FROM tbl;
+func (parser *Parser) parseSelectCommand() (ast.Command, error) {
+	// token.SELECT already at current position in parser
+	selectCommand := &ast.SelectCommand{Token: parser.currentToken}
+
+	// Ignore token.SELECT
+	parser.nextToken()
+
+	// optional DISTINCT
+	if parser.currentToken.Type == token.DISTINCT {
+		selectCommand.HasDistinct = true
+
+		// Ignore token.DISTINCT
+		parser.nextToken()
+	}
+
+	var err error
+	if !startsExpression(parser.currentToken.Type) {
+		err = validateToken(parser.currentToken.Type, []token.Type{token.ASTERISK, token.IDENT, token.MAX, token.MIN, token.SUM, token.AVG, token.COUNT, token.BOOL_AND, token.BOOL_OR})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if parser.currentToken.Type == token.ASTERISK {
+		selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken})
+		parser.nextToken()
+	} else {
+		for parser.currentToken.Type == token.IDENT || startsExpression(parser.currentToken.Type) || isAggregateFunction(parser.currentToken.Type) {
+			if isAggregateFunction(parser.currentToken.Type) {
+				aggregateFunction := parser.currentToken
+				parser.nextToken()
+				err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+				if err != nil {
+					return nil, err
+				}
+				if aggregateFunction.Type == token.COUNT {
+					err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.ASTERISK})
+				} else {
+					err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+				}
+				if err != nil {
+					return nil, err
+				}
+				selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken, AggregateFunc: &aggregateFunction})
+				parser.nextToken()
+
+				err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				// Get column name or function call
+				value, err := parser.getTifier()
+				if err != nil {
+					return nil, err
+				}
+				selectCommand.Space = append(selectCommand.Space, getSpace(value))
+			}
+
+			if parser.currentToken.Type != token.COMMA {
+				break
+			}
+			// Ignore token.COMMA
+			parser.nextToken()
+		}
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.FROM})
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.Type == token.UNNEST {
+		err = parser.getTableFunction(selectCommand)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+
+		selectCommand.Name = ast.Identifier{Token: parser.currentToken}
+		// Ignore token.IDENT
+		parser.nextToken()
+	}
+
+	// expect SEMICOLON or other keywords expected in SELECT statement
+	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.WHERE, token.ORDER, token.LIMIT, token.OFFSET, token.JOIN, token.LEFT, token.RIGHT, token.INNER, token.FULL})
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.Type == token.SEMICOLON {
+		parser.nextToken()
+	}
+
+	return selectCommand, nil
+}
+
+// getTableFunction - Set function returning rows used in FROM, ex. UNNEST(ARRAY[1, 2]) AS id, alias is used as name
+// of both table and its column, it's function name in lower case by default
+func (parser *Parser) getTableFunction(selectCommand *ast.SelectCommand) error {
+	name := token.Token{Type: token.IDENT, Literal: strings.ToLower(parser.currentToken.Literal)}
+
+	tableFunction, err := parser.getFunctionCall()
+	if err != nil {
+		return err
+	}
+	functionCall := tableFunction.(ast.FunctionCall)
+
+	if parser.currentToken.Type == token.AS {
+		// Skip token.AS
+		parser.nextToken()
+
+		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return err
+		}
+		name = parser.currentToken
+		// Skip token.IDENT
+		parser.nextToken()
+	}
+
+	selectCommand.Name = ast.Identifier{Token: name}
+	selectCommand.TableFunction = &functionCall
+	return nil
+}
+
+// getSpace - Return ast.Space with either plain column name or function call
+func getSpace(value ast.Tifier) ast.Space {
+	functionCall, isFunctionCall := value.(ast.FunctionCall)
+	if !isFunctionCall {
+		return ast.Space{ColumnName: value.GetToken()}
+	}
+	return ast.Space{ColumnName: token.Token{Type: token.IDENT, Literal: functionCall.String()}, Function: &functionCall}
+}
+
+func (parser *Parser) getColumnName(err error, selectCommand *ast.SelectCommand, aggregateFunction token.Token) error {
+	// Get column name
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.ASTERISK})
+	if err != nil {
+		return err
+	}
+	selectCommand.Space = append(selectCommand.Space, ast.Space{ColumnName: parser.currentToken, AggregateFunc: &aggregateFunction})
+	parser.nextToken()
+	return nil
+}
+
+// startsExpression - Return true if token can only be the beginning of scalar expression, ex. CAST(...), (...),
+// unary minus, EXTRACT(...), ARRAY[...] or typed literal like DATE '2024-01-31'
+func startsExpression(t token.Type) bool {
+	return t == token.CAST || t == token.LPAREN || t == token.MINUS || t == token.PLUS || t == token.EXTRACT ||
+		t == token.ARRAY || isTemporalType(t)
+}
+
+func isTemporalType(t token.Type) bool {
+	return t == token.DATE || t == token.TIME || t == token.TIMESTAMP || t == token.TIMESTAMPTZ || t == token.INTERVAL
+}
+
+func isComparisonOperator(t token.Type) bool {
+	return t == token.EQUAL || t == token.NOT || t == token.LT || t == token.GT || t == token.LTE || t == token.GTE
+}
+
+func isAggregateFunction(t token.Type) bool {
+	return t == token.MIN || t == token.MAX || t == token.COUNT || t == token.SUM || t == token.AVG ||
+		t == token.BOOL_AND || t == token.BOOL_OR
+}
+
+// isUnquotedLiteral - Return true if token is a value which can't be wrapped with apostrophes, ex. TRUE or X'FF'
+func isUnquotedLiteral(t token.Type) bool {
+	return t == token.TRUE || t == token.FALSE || t == token.HEX
+}
+
+// endsPredicate - Return true if token can follow value used as condition without comparison, ex. WHERE is_active;
+func endsPredicate(t token.Type) bool {
+	return t == token.SEMICOLON || t == token.AND || t == token.OR || t == token.ORDER || t == token.WHERE ||
+		t == token.LIMIT || t == token.OFFSET || t == token.RPAREN || t == token.EOF
+}
+
+// parseWhereCommand - Return ast.WhereCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.WhereCommand:
+// WHERE colName EQUAL 'potato'
+func (parser *Parser) parseWhereCommand() (ast.Command, error) {
+	// token.WHERE already at current position in parser
+	whereCommand := &ast.WhereCommand{Token: parser.currentToken}
+	expressionIsValid := false
+
+	// Ignore token.WHERE
+	parser.nextToken()
+	var err error
+	expressionIsValid, whereCommand.Expression, err = parser.getExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if !expressionIsValid {
+		return nil, &LogicalExpressionParsingError{}
+	}
+
+	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.ORDER})
+	if err != nil {
+		return nil, err
+	}
+
+	parser.skipIfCurrentTokenIsSemicolon()
+
+	return whereCommand, nil
+}
+
+// parseDeleteCommand - Return ast.DeleteCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.DeleteCommand:
+// DELETE FROM table;
+func (parser *Parser) parseDeleteCommand() (ast.Command, error) {
+	// token.DELETE already at current position in parser
+	deleteCommand := &ast.DeleteCommand{Token: parser.currentToken}
+
+	// token.DELETE no longer needed
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.FROM})
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	deleteCommand.Name = ast.Identifier{Token: parser.currentToken}
+
+	// token.IDENT no longer needed
+	parser.nextToken()
+
+	// WHERE is parsed as separate command, without it all rows are deleted
+	err = validateToken(parser.currentToken.Type, []token.Type{token.WHERE, token.SEMICOLON})
+	if err != nil {
+		return nil, err
+	}
+	parser.skipIfCurrentTokenIsSemicolon()
+
+	return deleteCommand, nil
+}
+
+// parseTruncateCommand - Return ast.TruncateCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.TruncateCommand:
+// TRUNCATE TABLE tbl;
+// TRUNCATE tbl, other CASCADE;
+func (parser *Parser) parseTruncateCommand() (ast.Command, error) {
+	// token.TRUNCATE already at current position in parser
+	truncateCommand := &ast.TruncateCommand{Token: parser.currentToken}
+
+	// Skip token.TRUNCATE
+	parser.nextToken()
+
+	// TABLE keyword is optional like in PostgreSQL
+	if parser.currentToken.Type == token.TABLE {
+		// Skip token.TABLE
+		parser.nextToken()
+	}
+
+	for {
+		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+		truncateCommand.Names = append(truncateCommand.Names, ast.Identifier{Token: parser.currentToken})
+
+		// Skip token.IDENT
+		parser.nextToken()
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Skip token.COMMA
+		parser.nextToken()
+	}
+
+	if parser.currentToken.Type == token.CASCADE {
+		truncateCommand.Cascade = true
+		// Skip token.CASCADE
+		parser.nextToken()
+	}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	if err != nil {
+		return nil, err
+	}
+	return truncateCommand, nil
+}
+
+// parseDropCommand - Return ast.DropCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.DropCommand:
+// DROP TABLE table;
+// DROP TABLE IF EXISTS table CASCADE;
+func (parser *Parser) parseDropCommand() (ast.Command, error) {
+	// token.DROP already at current position in parser
+	dropCommand := &ast.DropCommand{Token: parser.currentToken}
+
+	// token.DROP no longer needed
+	parser.nextToken()
+
+	if parser.currentToken.Type == token.INDEX {
+		return parser.parseDropIndexCommand(dropCommand.Token)
+	}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.TABLE, token.INDEX})
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.currentToken.Type == token.IF {
+		// Skip token.IF
+		parser.nextToken()
+		err = validateTokenAndSkip(parser, []token.Type{token.EXISTS})
+		if err != nil {
+			return nil, err
+		}
+		dropCommand.IfExists = true
+	}
+
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	dropCommand.Name = ast.Identifier{Token: parser.currentToken}
+
+	// token.IDENT no longer needed
+	parser.nextToken()
+
+	if parser.currentToken.Type == token.CASCADE {
+		dropCommand.Cascade = true
+		// token.CASCADE no longer needed
+		parser.nextToken()
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+
+	return dropCommand, err
+}
+
+// parseDropIndexCommand - Return ast.DropIndexCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.DropIndexCommand:
+// DROP INDEX idx;
+func (parser *Parser) parseDropIndexCommand(dropToken token.Token) (ast.Command, error) {
+	// token.INDEX already at current position in parser
+	dropIndexCommand := &ast.DropIndexCommand{Token: dropToken}
+
+	// token.INDEX no longer needed
+	parser.nextToken()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	dropIndexCommand.Name = ast.Identifier{Token: parser.currentToken}
+
+	// token.IDENT no longer needed
+	parser.nextToken()
+
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+
+	return dropIndexCommand, err
+}
+
+// parseAlterTableCommand - Return ast.AlterTableCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.AlterTableCommand:
+// ALTER TABLE tbl ADD COLUMN three INT NOT NULL DEFAULT 0;
+// ALTER TABLE tbl DROP COLUMN three CASCADE;
+// ALTER TABLE tbl RENAME COLUMN one TO first;
+// ALTER TABLE tbl RENAME TO other;
+// ALTER TABLE tbl ALTER COLUMN two TYPE DECIMAL(10, 2);
+func (parser *Parser) parseAlterTableCommand() (ast.Command, error) {
+	// token.ALTER already at current position in parser
+	alterCommand := &ast.AlterTableCommand{Token: parser.currentToken}
+
+	// Skip token.ALTER
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.TABLE})
+	if err != nil {
+		return nil, err
+	}
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	alterCommand.Name = ast.Identifier{Token: parser.currentToken}
+
+	// Skip token.IDENT
+	parser.nextToken()
+
+	err = validateToken(parser.currentToken.Type, []token.Type{token.ADD, token.DROP, token.RENAME, token.ALTER})
+	if err != nil {
+		return nil, err
+	}
+	action := parser.currentToken.Type
+
+	// Skip token.ADD, token.DROP, token.RENAME or token.ALTER
+	parser.nextToken()
+
+	switch action {
+	case token.ADD:
+		err = parser.getAddedColumn(alterCommand)
+	case token.DROP:
+		err = parser.getDroppedColumn(alterCommand)
+	case token.RENAME:
+		err = parser.getRenamedColumnOrTable(alterCommand)
+	case token.ALTER:
+		err = parser.getAlteredColumnType(alterCommand)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	if err != nil {
+		return nil, err
+	}
+	return alterCommand, nil
+}
+
+// getAddedColumn - Set definition of column added with ALTER TABLE, ex. ADD COLUMN three INT NOT NULL DEFAULT 0
+func (parser *Parser) getAddedColumn(alterCommand *ast.AlterTableCommand) error {
+	alterCommand.Action = ast.AddColumn
+	parser.skipOptionalColumnKeyword()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return err
+	}
+	alterCommand.Definition = &ast.CreateCommand{Token: alterCommand.Token, Name: alterCommand.Name}
+	return parser.getColumnDefinition(alterCommand.Definition)
+}
+
+// getDroppedColumn - Set column dropped with ALTER TABLE, ex. DROP COLUMN three CASCADE
+func (parser *Parser) getDroppedColumn(alterCommand *ast.AlterTableCommand) error {
+	alterCommand.Action = ast.DropColumn
+	parser.skipOptionalColumnKeyword()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return err
+	}
+	alterCommand.ColumnName = parser.currentToken.Literal
+
+	// Skip token.IDENT
+	parser.nextToken()
+
+	if parser.currentToken.Type == token.CASCADE {
+		alterCommand.Cascade = true
+		// Skip token.CASCADE
+		parser.nextToken()
+	}
+	return nil
+}
+
+// getRenamedColumnOrTable - Set new name of the column or of the table, ex. RENAME COLUMN one TO first or
+// RENAME TO other
+func (parser *Parser) getRenamedColumnOrTable(alterCommand *ast.AlterTableCommand) error {
+	alterCommand.Action = ast.RenameTable
+	if parser.currentToken.Type != token.TO {
+		alterCommand.Action = ast.RenameColumn
+		parser.skipOptionalColumnKeyword()
+
+		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return err
+		}
+		alterCommand.ColumnName = parser.currentToken.Literal
+
+		// Skip token.IDENT
+		parser.nextToken()
+	}
+
+	err := validateTokenAndSkip(parser, []token.Type{token.TO})
+	if err != nil {
+		return err
+	}
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return err
+	}
+	if strings.Contains(parser.currentToken.Literal, ".") {
+		return &IllegalPeriodInIdentParserError{name: parser.currentToken.Literal}
+	}
+	alterCommand.NewName = parser.currentToken.Literal
+
+	// Skip token.IDENT
+	parser.nextToken()
+	return nil
+}
+
+// getAlteredColumnType - Set column and its new type, ex. ALTER COLUMN two TYPE DECIMAL(10, 2)
+func (parser *Parser) getAlteredColumnType(alterCommand *ast.AlterTableCommand) error {
+	alterCommand.Action = ast.AlterColumnType
+	parser.skipOptionalColumnKeyword()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return err
+	}
+	alterCommand.ColumnName = parser.currentToken.Literal
+
+	// Skip token.IDENT
+	parser.nextToken()
+
+	err = validateTokenAndSkip(parser, []token.Type{token.TYPE})
+	if err != nil {
+		return err
+	}
+	alterCommand.ColumnType, alterCommand.TypeParameters, alterCommand.IsArray, err = parser.getColumnType()
+	return err
+}
+
+// skipOptionalColumnKeyword - Skip COLUMN keyword which is optional in ALTER TABLE, ex. DROP COLUMN one or DROP one
+func (parser *Parser) skipOptionalColumnKeyword() {
+	if parser.currentToken.Type == token.COLUMN {
+		// Skip token.COLUMN
+		parser.nextToken()
+	}
+}
+
+// parseOrderByCommand - Return ast.OrderByCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.OrderByCommand:
+// ORDER BY colName ASC
+func (parser *Parser) parseOrderByCommand() (ast.Command, error) {
+	// token.ORDER already at current position in parser
+	orderCommand := &ast.OrderByCommand{Token: parser.currentToken}
+
+	// token.ORDER no longer needed
+	parser.nextToken()
+
+	err := validateTokenAndSkip(parser, []token.Type{token.BY})
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure that loop below will execute at least once
+	if !startsExpression(parser.currentToken.Type) {
+		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// array of SortPattern
+	for parser.currentToken.Type == token.IDENT || startsExpression(parser.currentToken.Type) {
+		// Get column name or function call
+		value, err := parser.getTifier()
+		if err != nil {
+			return nil, err
+		}
+		space := getSpace(value)
+
+		// Get ASC or DESC
+		err = validateToken(parser.currentToken.Type, []token.Type{token.ASC, token.DESC})
+		if err != nil {
+			return nil, err
+		}
+		order := parser.currentToken
+		parser.nextToken()
+
+		// append sortPattern
+		orderCommand.SortPatterns = append(orderCommand.SortPatterns, ast.SortPattern{ColumnName: space.ColumnName, Order: order, Function: space.Function})
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Ignore token.COMMA
+		parser.nextToken()
+	}
+
+	parser.skipIfCurrentTokenIsSemicolon()
+
+	return orderCommand, nil
+}
 
-	// token.IDENT no longer needed
+// parseLimitCommand - Return ast.LimitCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.LimitCommand:
+// LIMIT 10
+func (parser *Parser) parseLimitCommand() (ast.Command, error) {
+	// token.LIMIT already at current position in parser
+	limitCommand := &ast.LimitCommand{Token: parser.currentToken}
+
+	// token.LIMIT no longer needed
+	parser.nextToken()
+
+	err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
+	if err != nil {
+		return nil, err
+	}
+
+	// convert count number to int
+	count, err := strconv.Atoi(parser.currentToken.Literal)
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 0 {
+		return nil, &ArithmeticLessThanZeroParserError{variable: "limit"}
+	}
+
+	limitCommand.Count = count
+
+	// Skip token.IDENT
 	parser.nextToken()
 
-	// expect WHERE
-	err = validateToken(parser.currentToken.Type, []token.Type{token.WHERE})
+	parser.skipIfCurrentTokenIsSemicolon()
 
-	return deleteCommand, err
+	return limitCommand, nil
 }
 
-// parseDropCommand - Return ast.DropCommand created from tokens and validate the syntax
+// parseOffsetCommand - Return ast.OffsetCommand created from tokens and validate the syntax
 //
-// Example of input parsable to the ast.DropCommand:
-// DROP TABLE table;
-func (parser *Parser) parseDropCommand() (ast.Command, error) {
-	// token.DROP already at current position in parser
-	dropCommand := &ast.DropCommand{Token: parser.currentToken}
+// Example of input parsable to the ast.LimitCommand:
+// OFFSET 10
+func (parser *Parser) parseOffsetCommand() (ast.Command, error) {
+	// token.OFFSET already at current position in parser
+	offsetCommand := &ast.OffsetCommand{Token: parser.currentToken}
 
-	// token.DROP no longer needed
+	// token.OFFSET no longer needed
 	parser.nextToken()
 
-	err := validateTokenAndSkip(parser, []token.Type{token.TABLE})
+	err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
 	if err != nil {
 		return nil, err
 	}
 
-	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	count, err := strconv.Atoi(parser.currentToken.Literal)
 	if err != nil {
 		return nil, err
 	}
-	dropCommand.Name = ast.Identifier{Token: parser.currentToken}
+	if count < 0 {
+		return nil, &ArithmeticLessThanZeroParserError{variable: "offset"}
+	}
 
-	// token.IDENT no longer needed
+	offsetCommand.Count = count
+
+	// Skip token.IDENT
 	parser.nextToken()
 
-	err = validateTokenAndSkip(parser, []token.Type{token.SEMICOLON})
+	parser.skipIfCurrentTokenIsSemicolon()
 
-	return dropCommand, err
+	return offsetCommand, nil
 }
 
-// parseOrderByCommand - Return ast.OrderByCommand created from tokens and validate the syntax
+// parseJoinCommand - Return ast.JoinCommand created from tokens and validate the syntax
 //
-// Example of input parsable to the ast.OrderByCommand:
-// ORDER BY colName ASC
-func (parser *Parser) parseOrderByCommand() (ast.Command, error) {
-	// token.ORDER already at current position in parser
-	orderCommand := &ast.OrderByCommand{Token: parser.currentToken}
+// Example of input parsable to the ast.JoinCommand:
+// JOIN table on table.one EQUAL table2.one;
+func (parser *Parser) parseJoinCommand() (ast.Command, error) {
+	// parser has either token.JOIN, token.LEFT, token.RIGHT, token.INNER or token.FULL
+	var joinCommand *ast.JoinCommand
 
-	// token.ORDER no longer needed
+	if parser.currentToken.Type == token.JOIN {
+		joinCommand = &ast.JoinCommand{Token: parser.currentToken}
+		joinCommand.JoinType = token.Token{Type: token.INNER, Literal: token.INNER}
+	} else {
+		joinTypeTokenType := parser.currentToken
+		parser.nextToken()
+		err := validateToken(parser.currentToken.Type, []token.Type{token.JOIN})
+		if err != nil {
+			return nil, err
+		}
+		joinCommand = &ast.JoinCommand{Token: parser.currentToken}
+		joinCommand.JoinType = joinTypeTokenType
+	}
+
+	// token.JOIN no longer needed
 	parser.nextToken()
 
-	err := validateTokenAndSkip(parser, []token.Type{token.BY})
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+
+	joinCommand.Name = ast.Identifier{Token: parser.currentToken}
+	parser.nextToken()
+
+	err = validateTokenAndSkip(parser, []token.Type{token.ON})
+	if err != nil {
+		return nil, err
+	}
+
+	var expressionIsValid bool
+	expressionIsValid, joinCommand.Expression, err = parser.getExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if !expressionIsValid {
+		return nil, &LogicalExpressionParsingError{}
+	}
+
+	parser.skipIfCurrentTokenIsSemicolon()
+
+	return joinCommand, nil
+}
+
+// parseUpdateCommand - Return ast.parseUpdateCommand created from tokens and validate the syntax
+//
+// Example of input parsable to the ast.parseUpdateCommand:
+// UPDATE table SET col1 TO 'value' WHERE col2 EQUAL 10;
+func (parser *Parser) parseUpdateCommand() (ast.Command, error) {
+	// token.UPDATE already at current position in parser
+	updateCommand := &ast.UpdateCommand{Token: parser.currentToken}
+
+	// Ignore token.UPDATE
+	parser.nextToken()
+
+	// Get table name
+	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	updateCommand.Name = ast.Identifier{Token: parser.currentToken}
+
+	// Ignore token.IDENT
+	parser.nextToken()
+
+	err = validateTokenAndSkip(parser, []token.Type{token.SET})
 	if err != nil {
 		return nil, err
 	}
 
-	// ensure that loop below will execute at least once
 	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 	if err != nil {
 		return nil, err
 	}
 
-	// array of SortPattern
+	updateCommand.Changes = make(map[token.Token]ast.Tifier)
 	for parser.currentToken.Type == token.IDENT {
 		// Get column name
-		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
 		if err != nil {
 			return nil, err
 		}
-		columnName := parser.currentToken
+		colKey := parser.currentToken
+
+		// skip column name
 		parser.nextToken()
 
-		// Get ASC or DESC
-		err = validateToken(parser.currentToken.Type, []token.Type{token.ASC, token.DESC})
+		err = validateToken(parser.currentToken.Type, []token.Type{token.TO})
 		if err != nil {
 			return nil, err
 		}
-		order := parser.currentToken
+		// skip token.TO
 		parser.nextToken()
 
-		// append sortPattern
-		orderCommand.SortPatterns = append(orderCommand.SortPatterns, ast.SortPattern{ColumnName: columnName, Order: order})
+		if parser.currentToken.Type == token.DEFAULT {
+			updateCommand.Changes[colKey] = ast.Anonymitifier{Token: parser.currentToken}
+			// skip token.DEFAULT
+			parser.nextToken()
+		} else {
+			if parser.currentToken.Type != token.APOSTROPHE && !startsExpression(parser.currentToken.Type) && !isUnquotedLiteral(parser.currentToken.Type) {
+				err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.LITERAL, token.NULL})
+				if err != nil {
+					return nil, err
+				}
+			}
+			updateCommand.Changes[colKey], err = parser.getTifierWithoutTrailingApostrophe()
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		if parser.currentToken.Type != token.COMMA {
 			break
 		}
-		// Ignore token.COMMA
+
+		// Skip token.COMMA
 		parser.nextToken()
 	}
 
+	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.WHERE})
+	if err != nil {
+		return nil, err
+	}
 	parser.skipIfCurrentTokenIsSemicolon()
+	return updateCommand, nil
+}
 
-	return orderCommand, nil
+// getExpression - Return proper structure of ast.Expression and validate the syntax
+//
+// Available expressions:
+// - ast.OperationExpression
+// - ast.BooleanExpression
+// - ast.PredicateExpression
+// - ast.ConditionExpression
+// - ast.ContainExpression
+func (parser *Parser) getExpression() (bool, ast.Expression, error) {
+
+	if parser.currentToken.Type == token.IDENT ||
+		parser.currentToken.Type == token.LITERAL ||
+		parser.currentToken.Type == token.NULL ||
+		parser.currentToken.Type == token.APOSTROPHE ||
+		startsExpression(parser.currentToken.Type) ||
+		parser.currentToken.Type == token.TRUE ||
+		parser.currentToken.Type == token.FALSE ||
+		parser.currentToken.Type == token.HEX {
+
+		leftSide, err := parser.getTifier()
+		if err != nil {
+			return false, nil, err
+		}
+
+		isValidExpression := false
+		var expression ast.Expression
+
+		if isComparisonOperator(parser.currentToken.Type) {
+			isValidExpression, expression, err = parser.getConditionalExpression(leftSide)
+		} else if parser.currentToken.Type == token.IN || parser.currentToken.Type == token.NOTIN {
+			isValidExpression, expression, err = parser.getContainExpression(leftSide)
+		} else if leftSide.GetToken().Type == token.TRUE || leftSide.GetToken().Type == token.FALSE {
+			expression = &ast.BooleanExpression{Boolean: leftSide.GetToken()}
+			isValidExpression = true
+			err = nil
+		} else if _, isColumn := leftSide.(ast.Identifier); isColumn && endsPredicate(parser.currentToken.Type) {
+			expression = &ast.PredicateExpression{Value: leftSide}
+			isValidExpression = true
+		}
+
+		if err != nil {
+			return false, nil, err
+		}
+
+		if (parser.currentToken.Type == token.AND || parser.currentToken.Type == token.OR) && isValidExpression {
+			isValidExpression, expression, err = parser.getOperationExpression(expression)
+		}
+
+		if err != nil {
+			return false, nil, err
+		}
+
+		if isValidExpression {
+			return true, expression, nil
+		}
+	}
+	return false, nil, nil
 }
 
-// parseLimitCommand - Return ast.LimitCommand created from tokens and validate the syntax
+// getTifier - Return ast.Tifier created from tokens, values can be joined with operators: token.CONCAT (the lowest
+// precedence), token.PLUS and token.MINUS, token.ASTERISK and token.SLASH, token.TYPECAST (the highest precedence)
 //
-// Example of input parsable to the ast.LimitCommand:
-// LIMIT 10
-func (parser *Parser) parseLimitCommand() (ast.Command, error) {
-	// token.LIMIT already at current position in parser
-	limitCommand := &ast.LimitCommand{Token: parser.currentToken}
+// Available tifiers:
+// - ast.Identifier (ex. column1)
+// - ast.Anonymitifier (ex. 'text', 123 or NULL)
+// - ast.FunctionCall (ex. UPPER(column1), column1 || 'text', price * (1 + tax), CAST(column1 AS INT) or column1::INT)
+func (parser *Parser) getTifier() (ast.Tifier, error) {
+	tifier, err := parser.getAdditiveTifier()
+	if err != nil {
+		return nil, err
+	}
 
-	// token.LIMIT no longer needed
-	parser.nextToken()
+	for parser.currentToken.Type == token.CONCAT {
+		operator := parser.currentToken
+		// Skip token.CONCAT
+		parser.nextToken()
+
+		right, err := parser.getAdditiveTifier()
+		if err != nil {
+			return nil, err
+		}
+		tifier = ast.FunctionCall{Name: operator, Arguments: []ast.Tifier{tifier, right}}
+	}
+
+	return tifier, nil
+}
+
+// getAdditiveTifier - Return ast.Tifier made of values joined with token.PLUS or token.MINUS
+func (parser *Parser) getAdditiveTifier() (ast.Tifier, error) {
+	tifier, err := parser.getMultiplicativeTifier()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.currentToken.Type == token.PLUS || parser.currentToken.Type == token.MINUS || parser.isSignedLiteral() {
+		var operator token.Token
+		if parser.isSignedLiteral() {
+			// lexer joins sign with the number, so in "column1 -5" sign is operator and number is right operand
+			operator = token.Token{Type: token.Type(parser.currentToken.Literal[:1]), Literal: parser.currentToken.Literal[:1]}
+			parser.currentToken.Literal = parser.currentToken.Literal[1:]
+		} else {
+			operator = parser.currentToken
+			// Skip token.PLUS or token.MINUS
+			parser.nextToken()
+		}
+
+		right, err := parser.getMultiplicativeTifier()
+		if err != nil {
+			return nil, err
+		}
+		tifier = ast.FunctionCall{Name: operator, Arguments: []ast.Tifier{tifier, right}}
+	}
+
+	return tifier, nil
+}
+
+// isSignedLiteral - Return true if current token is number starting with sign
+func (parser *Parser) isSignedLiteral() bool {
+	return parser.currentToken.Type == token.LITERAL &&
+		(strings.HasPrefix(parser.currentToken.Literal, token.PLUS) || strings.HasPrefix(parser.currentToken.Literal, token.MINUS))
+}
 
-	err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
+// getMultiplicativeTifier - Return ast.Tifier made of values joined with token.ASTERISK or token.SLASH
+func (parser *Parser) getMultiplicativeTifier() (ast.Tifier, error) {
+	tifier, err := parser.getTypecastTifier()
 	if err != nil {
 		return nil, err
 	}
 
-	// convert count number to int
-	count, err := strconv.Atoi(parser.currentToken.Literal)
-	if err != nil {
-		return nil, err
-	}
+	for parser.currentToken.Type == token.ASTERISK || parser.currentToken.Type == token.SLASH {
+		operator := parser.currentToken
+		// Skip token.ASTERISK or token.SLASH
+		parser.nextToken()
 
-	if count < 0 {
-		return nil, &ArithmeticLessThanZeroParserError{variable: "limit"}
+		right, err := parser.getTypecastTifier()
+		if err != nil {
+			return nil, err
+		}
+		tifier = ast.FunctionCall{Name: operator, Arguments: []ast.Tifier{tifier, right}}
 	}
 
-	limitCommand.Count = count
+	return tifier, nil
+}
 
-	// Skip token.IDENT
-	parser.nextToken()
+// getTypecastTifier - Return single ast.Tifier, which is converted to the other type if it's followed by
+// token.TYPECAST, ex. column1::INT, which has JSON field extracted with token.ARROW or token.DOUBLE_ARROW,
+// ex. payload->'user'->>'name', or which has element of array selected with index in brackets, ex. tags[1]
+func (parser *Parser) getTypecastTifier() (ast.Tifier, error) {
+	tifier, err := parser.getSingleTifier()
+	if err != nil {
+		return nil, err
+	}
 
-	parser.skipIfCurrentTokenIsSemicolon()
+	for parser.currentToken.Type == token.TYPECAST || parser.currentToken.Type == token.ARROW || parser.currentToken.Type == token.DOUBLE_ARROW ||
+		parser.currentToken.Type == token.LBRACKET {
+		operator := parser.currentToken
+		// Skip token.TYPECAST, token.ARROW, token.DOUBLE_ARROW or token.LBRACKET
+		parser.nextToken()
 
-	return limitCommand, nil
+		var right ast.Tifier
+		switch operator.Type {
+		case token.TYPECAST:
+			right, err = parser.getTargetType()
+		case token.LBRACKET:
+			right, err = parser.getTifier()
+			if err == nil {
+				err = validateTokenAndSkip(parser, []token.Type{token.RBRACKET})
+			}
+		default:
+			right, err = parser.getSingleTifier()
+		}
+		if err != nil {
+			return nil, err
+		}
+		tifier = ast.FunctionCall{Name: operator, Arguments: []ast.Tifier{tifier, right}}
+	}
+
+	return tifier, nil
 }
 
-// parseOffsetCommand - Return ast.OffsetCommand created from tokens and validate the syntax
-//
-// Example of input parsable to the ast.LimitCommand:
-// OFFSET 10
-func (parser *Parser) parseOffsetCommand() (ast.Command, error) {
-	// token.OFFSET already at current position in parser
-	offsetCommand := &ast.OffsetCommand{Token: parser.currentToken}
+// getCastCall - Return ast.FunctionCall created from CAST(value AS type) syntax
+func (parser *Parser) getCastCall() (ast.Tifier, error) {
+	functionCall := ast.FunctionCall{Name: parser.currentToken}
 
-	// token.OFFSET no longer needed
+	// Skip token.CAST
 	parser.nextToken()
 
-	err := validateToken(parser.currentToken.Type, []token.Type{token.LITERAL})
+	err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
 	if err != nil {
 		return nil, err
 	}
 
-	count, err := strconv.Atoi(parser.currentToken.Literal)
+	value, err := parser.getTifier()
 	if err != nil {
 		return nil, err
 	}
-	if count < 0 {
-		return nil, &ArithmeticLessThanZeroParserError{variable: "offset"}
-	}
 
-	offsetCommand.Count = count
+	err = validateTokenAndSkip(parser, []token.Type{token.AS})
+	if err != nil {
+		return nil, err
+	}
 
-	// Skip token.IDENT
-	parser.nextToken()
+	targetType, err := parser.getTargetType()
+	if err != nil {
+		return nil, err
+	}
 
-	parser.skipIfCurrentTokenIsSemicolon()
+	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
+	}
 
-	return offsetCommand, nil
+	functionCall.Arguments = []ast.Tifier{value, targetType}
+	return functionCall, nil
 }
 
-// parseJoinCommand - Return ast.JoinCommand created from tokens and validate the syntax
-//
-// Example of input parsable to the ast.JoinCommand:
-// JOIN table on table.one EQUAL table2.one;
-func (parser *Parser) parseJoinCommand() (ast.Command, error) {
-	// parser has either token.JOIN, token.LEFT, token.RIGHT, token.INNER or token.FULL
-	var joinCommand *ast.JoinCommand
-
-	if parser.currentToken.Type == token.JOIN {
-		joinCommand = &ast.JoinCommand{Token: parser.currentToken}
-		joinCommand.JoinType = token.Token{Type: token.INNER, Literal: token.INNER}
-	} else {
-		joinTypeTokenType := parser.currentToken
-		parser.nextToken()
-		err := validateToken(parser.currentToken.Type, []token.Type{token.JOIN})
-		if err != nil {
-			return nil, err
-		}
-		joinCommand = &ast.JoinCommand{Token: parser.currentToken}
-		joinCommand.JoinType = joinTypeTokenType
+// getTargetType - Return ast.Anonymitifier containing name of type used in conversion
+func (parser *Parser) getTargetType() (ast.Tifier, error) {
+	err := validateToken(parser.currentToken.Type, []token.Type{token.TEXT, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL,
+		token.DECIMAL, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.JSON, token.UUID})
+	if err != nil {
+		return nil, err
 	}
+	targetType := parser.currentToken
 
-	// token.JOIN no longer needed
+	// Skip type
 	parser.nextToken()
 
-	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	targetType, err = parser.getTimeZoneType(targetType)
 	if err != nil {
 		return nil, err
 	}
+	return ast.Anonymitifier{Token: targetType}, nil
+}
 
-	joinCommand.Name = ast.Identifier{Token: parser.currentToken}
-	parser.nextToken()
-
-	err = validateTokenAndSkip(parser, []token.Type{token.ON})
+// getTypedLiteral - Return ast.FunctionCall converting text to the type written before it, ex. DATE '2024-01-31'
+// is the same as '2024-01-31'::DATE
+func (parser *Parser) getTypedLiteral() (ast.Tifier, error) {
+	targetType, err := parser.getTargetType()
 	if err != nil {
 		return nil, err
 	}
 
-	var expressionIsValid bool
-	expressionIsValid, joinCommand.Expression, err = parser.getExpression()
+	err = validateToken(parser.currentToken.Type, []token.Type{token.APOSTROPHE})
 	if err != nil {
 		return nil, err
 	}
-
-	if !expressionIsValid {
-		return nil, &LogicalExpressionParsingError{}
+	value, err := parser.getTextTifier()
+	if err != nil {
+		return nil, err
 	}
 
-	parser.skipIfCurrentTokenIsSemicolon()
-
-	return joinCommand, nil
+	return ast.FunctionCall{Name: token.Token{Type: token.TYPECAST, Literal: token.TYPECAST}, Arguments: []ast.Tifier{value, targetType}}, nil
 }
 
-// parseUpdateCommand - Return ast.parseUpdateCommand created from tokens and validate the syntax
-//
-// Example of input parsable to the ast.parseUpdateCommand:
-// UPDATE table SET col1 TO 'value' WHERE col2 EQUAL 10;
-func (parser *Parser) parseUpdateCommand() (ast.Command, error) {
-	// token.UPDATE already at current position in parser
-	updateCommand := &ast.UpdateCommand{Token: parser.currentToken}
+// getExtractCall - Return ast.FunctionCall created from EXTRACT(field FROM value) syntax, name of the field is passed
+// as the first argument
+func (parser *Parser) getExtractCall() (ast.Tifier, error) {
+	functionCall := ast.FunctionCall{Name: parser.currentToken}
 
-	// Ignore token.UPDATE
+	// Skip token.EXTRACT
 	parser.nextToken()
 
-	// Get table name
-	err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
 	if err != nil {
 		return nil, err
 	}
-	updateCommand.Name = ast.Identifier{Token: parser.currentToken}
 
-	// Ignore token.IDENT
+	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	if err != nil {
+		return nil, err
+	}
+	field := ast.Anonymitifier{Token: parser.currentToken}
+
+	// Skip field name
 	parser.nextToken()
 
-	err = validateTokenAndSkip(parser, []token.Type{token.SET})
+	err = validateTokenAndSkip(parser, []token.Type{token.FROM})
 	if err != nil {
 		return nil, err
 	}
 
-	err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
+	value, err := parser.getTifier()
 	if err != nil {
 		return nil, err
 	}
 
-	updateCommand.Changes = make(map[token.Token]ast.Anonymitifier)
-	for parser.currentToken.Type == token.IDENT {
-		// Get column name
-		err := validateToken(parser.currentToken.Type, []token.Type{token.IDENT})
-		if err != nil {
-			return nil, err
-		}
-		colKey := parser.currentToken
+	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
+	}
 
-		// skip column name
+	functionCall.Arguments = []ast.Tifier{field, value}
+	return functionCall, nil
+}
+
+// getTifierWithoutTrailingApostrophe - Return ast.Tifier and validate that it isn't followed by closing apostrophe
+// without opening one
+func (parser *Parser) getTifierWithoutTrailingApostrophe() (ast.Tifier, error) {
+	tifier, err := parser.getTifier()
+	if err != nil {
+		return nil, err
+	}
+
+	finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+	err = validateApostropheWrapping(false, finishedWithApostrophe, tifier.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return tifier, nil
+}
+
+func (parser *Parser) getSingleTifier() (ast.Tifier, error) {
+	switch parser.currentToken.Type {
+	case token.APOSTROPHE:
+		return parser.getTextTifier()
+	case token.CAST:
+		return parser.getCastCall()
+	case token.EXTRACT:
+		return parser.getExtractCall()
+	case token.ARRAY:
+		return parser.getArrayLiteral()
+	case token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL:
+		return parser.getTypedLiteral()
+	case token.MINUS, token.PLUS:
+		operator := parser.currentToken
+		// Skip sign
 		parser.nextToken()
 
-		err = validateToken(parser.currentToken.Type, []token.Type{token.TO})
+		operand, err := parser.getTypecastTifier()
 		if err != nil {
 			return nil, err
 		}
-		// skip token.TO
+		return ast.FunctionCall{Name: operator, Arguments: []ast.Tifier{operand}}, nil
+	case token.LPAREN:
+		// Skip token.LPAREN
 		parser.nextToken()
 
-		startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
-		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.LITERAL, token.NULL})
+		tifier, err := parser.getTifier()
 		if err != nil {
 			return nil, err
 		}
-		updateCommand.Changes[colKey] = ast.Anonymitifier{Token: parser.currentToken}
-
-		// skip token.IDENT, token.LITERAL or token.NULL
-		parser.nextToken()
-		finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
-
-		err = validateApostropheWrapping(startedWithApostrophe, finishedWithApostrophe, updateCommand.Changes[colKey].GetToken())
 
+		err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
 		if err != nil {
 			return nil, err
 		}
-
-		if parser.currentToken.Type != token.COMMA {
-			break
+		return tifier, nil
+	case token.IDENT:
+		if parser.peekToken.Type == token.LPAREN {
+			return parser.getFunctionCall()
 		}
+		identifier := ast.Identifier{Token: parser.currentToken}
+		parser.nextToken()
+		return identifier, nil
+	case token.LITERAL, token.NULL, token.TRUE, token.FALSE, token.HEX:
+		anonymitifier := ast.Anonymitifier{Token: parser.currentToken}
+		parser.nextToken()
+		return anonymitifier, nil
+	default:
+		return nil, &SyntaxError{expecting: []string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, got: parser.currentToken.Literal}
+	}
+}
 
-		// Skip token.COMMA
+// getTextTifier - Return ast.Anonymitifier containing everything between apostrophes
+func (parser *Parser) getTextTifier() (ast.Tifier, error) {
+	startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+
+	value := ""
+	for parser.currentToken.Type != token.EOF && parser.currentToken.Type != token.APOSTROPHE {
+		value += parser.currentToken.Literal
 		parser.nextToken()
 	}
+	text := token.Token{Type: token.IDENT, Literal: value}
 
-	err = validateToken(parser.currentToken.Type, []token.Type{token.SEMICOLON, token.WHERE})
+	finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+
+	err := validateApostropheWrapping(startedWithApostrophe, finishedWithApostrophe, text)
 	if err != nil {
 		return nil, err
 	}
-	parser.skipIfCurrentTokenIsSemicolon()
-	return updateCommand, nil
+	return ast.Anonymitifier{Token: text}, nil
 }
 
-// getExpression - Return proper structure of ast.Expression and validate the syntax
-//
-// Available expressions:
-// - ast.OperationExpression
-// - ast.BooleanExpression
-// - ast.ConditionExpression
-// - ast.ContainExpression
-func (parser *Parser) getExpression() (bool, ast.Expression, error) {
-
-	if parser.currentToken.Type == token.IDENT ||
-		parser.currentToken.Type == token.LITERAL ||
-		parser.currentToken.Type == token.NULL ||
-		parser.currentToken.Type == token.APOSTROPHE ||
-		parser.currentToken.Type == token.TRUE ||
-		parser.currentToken.Type == token.FALSE {
-
-		leftSide, isAnonymitifier, err := parser.getExpressionLeftSideValue()
-		if err != nil {
-			return false, nil, err
-		}
+// getArrayLiteral - Return ast.FunctionCall creating array from elements written in brackets, ex. ARRAY['a', 'b']
+func (parser *Parser) getArrayLiteral() (ast.Tifier, error) {
+	functionCall := ast.FunctionCall{Name: parser.currentToken, Arguments: []ast.Tifier{}}
 
-		isValidExpression := false
-		var expression ast.Expression
+	// Skip token.ARRAY
+	parser.nextToken()
 
-		if parser.currentToken.Type == token.EQUAL || parser.currentToken.Type == token.NOT {
-			isValidExpression, expression, err = parser.getConditionalExpression(leftSide, isAnonymitifier)
-		} else if parser.currentToken.Type == token.IN || parser.currentToken.Type == token.NOTIN {
-			isValidExpression, expression, err = parser.getContainExpression(leftSide, isAnonymitifier)
-		} else if leftSide.Type == token.TRUE || leftSide.Type == token.FALSE {
-			expression = &ast.BooleanExpression{Boolean: leftSide}
-			isValidExpression = true
-			err = nil
-		}
+	err := validateTokenAndSkip(parser, []token.Type{token.LBRACKET})
+	if err != nil {
+		return nil, err
+	}
 
+	for parser.currentToken.Type != token.RBRACKET {
+		element, err := parser.getTifier()
 		if err != nil {
-			return false, nil, err
-		}
-
-		if (parser.currentToken.Type == token.AND || parser.currentToken.Type == token.OR) && isValidExpression {
-			isValidExpression, expression, err = parser.getOperationExpression(expression)
+			return nil, err
 		}
+		functionCall.Arguments = append(functionCall.Arguments, element)
 
-		if err != nil {
-			return false, nil, err
+		if parser.currentToken.Type != token.COMMA {
+			break
 		}
-
-		if isValidExpression {
-			return true, expression, nil
+		// Skip token.COMMA, element is required after it
+		parser.nextToken()
+		if parser.currentToken.Type == token.RBRACKET {
+			return nil, &SyntaxError{expecting: []string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, got: parser.currentToken.Literal}
 		}
 	}
-	return false, nil, nil
-}
 
-func (parser *Parser) getExpressionLeftSideValue() (token.Token, bool, error) {
-	var leftSide token.Token
-	isAnonymitifier := false
-	startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+	err = validateTokenAndSkip(parser, []token.Type{token.RBRACKET})
+	if err != nil {
+		return nil, err
+	}
 
-	if startedWithApostrophe {
-		isAnonymitifier = true
-		value := ""
-		for parser.currentToken.Type != token.EOF && parser.currentToken.Type != token.APOSTROPHE {
-			value += parser.currentToken.Literal
-			parser.nextToken()
-		}
+	return functionCall, nil
+}
 
-		leftSide = token.Token{Type: token.IDENT, Literal: value}
+// getFunctionCall - Return ast.FunctionCall created from tokens and validate the syntax, function name isn't
+// validated there, because functions are registered in engine
+func (parser *Parser) getFunctionCall() (ast.Tifier, error) {
+	functionCall := ast.FunctionCall{Name: parser.currentToken, Arguments: []ast.Tifier{}}
 
-		finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
+	// Skip function name
+	parser.nextToken()
 
-		err := validateApostropheWrapping(startedWithApostrophe, finishedWithApostrophe, leftSide)
+	err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
+	if err != nil {
+		return nil, err
+	}
 
+	for parser.currentToken.Type != token.RPAREN {
+		argument, err := parser.getTifier()
 		if err != nil {
-			return token.Token{}, isAnonymitifier, err
+			return nil, err
 		}
-	} else {
-		leftSide = parser.currentToken
+		functionCall.Arguments = append(functionCall.Arguments, argument)
+
+		if parser.currentToken.Type != token.COMMA {
+			break
+		}
+		// Skip token.COMMA, argument is required after it
 		parser.nextToken()
+		if parser.currentToken.Type == token.RPAREN {
+			return nil, &SyntaxError{expecting: []string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, got: parser.currentToken.Literal}
+		}
+	}
+
+	err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+	if err != nil {
+		return nil, err
 	}
-	return leftSide, isAnonymitifier, nil
+
+	return functionCall, nil
 }
 
 // getOperationExpression - Return ast.OperationExpression created from tokens and validate the syntax
@@ -805,50 +2322,51 @@ func (parser *Parser) getOperationExpression(expression ast.Expression) (bool, *
 }
 
 // getConditionalExpression - Return ast.ConditionExpression created from tokens and validate the syntax
-func (parser *Parser) getConditionalExpression(leftSide token.Token, isAnonymitifier bool) (bool, *ast.ConditionExpression, error) {
-	conditionalExpression := &ast.ConditionExpression{Condition: parser.currentToken}
-
-	if isAnonymitifier {
-		conditionalExpression.Left = ast.Anonymitifier{Token: leftSide}
-	} else {
-		conditionalExpression.Left = ast.Identifier{Token: leftSide}
-	}
+func (parser *Parser) getConditionalExpression(leftSide ast.Tifier) (bool, *ast.ConditionExpression, error) {
+	conditionalExpression := &ast.ConditionExpression{Condition: parser.currentToken, Left: leftSide}
 
-	// skip EQUAL or NOT
+	// skip EQUAL, NOT or comparison operator
 	parser.nextToken()
 
-	if parser.currentToken.Type == token.IDENT || parser.currentToken.Type == token.LITERAL ||
-		parser.currentToken.Type == token.NULL || parser.currentToken.Type == token.APOSTROPHE {
-		startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
-
-		if !startedWithApostrophe && parser.currentToken.Type == token.IDENT {
-			conditionalExpression.Right = ast.Identifier{Token: parser.currentToken}
-		} else {
-			conditionalExpression.Right = ast.Anonymitifier{Token: parser.currentToken}
-		}
+	if parser.currentToken.Type == token.ANY || parser.currentToken.Type == token.ALL {
+		quantifier := parser.currentToken
+		conditionalExpression.Quantifier = &quantifier
+		// skip token.ANY or token.ALL
 		parser.nextToken()
 
-		finishedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
-		err := validateApostropheWrapping(startedWithApostrophe, finishedWithApostrophe, conditionalExpression.Right.GetToken())
+		err := validateTokenAndSkip(parser, []token.Type{token.LPAREN})
 		if err != nil {
 			return false, nil, err
 		}
-	} else {
-		return false, conditionalExpression, &SyntaxError{expecting: []string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, got: parser.currentToken.Literal}
+		conditionalExpression.Right, err = parser.getTifier()
+		if err != nil {
+			return false, nil, err
+		}
+		err = validateTokenAndSkip(parser, []token.Type{token.RPAREN})
+		if err != nil {
+			return false, nil, err
+		}
+		return true, conditionalExpression, nil
 	}
 
+	rightSide, err := parser.getTifierWithoutTrailingApostrophe()
+	if err != nil {
+		return false, nil, err
+	}
+	conditionalExpression.Right = rightSide
+
 	return true, conditionalExpression, nil
 }
 
 // getContainExpression - Return ast.ContainExpression created from tokens and validate the syntax
-func (parser *Parser) getContainExpression(leftSide token.Token, isAnonymitifier bool) (bool, *ast.ContainExpression, error) {
+func (parser *Parser) getContainExpression(leftSide ast.Tifier) (bool, *ast.ContainExpression, error) {
 	containExpression := &ast.ContainExpression{}
 
-	if isAnonymitifier {
-		return false, nil, &SyntaxError{expecting: []string{token.IDENT}, got: "'" + leftSide.Literal + "'"}
+	if _, isAnonymitifier := leftSide.(ast.Anonymitifier); isAnonymitifier {
+		return false, nil, &SyntaxError{expecting: []string{token.IDENT}, got: "'" + leftSide.GetToken().Literal + "'"}
 	}
 
-	containExpression.Left = ast.Identifier{Token: leftSide}
+	containExpression.Left = leftSide
 
 	if parser.currentToken.Type == token.IN {
 		containExpression.Contains = true
@@ -864,12 +2382,14 @@ func (parser *Parser) getContainExpression(leftSide token.Token, isAnonymitifier
 		return false, nil, err
 	}
 
-	for parser.currentToken.Type == token.IDENT || parser.currentToken.Type == token.LITERAL || parser.currentToken.Type == token.NULL || parser.currentToken.Type == token.APOSTROPHE {
+	for parser.currentToken.Type == token.IDENT || parser.currentToken.Type == token.LITERAL || parser.currentToken.Type == token.NULL || parser.currentToken.Type == token.APOSTROPHE || isUnquotedLiteral(parser.currentToken.Type) {
 		startedWithApostrophe := parser.skipIfCurrentTokenIsApostrophe()
 
-		err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.LITERAL, token.NULL})
-		if err != nil {
-			return false, nil, err
+		if !isUnquotedLiteral(parser.currentToken.Type) {
+			err = validateToken(parser.currentToken.Type, []token.Type{token.IDENT, token.LITERAL, token.NULL})
+			if err != nil {
+				return false, nil, err
+			}
 		}
 		currentAnonymitifier := ast.Anonymitifier{Token: parser.currentToken}
 		containExpression.Right = append(containExpression.Right, currentAnonymitifier)
@@ -925,6 +2445,10 @@ func (parser *Parser) ParseSequence() (*ast.Sequence, error) {
 			command, err = parser.parseDeleteCommand()
 		case token.DROP:
 			command, err = parser.parseDropCommand()
+		case token.ALTER:
+			command, err = parser.parseAlterTableCommand()
+		case token.TRUNCATE:
+			command, err = parser.parseTruncateCommand()
 		case token.WHERE:
 			lastCommand, parserError := parser.getLastCommand(sequence, token.WHERE)
 			if parserError != nil {
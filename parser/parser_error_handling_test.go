@@ -12,13 +12,20 @@ type errorHandlingTestSuite struct {
 }
 
 func TestParseCreateCommandErrorHandling(t *testing.T) {
-	noTableKeyword := SyntaxError{[]string{token.TABLE}, token.IDENT}
+	noTableKeyword := SyntaxError{[]string{token.TABLE, token.TYPE, token.SEQUENCE, token.INDEX}, token.IDENT}
 	noTableName := SyntaxError{[]string{token.IDENT}, token.LPAREN}
 	noLeftParen := SyntaxError{[]string{token.LPAREN}, token.IDENT}
 	noRightParen := SyntaxError{[]string{token.RPAREN}, token.SEMICOLON}
 	noColumnName := SyntaxError{[]string{token.RPAREN}, token.TEXT}
-	noColumnType := SyntaxError{[]string{token.TEXT, token.INT}, token.COMMA}
+	noColumnType := SyntaxError{[]string{token.TEXT, token.VARCHAR, token.CHAR, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL, token.BOOLEAN, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.BLOB, token.JSON, token.UUID, token.SERIAL, token.IDENT}, token.COMMA}
 	noSemicolon := SyntaxError{[]string{token.SEMICOLON}, ""}
+	noPrecision := SyntaxError{[]string{token.LITERAL}, token.RPAREN}
+	zeroPrecision := InvalidTypeParameterParserError{columnType: token.DECIMAL, parameter: "0"}
+	scaleGreaterThanPrecision := InvalidTypeParameterParserError{columnType: token.DECIMAL, parameter: "3"}
+	fractionalPrecision := InvalidTypeParameterParserError{columnType: token.DECIMAL, parameter: "1.5"}
+	tooLargePrecision := InvalidTypeParameterParserError{columnType: token.DECIMAL, parameter: "2000000000"}
+	zeroLength := InvalidTypeParameterParserError{columnType: token.VARCHAR, parameter: "0"}
+	tooManyLengths := SyntaxError{[]string{token.RPAREN}, token.COMMA}
 
 	tests := []errorHandlingTestSuite{
 		{"CREATE tbl(one TEXT);", noTableKeyword.Error()},
@@ -28,18 +35,198 @@ func TestParseCreateCommandErrorHandling(t *testing.T) {
 		{"CREATE TABLE tbl (TEXT, two INT);", noColumnName.Error()},
 		{"CREATE TABLE tbl (one , two INT);", noColumnType.Error()},
 		{"CREATE TABLE tbl (one TEXT, two INT)", noSemicolon.Error()},
+		{"CREATE TABLE tbl (one DECIMAL());", noPrecision.Error()},
+		{"CREATE TABLE tbl (one DECIMAL(0));", zeroPrecision.Error()},
+		{"CREATE TABLE tbl (one DECIMAL(2, 3));", scaleGreaterThanPrecision.Error()},
+		{"CREATE TABLE tbl (one DECIMAL(1.5));", fractionalPrecision.Error()},
+		{"CREATE TABLE tbl (one DECIMAL(2000000000, 1000000000));", tooLargePrecision.Error()},
+		{"CREATE TABLE tbl (one VARCHAR(0));", zeroLength.Error()},
+		{"CREATE TABLE tbl (one CHAR(2, 1));", tooManyLengths.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+
+}
+
+func TestParsePrimaryKeyErrorHandling(t *testing.T) {
+	noKeyKeyword := SyntaxError{[]string{token.KEY}, token.COMMA}
+	noLeftParen := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+	noColumnName := SyntaxError{[]string{token.IDENT}, token.RPAREN}
+	noRightParen := SyntaxError{[]string{token.RPAREN}, token.IDENT}
+	multiplePrimaryKeys := MultiplePrimaryKeysParserError{tableName: "tbl"}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one INT PRIMARY, two INT);", noKeyKeyword.Error()},
+		{"CREATE TABLE tbl (one INT, PRIMARY KEY one);", noLeftParen.Error()},
+		{"CREATE TABLE tbl (one INT, PRIMARY KEY ());", noColumnName.Error()},
+		{"CREATE TABLE tbl (one INT, two INT, PRIMARY KEY (one two));", noRightParen.Error()},
+		{"CREATE TABLE tbl (one INT PRIMARY KEY, two INT PRIMARY KEY);", multiplePrimaryKeys.Error()},
+		{"CREATE TABLE tbl (one INT PRIMARY KEY, PRIMARY KEY (one));", multiplePrimaryKeys.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseUniqueConstraintErrorHandling(t *testing.T) {
+	noLeftParen := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+	noColumnName := SyntaxError{[]string{token.IDENT}, token.RPAREN}
+	noRightParen := SyntaxError{[]string{token.RPAREN}, token.IDENT}
+	noDistinctKeyword := SyntaxError{[]string{token.DISTINCT}, token.COMMA}
+	noDistinctAfterNot := SyntaxError{[]string{token.DISTINCT}, token.LPAREN}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one INT, UNIQUE one);", noLeftParen.Error()},
+		{"CREATE TABLE tbl (one INT, UNIQUE ());", noColumnName.Error()},
+		{"CREATE TABLE tbl (one INT, two INT, UNIQUE (one two));", noRightParen.Error()},
+		{"CREATE TABLE tbl (one INT UNIQUE NULLS, two INT);", noDistinctKeyword.Error()},
+		{"CREATE TABLE tbl (one INT, UNIQUE NULLS NOT (one));", noDistinctAfterNot.Error()},
 	}
 
 	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseForeignKeyErrorHandling(t *testing.T) {
+	noKeyKeyword := SyntaxError{[]string{token.KEY}, token.LPAREN}
+	noReferencesKeyword := SyntaxError{[]string{token.REFERENCES}, token.IDENT}
+	noReferencedTable := SyntaxError{[]string{token.IDENT}, token.LPAREN}
+	noActionCommand := SyntaxError{[]string{token.DELETE, token.UPDATE}, token.CASCADE}
+	invalidAction := SyntaxError{[]string{token.CASCADE, token.RESTRICT, token.SET}, token.NULL}
+	noNullAfterSet := SyntaxError{[]string{token.NULL}, token.RPAREN}
+	foreignInColumn := SyntaxError{[]string{token.CHECK, token.UNIQUE, token.REFERENCES}, token.FOREIGN}
+	noSemicolonAfterCascade := SyntaxError{[]string{token.SEMICOLON}, token.IDENT}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one INT, FOREIGN (one) REFERENCES other);", noKeyKeyword.Error()},
+		{"CREATE TABLE tbl (one INT, FOREIGN KEY (one) other);", noReferencesKeyword.Error()},
+		{"CREATE TABLE tbl (one INT REFERENCES (id));", noReferencedTable.Error()},
+		{"CREATE TABLE tbl (one INT REFERENCES other ON CASCADE);", noActionCommand.Error()},
+		{"CREATE TABLE tbl (one INT REFERENCES other ON DELETE NULL);", invalidAction.Error()},
+		{"CREATE TABLE tbl (one INT REFERENCES other ON UPDATE SET);", noNullAfterSet.Error()},
+		{"CREATE TABLE tbl (one INT CONSTRAINT fk FOREIGN KEY (one) REFERENCES other);", foreignInColumn.Error()},
+		{"DROP TABLE tbl CASCADE tbl;", noSemicolonAfterCascade.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseSequenceErrorHandling(t *testing.T) {
+	noSequenceName := SyntaxError{[]string{token.IDENT}, token.START}
+	invalidOption := SyntaxError{[]string{token.INCREMENT, token.MINVALUE, token.MAXVALUE, token.START, token.CYCLE, token.NO, token.SEMICOLON}, token.IDENT}
+	noOptionValue := SyntaxError{[]string{token.LITERAL}, token.SEMICOLON}
+	notIntegerValue := InvalidSequenceOptionParserError{option: token.INCREMENT, value: "1.5"}
+	invalidOptionAfterNo := SyntaxError{[]string{token.MINVALUE, token.MAXVALUE, token.CYCLE}, token.START}
+	noIdentityKind := SyntaxError{[]string{token.ALWAYS, token.BY}, token.AS}
+	noDefaultAfterBy := SyntaxError{[]string{token.DEFAULT}, token.AS}
+	noIdentityKeyword := SyntaxError{[]string{token.IDENTITY}, token.RPAREN}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE SEQUENCE START 1;", noSequenceName.Error()},
+		{"CREATE SEQUENCE ids STEP 1;", invalidOption.Error()},
+		{"CREATE SEQUENCE ids START WITH;", noOptionValue.Error()},
+		{"CREATE SEQUENCE ids INCREMENT BY 1.5;", notIntegerValue.Error()},
+		{"CREATE SEQUENCE ids NO START;", invalidOptionAfterNo.Error()},
+		{"CREATE TABLE tbl (one INT GENERATED AS IDENTITY);", noIdentityKind.Error()},
+		{"CREATE TABLE tbl (one INT GENERATED BY AS IDENTITY);", noDefaultAfterBy.Error()},
+		{"CREATE TABLE tbl (one INT GENERATED ALWAYS AS);", noIdentityKeyword.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseIndexErrorHandling(t *testing.T) {
+	noOnKeyword := SyntaxError{[]string{token.ON}, token.LPAREN}
+	noTableName := SyntaxError{[]string{token.IDENT}, token.LPAREN}
+	invalidMethod := SyntaxError{[]string{token.BTREE, token.HASH}, token.IDENT}
+	noColumn := SyntaxError{[]string{token.IDENT}, token.RPAREN}
+	noCommaBetweenColumns := SyntaxError{[]string{token.RPAREN}, token.IDENT}
+	noParenAfterInclude := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+	noSemicolonAfterColumns := SyntaxError{[]string{token.SEMICOLON}, token.IDENT}
+	noDroppedIndexName := SyntaxError{[]string{token.IDENT}, token.SEMICOLON}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE INDEX idx (one);", noOnKeyword.Error()},
+		{"CREATE INDEX idx ON (one);", noTableName.Error()},
+		{"CREATE INDEX idx ON tbl USING gist (one);", invalidMethod.Error()},
+		{"CREATE INDEX idx ON tbl ();", noColumn.Error()},
+		{"CREATE INDEX idx ON tbl (one two);", noCommaBetweenColumns.Error()},
+		{"CREATE INDEX idx ON tbl (one) INCLUDE two;", noParenAfterInclude.Error()},
+		{"CREATE INDEX idx ON tbl (one) two;", noSemicolonAfterColumns.Error()},
+		{"DROP INDEX;", noDroppedIndexName.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseAlterTableErrorHandling(t *testing.T) {
+	noTableKeyword := SyntaxError{[]string{token.TABLE}, token.IDENT}
+	noTableName := SyntaxError{[]string{token.IDENT}, token.ADD}
+	invalidAction := SyntaxError{[]string{token.ADD, token.DROP, token.RENAME, token.ALTER}, token.IDENT}
+	noAddedColumnType := SyntaxError{[]string{token.TEXT, token.VARCHAR, token.CHAR, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL, token.BOOLEAN, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.BLOB, token.JSON, token.UUID, token.SERIAL, token.IDENT}, token.SEMICOLON}
+	noDroppedColumn := SyntaxError{[]string{token.IDENT}, token.SEMICOLON}
+	noToKeyword := SyntaxError{[]string{token.TO}, token.IDENT}
+	noNewName := SyntaxError{[]string{token.IDENT}, token.SEMICOLON}
+	noTypeKeyword := SyntaxError{[]string{token.TYPE}, token.INT}
+	noNewType := SyntaxError{[]string{token.TEXT, token.VARCHAR, token.CHAR, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL, token.BOOLEAN, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.BLOB, token.JSON, token.UUID, token.SERIAL, token.IDENT}, token.SEMICOLON}
+	noSemicolon := SyntaxError{[]string{token.SEMICOLON}, token.IDENT}
+	periodInNewName := IllegalPeriodInIdentParserError{name: "other.tbl"}
+
+	tests := []errorHandlingTestSuite{
+		{"ALTER tbl ADD COLUMN one INT;", noTableKeyword.Error()},
+		{"ALTER TABLE ADD COLUMN one INT;", noTableName.Error()},
+		{"ALTER TABLE tbl CHANGE one INT;", invalidAction.Error()},
+		{"ALTER TABLE tbl ADD COLUMN one;", noAddedColumnType.Error()},
+		{"ALTER TABLE tbl DROP COLUMN;", noDroppedColumn.Error()},
+		{"ALTER TABLE tbl RENAME COLUMN one first;", noToKeyword.Error()},
+		{"ALTER TABLE tbl RENAME TO;", noNewName.Error()},
+		{"ALTER TABLE tbl ALTER COLUMN one INT;", noTypeKeyword.Error()},
+		{"ALTER TABLE tbl ALTER COLUMN one TYPE;", noNewType.Error()},
+		{"ALTER TABLE tbl DROP COLUMN one two;", noSemicolon.Error()},
+		{"ALTER TABLE tbl RENAME TO other.tbl;", periodInNewName.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseColumnConstraintsErrorHandling(t *testing.T) {
+	noNullAfterNot := SyntaxError{[]string{token.NULL}, token.COMMA}
+	noDefaultValue := SyntaxError{[]string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, token.COMMA}
+	noLeftParenAfterCheck := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+	noRightParenAfterCheck := SyntaxError{[]string{token.RPAREN}, token.COMMA}
+	noCheckCondition := LogicalExpressionParsingError{}
+	noConstraintName := SyntaxError{[]string{token.IDENT}, token.CHECK}
+	noCheckAfterName := SyntaxError{[]string{token.CHECK, token.UNIQUE, token.FOREIGN}, token.LPAREN}
+	noInsertColumnName := SyntaxError{[]string{token.IDENT}, token.RPAREN}
+	noRightParenAfterColumns := SyntaxError{[]string{token.RPAREN}, token.VALUES}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one INT NOT, two INT);", noNullAfterNot.Error()},
+		{"CREATE TABLE tbl (one INT DEFAULT, two INT);", noDefaultValue.Error()},
+		{"CREATE TABLE tbl (one INT CHECK one > 0);", noLeftParenAfterCheck.Error()},
+		{"CREATE TABLE tbl (one INT CHECK (one > 0, two INT);", noRightParenAfterCheck.Error()},
+		{"CREATE TABLE tbl (one INT CHECK ());", noCheckCondition.Error()},
+		{"CREATE TABLE tbl (one INT, CONSTRAINT CHECK (one > 0));", noConstraintName.Error()},
+		{"CREATE TABLE tbl (one INT, CONSTRAINT positive (one > 0));", noCheckAfterName.Error()},
+		{"INSERT INTO tbl () VALUES (1);", noInsertColumnName.Error()},
+		{"INSERT INTO tbl (one VALUES (1);", noRightParenAfterColumns.Error()},
+	}
 
+	runParserErrorHandlingSuite(t, tests)
 }
 
 func TestParseDropCommandErrorHandling(t *testing.T) {
-	missingTableKeywordError := SyntaxError{expecting: []string{token.TABLE}, got: token.IDENT}
+	missingTableKeywordError := SyntaxError{expecting: []string{token.TABLE, token.INDEX}, got: token.IDENT}
 	missingDropKeywordError := SyntaxInvalidCommandError{token.TABLE}
 	missingSemicolonError := &SyntaxError{expecting: []string{token.SEMICOLON}, got: ""}
 	invalidIdentError := &SyntaxError{expecting: []string{token.IDENT}, got: token.LITERAL}
+	noExistsAfterIf := &SyntaxError{expecting: []string{token.EXISTS}, got: token.IDENT}
+	notInDropIfExists := &SyntaxError{expecting: []string{token.EXISTS}, got: token.NOT}
+	noNotInCreateIfNotExists := &SyntaxError{expecting: []string{token.NOT}, got: token.EXISTS}
+	noTableNameAfterIfExists := &SyntaxError{expecting: []string{token.IDENT}, got: token.SEMICOLON}
 	tests := []errorHandlingTestSuite{
+		{input: "DROP TABLE IF table;", expectedError: noExistsAfterIf.Error()},
+		{input: "DROP TABLE IF NOT EXISTS table;", expectedError: notInDropIfExists.Error()},
+		{input: "CREATE TABLE IF EXISTS table( one INT );", expectedError: noNotInCreateIfNotExists.Error()},
+		{input: "DROP TABLE IF EXISTS;", expectedError: noTableNameAfterIfExists.Error()},
 		{input: "DROP table;", expectedError: missingTableKeywordError.Error()},
 		{input: "TABLE table;", expectedError: missingDropKeywordError.Error()},
 		{input: "DROP TABLE table", expectedError: missingSemicolonError.Error()},
@@ -49,6 +236,56 @@ func TestParseDropCommandErrorHandling(t *testing.T) {
 	runParserErrorHandlingSuite(t, tests)
 }
 
+func TestParseCreateTypeCommandErrorHandling(t *testing.T) {
+	noTypeName := SyntaxError{[]string{token.IDENT}, token.AS}
+	noAs := SyntaxError{[]string{token.AS}, token.ENUM}
+	noEnum := SyntaxError{[]string{token.ENUM}, token.LPAREN}
+	noLeftParen := SyntaxError{[]string{token.LPAREN}, token.APOSTROPHE}
+	unquotedLabel := SyntaxError{[]string{token.APOSTROPHE}, token.IDENT}
+	emptyLabel := SyntaxError{[]string{token.IDENT}, token.APOSTROPHE}
+	noRightParen := SyntaxError{[]string{token.RPAREN}, token.SEMICOLON}
+	noSemicolon := SyntaxError{[]string{token.SEMICOLON}, ""}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TYPE AS ENUM ('new');", noTypeName.Error()},
+		{"CREATE TYPE status ENUM ('new');", noAs.Error()},
+		{"CREATE TYPE status AS ('new');", noEnum.Error()},
+		{"CREATE TYPE status AS ENUM 'new';", noLeftParen.Error()},
+		{"CREATE TYPE status AS ENUM (new);", unquotedLabel.Error()},
+		{"CREATE TYPE status AS ENUM ('');", emptyLabel.Error()},
+		{"CREATE TYPE status AS ENUM ('new';", noRightParen.Error()},
+		{"CREATE TYPE status AS ENUM ('new')", noSemicolon.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseArrayErrorHandling(t *testing.T) {
+	noRightBracketInType := SyntaxError{[]string{token.RBRACKET}, token.RPAREN}
+	noLeftBracket := SyntaxError{[]string{token.LBRACKET}, token.LPAREN}
+	noRightBracket := SyntaxError{[]string{token.RBRACKET}, token.FROM}
+	noElementAfterComma := SyntaxError{[]string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, "]"}
+	noRightBracketAfterIndex := SyntaxError{[]string{token.RBRACKET}, token.FROM}
+	noLeftParenAfterAny := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+	noRightParenAfterAll := SyntaxError{[]string{token.RPAREN}, token.SEMICOLON}
+	columnInsertedIntoArray := SyntaxError{[]string{token.APOSTROPHE, token.LITERAL, token.NULL}, "one"}
+	noUnnestAlias := SyntaxError{[]string{token.IDENT}, token.LITERAL}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one TEXT[);", noRightBracketInType.Error()},
+		{"SELECT ARRAY('a') FROM tbl;", noLeftBracket.Error()},
+		{"SELECT ARRAY['a' FROM tbl;", noRightBracket.Error()},
+		{"SELECT ARRAY[1, ] FROM tbl;", noElementAfterComma.Error()},
+		{"SELECT one[1 FROM tbl;", noRightBracketAfterIndex.Error()},
+		{"SELECT * FROM tbl WHERE 'a' EQUAL ANY one;", noLeftParenAfterAny.Error()},
+		{"SELECT * FROM tbl WHERE 1 < ALL(one;", noRightParenAfterAll.Error()},
+		{"INSERT INTO tbl VALUES(ARRAY[one]);", columnInsertedIntoArray.Error()},
+		{"SELECT * FROM UNNEST(one) AS 5;", noUnnestAlias.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
 func TestParseInsertCommandErrorHandling(t *testing.T) {
 	noIntoKeyword := SyntaxError{[]string{token.INTO}, token.IDENT}
 	noTableName := SyntaxError{[]string{token.IDENT}, token.VALUES}
@@ -103,7 +340,7 @@ func TestParseUpdateCommandErrorHandling(t *testing.T) {
 
 func TestParseSelectCommandErrorHandling(t *testing.T) {
 	noFromKeyword := SyntaxError{[]string{token.FROM}, token.IDENT}
-	noColumns := SyntaxError{[]string{token.ASTERISK, token.IDENT, token.MAX, token.MIN, token.SUM, token.AVG, token.COUNT}, token.FROM}
+	noColumns := SyntaxError{[]string{token.ASTERISK, token.IDENT, token.MAX, token.MIN, token.SUM, token.AVG, token.COUNT, token.BOOL_AND, token.BOOL_OR}, token.FROM}
 	noTableName := SyntaxError{[]string{token.IDENT}, token.SEMICOLON}
 	noSemicolon := SyntaxError{[]string{token.SEMICOLON, token.WHERE, token.ORDER, token.LIMIT, token.OFFSET, token.JOIN, token.LEFT, token.RIGHT, token.INNER, token.FULL}, ""}
 	noAggregateFunctionParenClosure := SyntaxError{[]string{token.RPAREN}, ","}
@@ -221,12 +458,26 @@ func TestParseOffsetCommandErrorHandling(t *testing.T) {
 func TestParseDeleteCommandErrorHandling(t *testing.T) {
 	noFromKeyword := SyntaxError{[]string{token.FROM}, token.IDENT}
 	noTableName := SyntaxError{[]string{token.IDENT}, token.WHERE}
-	noWhereCommand := SyntaxError{[]string{token.WHERE}, ";"}
+	noWhereCommandOrSemicolon := SyntaxError{[]string{token.WHERE, token.SEMICOLON}, token.IDENT}
 
 	tests := []errorHandlingTestSuite{
 		{"DELETE table WHERE TRUE", noFromKeyword.Error()},
 		{"DELETE FROM WHERE TRUE;", noTableName.Error()},
-		{"DELETE FROM table;", noWhereCommand.Error()},
+		{"DELETE FROM table two;", noWhereCommandOrSemicolon.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseTruncateCommandErrorHandling(t *testing.T) {
+	noTableName := SyntaxError{[]string{token.IDENT}, token.SEMICOLON}
+	noTableNameAfterComma := SyntaxError{[]string{token.IDENT}, token.CASCADE}
+	noSemicolon := SyntaxError{[]string{token.SEMICOLON}, token.IDENT}
+
+	tests := []errorHandlingTestSuite{
+		{"TRUNCATE TABLE;", noTableName.Error()},
+		{"TRUNCATE tbl, CASCADE;", noTableNameAfterComma.Error()},
+		{"TRUNCATE TABLE tbl other;", noSemicolon.Error()},
 	}
 
 	runParserErrorHandlingSuite(t, tests)
@@ -244,6 +495,55 @@ func TestPeriodInIdentWhileCreatingTableErrorHandling(t *testing.T) {
 	runParserErrorHandlingSuite(t, tests)
 }
 
+func TestParseFunctionCallErrorHandling(t *testing.T) {
+	noRightParen := SyntaxError{[]string{token.RPAREN}, token.FROM}
+	noArgumentAfterComma := SyntaxError{[]string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, token.RPAREN}
+	noRightSideOfConcat := SyntaxError{[]string{token.APOSTROPHE, token.IDENT, token.LITERAL, token.NULL}, token.FROM}
+
+	tests := []errorHandlingTestSuite{
+		{"SELECT UPPER(one FROM tbl;", noRightParen.Error()},
+		{"SELECT SUBSTR(one, ) FROM tbl;", noArgumentAfterComma.Error()},
+		{"SELECT one || FROM tbl;", noRightSideOfConcat.Error()},
+		{"SELECT * FROM tbl WHERE UPPER(one, ) EQUAL 'A';", noArgumentAfterComma.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseTypeConversionErrorHandling(t *testing.T) {
+	noAsKeyword := SyntaxError{[]string{token.AS}, token.INT}
+	noTypeAfterAs := SyntaxError{[]string{token.TEXT, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.JSON, token.UUID}, token.IDENT}
+	noTypeAfterTypecast := SyntaxError{[]string{token.TEXT, token.SMALLINT, token.INT, token.BIGINT, token.FLOAT, token.REAL, token.DECIMAL, token.DATE, token.TIME, token.TIMESTAMP, token.TIMESTAMPTZ, token.INTERVAL, token.JSON, token.UUID}, token.FROM}
+	noRightParen := SyntaxError{[]string{token.RPAREN}, token.FROM}
+	noLeftParen := SyntaxError{[]string{token.LPAREN}, token.IDENT}
+
+	tests := []errorHandlingTestSuite{
+		{"SELECT CAST(one INT) FROM tbl;", noAsKeyword.Error()},
+		{"SELECT CAST(one AS number) FROM tbl;", noTypeAfterAs.Error()},
+		{"SELECT one:: FROM tbl;", noTypeAfterTypecast.Error()},
+		{"SELECT CAST(one AS INT FROM tbl;", noRightParen.Error()},
+		{"SELECT * FROM tbl WHERE CAST one AS INT EQUAL 1;", noLeftParen.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
+func TestParseTemporalTypesErrorHandling(t *testing.T) {
+	noTimeKeyword := SyntaxError{[]string{token.TIME}, token.ZONE}
+	noFromKeyword := SyntaxError{[]string{token.FROM}, token.IDENT}
+	noFieldName := SyntaxError{[]string{token.IDENT}, token.APOSTROPHE}
+	noTextAfterType := SyntaxError{[]string{token.APOSTROPHE}, token.LITERAL}
+
+	tests := []errorHandlingTestSuite{
+		{"CREATE TABLE tbl (one TIMESTAMP WITH ZONE);", noTimeKeyword.Error()},
+		{"SELECT EXTRACT(year one) FROM tbl;", noFromKeyword.Error()},
+		{"SELECT EXTRACT('year' FROM one) FROM tbl;", noFieldName.Error()},
+		{"SELECT * FROM tbl WHERE one > DATE 5;", noTextAfterType.Error()},
+	}
+
+	runParserErrorHandlingSuite(t, tests)
+}
+
 func runParserErrorHandlingSuite(t *testing.T, suite []errorHandlingTestSuite) {
 	for i, test := range suite {
 		errorMsg := getErrorMessage(t, test.input, i)
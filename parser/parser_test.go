@@ -18,6 +18,7 @@ func TestParserCreateCommand(t *testing.T) {
 		{"CREATE TABLE 	TBL( ONE TEXT );", "TBL", []string{"ONE"}, []token.Token{{Type: token.TEXT, Literal: "TEXT"}}},
 		{"CREATE TABLE 	TBL( ONE TEXT,  TWO TEXT, THREE INT);", "TBL", []string{"ONE", "TWO", "THREE"}, []token.Token{{Type: token.TEXT, Literal: "TEXT"}, {Type: token.TEXT, Literal: "TEXT"}, {Type: token.INT, Literal: "INT"}}},
 		{"CREATE TABLE 	TBL(  );", "TBL", []string{}, []token.Token{}},
+		{"CREATE TABLE 	TBL( ONE BOOLEAN );", "TBL", []string{"ONE"}, []token.Token{{Type: token.BOOLEAN, Literal: "BOOLEAN"}}},
 	}
 
 	for testIndex, tt := range tests {
@@ -38,6 +39,440 @@ func TestParserCreateCommand(t *testing.T) {
 	}
 }
 
+func TestParserCreateCommandWithNumericTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one DECIMAL(10, 2), two FLOAT, three REAL, four DECIMAL(5), five DECIMAL );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.DECIMAL, Literal: "DECIMAL"},
+		{Type: token.FLOAT, Literal: "FLOAT"},
+		{Type: token.REAL, Literal: "REAL"},
+		{Type: token.DECIMAL, Literal: "DECIMAL"},
+		{Type: token.DECIMAL, Literal: "DECIMAL"},
+	}
+	expectedTypeParameters := [][]int{{10, 2}, nil, nil, {5}, nil}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if !testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two", "three", "four", "five"}, expectedColumnTypes) {
+		return
+	}
+
+	testCreateTypeParameters(t, sequences.Commands[0].(*ast.CreateCommand), expectedTypeParameters)
+}
+
+func TestParserCreateCommandWithCharacterTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one VARCHAR(255), two CHAR(3), three VARCHAR, four CHAR );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.VARCHAR, Literal: "VARCHAR"},
+		{Type: token.CHAR, Literal: "CHAR"},
+		{Type: token.VARCHAR, Literal: "VARCHAR"},
+		{Type: token.CHAR, Literal: "CHAR"},
+	}
+	expectedTypeParameters := [][]int{{255}, {3}, nil, nil}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if !testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two", "three", "four"}, expectedColumnTypes) {
+		return
+	}
+
+	testCreateTypeParameters(t, sequences.Commands[0].(*ast.CreateCommand), expectedTypeParameters)
+}
+
+func testCreateTypeParameters(t *testing.T, createCommand *ast.CreateCommand, expectedTypeParameters [][]int) {
+	if len(createCommand.ColumnTypeParameters) != len(expectedTypeParameters) {
+		t.Fatalf("Expected %d type parameters, got: %d", len(expectedTypeParameters), len(createCommand.ColumnTypeParameters))
+	}
+	for i, expectedParameters := range expectedTypeParameters {
+		actualParameters := createCommand.ColumnTypeParameters[i]
+		if len(actualParameters) != len(expectedParameters) {
+			t.Fatalf("[%d] Expected type parameters %v, got: %v", i, expectedParameters, actualParameters)
+		}
+		for j := range expectedParameters {
+			if actualParameters[j] != expectedParameters[j] {
+				t.Errorf("[%d] Expected type parameters %v, got: %v", i, expectedParameters, actualParameters)
+			}
+		}
+	}
+}
+
+func TestParserCreateCommandWithTemporalTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one DATE, two TIME, three TIMESTAMP, four TIMESTAMP WITH TIME ZONE, five TIMESTAMPTZ, six INTERVAL );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.DATE, Literal: "DATE"},
+		{Type: token.TIME, Literal: "TIME"},
+		{Type: token.TIMESTAMP, Literal: "TIMESTAMP"},
+		{Type: token.TIMESTAMPTZ, Literal: "TIMESTAMPTZ"},
+		{Type: token.TIMESTAMPTZ, Literal: "TIMESTAMPTZ"},
+		{Type: token.INTERVAL, Literal: "INTERVAL"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two", "three", "four", "five", "six"}, expectedColumnTypes)
+}
+
+func TestParserCreateCommandWithBlobTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one BLOB, two BYTEA );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.BLOB, Literal: "BLOB"},
+		{Type: token.BLOB, Literal: "BYTEA"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two"}, expectedColumnTypes)
+}
+
+func TestParserCreateCommandWithUuidType(t *testing.T) {
+	input := "CREATE TABLE tbl( one UUID, two TEXT );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.UUID, Literal: "UUID"},
+		{Type: token.TEXT, Literal: "TEXT"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two"}, expectedColumnTypes)
+}
+
+func TestParserCreateCommandWithArrayTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one TEXT[], two INT, three VARCHAR(10)[] );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.TEXT, Literal: "TEXT"},
+		{Type: token.INT, Literal: "INT"},
+		{Type: token.VARCHAR, Literal: "VARCHAR"},
+	}
+	expectedColumnIsArray := []bool{true, false, true}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two", "three"}, expectedColumnTypes)
+
+	createCommand := sequences.Commands[0].(*ast.CreateCommand)
+	for i, isArray := range expectedColumnIsArray {
+		if createCommand.ColumnIsArray[i] != isArray {
+			t.Errorf("[%d] Column should be array: %t, got=%t", i, isArray, createCommand.ColumnIsArray[i])
+		}
+	}
+}
+
+func TestParserCreateCommandWithPrimaryKey(t *testing.T) {
+	tests := []struct {
+		input              string
+		expectedColumns    []string
+		expectedPrimaryKey []string
+	}{
+		{"CREATE TABLE tbl( one INT PRIMARY KEY, two TEXT );", []string{"one", "two"}, []string{"one"}},
+		{"CREATE TABLE tbl( one INT, two TEXT PRIMARY KEY );", []string{"one", "two"}, []string{"two"}},
+		{"CREATE TABLE tbl( one INT, two TEXT, PRIMARY KEY (two, one) );", []string{"one", "two"}, []string{"two", "one"}},
+		{"CREATE TABLE tbl( PRIMARY KEY (one), one INT );", []string{"one"}, []string{"one"}},
+		{"CREATE TABLE tbl( one INT, two TEXT );", []string{"one", "two"}, nil},
+	}
+
+	for testIndex, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("[%d] Got error from parser: %s", testIndex, err)
+		}
+
+		createCommand := sequences.Commands[0].(*ast.CreateCommand)
+		if !stringArrayEquals(createCommand.ColumnNames, tt.expectedColumns) {
+			t.Errorf("[%d] Column names should be %v, got=%v", testIndex, tt.expectedColumns, createCommand.ColumnNames)
+		}
+		if !stringArrayEquals(createCommand.PrimaryKey, tt.expectedPrimaryKey) {
+			t.Errorf("[%d] Primary key should be %v, got=%v", testIndex, tt.expectedPrimaryKey, createCommand.PrimaryKey)
+		}
+	}
+}
+
+func TestParserCreateCommandWithColumnConstraints(t *testing.T) {
+	input := "CREATE TABLE tbl( one INT NOT NULL CHECK (one > 0), two TEXT DEFAULT 'a' NOT NULL, three INT DEFAULT 1 + 2, CONSTRAINT positive CHECK (three >= one) );"
+	expectedNotNull := []bool{true, true, false}
+	expectedDefaults := []string{"", "'a'", "1 + 2"}
+	expectedChecks := []struct {
+		name       string
+		columnName string
+		condition  ast.ConditionExpression
+	}{
+		{
+			name:       "",
+			columnName: "one",
+			condition: ast.ConditionExpression{
+				Left:      ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}},
+				Right:     ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "0"}},
+				Condition: token.Token{Type: token.GT, Literal: token.GT},
+			},
+		},
+		{
+			name:       "positive",
+			columnName: "",
+			condition: ast.ConditionExpression{
+				Left:      ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "three"}},
+				Right:     ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}},
+				Condition: token.Token{Type: token.GTE, Literal: token.GTE},
+			},
+		},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	createCommand := sequences.Commands[0].(*ast.CreateCommand)
+	for i := range expectedNotNull {
+		if createCommand.ColumnNotNull[i] != expectedNotNull[i] {
+			t.Errorf("[%d] Column should be NOT NULL: %t, got=%t", i, expectedNotNull[i], createCommand.ColumnNotNull[i])
+		}
+		actualDefault := ""
+		if createCommand.ColumnDefaults[i] != nil {
+			actualDefault = ast.TifierToString(createCommand.ColumnDefaults[i])
+		}
+		if actualDefault != expectedDefaults[i] {
+			t.Errorf("[%d] Default value should be %q, got=%q", i, expectedDefaults[i], actualDefault)
+		}
+	}
+
+	if len(createCommand.Checks) != len(expectedChecks) {
+		t.Fatalf("Create command should contain %d checks, got=%d", len(expectedChecks), len(createCommand.Checks))
+	}
+	for i, expectedCheck := range expectedChecks {
+		actualCheck := createCommand.Checks[i]
+		if actualCheck.Name != expectedCheck.name || actualCheck.ColumnName != expectedCheck.columnName {
+			t.Errorf("[%d] Check should have name %q and column %q, got=%q and %q", i, expectedCheck.name, expectedCheck.columnName, actualCheck.Name, actualCheck.ColumnName)
+		}
+		if !expressionsAreEqual(actualCheck.Condition, expectedCheck.condition) {
+			t.Errorf("[%d] Check condition is not equal to expected one.\nActual: %#v\nExpected: %#v", i, actualCheck.Condition, expectedCheck.condition)
+		}
+	}
+}
+
+func TestParserCreateCommandWithUniqueConstraints(t *testing.T) {
+	input := "CREATE TABLE tbl( one INT UNIQUE, two TEXT UNIQUE NULLS NOT DISTINCT, three INT, CONSTRAINT unique_pair UNIQUE NULLS DISTINCT (one, three) );"
+	expectedUniques := []ast.UniqueConstraint{
+		{Name: "", ColumnNames: []string{"one"}, NullsNotDistinct: false},
+		{Name: "", ColumnNames: []string{"two"}, NullsNotDistinct: true},
+		{Name: "unique_pair", ColumnNames: []string{"one", "three"}, NullsNotDistinct: false},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	createCommand := sequences.Commands[0].(*ast.CreateCommand)
+	if len(createCommand.UniqueConstraints) != len(expectedUniques) {
+		t.Fatalf("Create command should contain %d unique constraints, got=%d", len(expectedUniques), len(createCommand.UniqueConstraints))
+	}
+	for i, expectedUnique := range expectedUniques {
+		actualUnique := createCommand.UniqueConstraints[i]
+		if actualUnique.Name != expectedUnique.Name || actualUnique.NullsNotDistinct != expectedUnique.NullsNotDistinct {
+			t.Errorf("[%d] Unique constraint should have name %q and NullsNotDistinct %t, got=%q and %t", i, expectedUnique.Name, expectedUnique.NullsNotDistinct, actualUnique.Name, actualUnique.NullsNotDistinct)
+		}
+		if !stringArrayEquals(actualUnique.ColumnNames, expectedUnique.ColumnNames) {
+			t.Errorf("[%d] Unique constraint should have columns %v, got=%v", i, expectedUnique.ColumnNames, actualUnique.ColumnNames)
+		}
+	}
+}
+
+func TestParserCreateCommandWithForeignKeys(t *testing.T) {
+	input := "CREATE TABLE orders( id INT, user_id INT REFERENCES users ON DELETE CASCADE, shop TEXT, item INT, CONSTRAINT fk_item FOREIGN KEY (shop, item) REFERENCES items (shop, id) ON UPDATE SET NULL ON DELETE RESTRICT );"
+	expectedForeignKeys := []ast.ForeignKeyConstraint{
+		{Name: "", ColumnNames: []string{"user_id"}, ReferencedTable: "users", ReferencedColumnNames: nil, OnDelete: ast.Cascade, OnUpdate: ast.Restrict},
+		{Name: "fk_item", ColumnNames: []string{"shop", "item"}, ReferencedTable: "items", ReferencedColumnNames: []string{"shop", "id"}, OnDelete: ast.Restrict, OnUpdate: ast.SetNull},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	createCommand := sequences.Commands[0].(*ast.CreateCommand)
+	if len(createCommand.ForeignKeys) != len(expectedForeignKeys) {
+		t.Fatalf("Create command should contain %d foreign keys, got=%d", len(expectedForeignKeys), len(createCommand.ForeignKeys))
+	}
+	for i, expected := range expectedForeignKeys {
+		actual := createCommand.ForeignKeys[i]
+		if actual.Name != expected.Name || actual.ReferencedTable != expected.ReferencedTable {
+			t.Errorf("[%d] Foreign key should have name %q and refer to %q, got=%q and %q", i, expected.Name, expected.ReferencedTable, actual.Name, actual.ReferencedTable)
+		}
+		if !stringArrayEquals(actual.ColumnNames, expected.ColumnNames) || !stringArrayEquals(actual.ReferencedColumnNames, expected.ReferencedColumnNames) {
+			t.Errorf("[%d] Foreign key should map %v to %v, got=%v to %v", i, expected.ColumnNames, expected.ReferencedColumnNames, actual.ColumnNames, actual.ReferencedColumnNames)
+		}
+		if actual.OnDelete != expected.OnDelete || actual.OnUpdate != expected.OnUpdate {
+			t.Errorf("[%d] Foreign key should have actions %q and %q, got=%q and %q", i, expected.OnDelete, expected.OnUpdate, actual.OnDelete, actual.OnUpdate)
+		}
+	}
+}
+
+func TestParserCreateSequenceCommand(t *testing.T) {
+	tests := []struct {
+		input             string
+		expectedIncrement *int64
+		expectedMinValue  *int64
+		expectedMaxValue  *int64
+		expectedStart     *int64
+		expectedCycle     bool
+	}{
+		{"CREATE SEQUENCE ids;", nil, nil, nil, nil, false},
+		{"CREATE SEQUENCE ids INCREMENT BY -2 MINVALUE -100 MAXVALUE 0 START WITH -1 CYCLE;", int64Pointer(-2), int64Pointer(-100), int64Pointer(0), int64Pointer(-1), true},
+		{"CREATE SEQUENCE ids START 5 INCREMENT 3 MAXVALUE 10 NO MAXVALUE CYCLE NO CYCLE;", int64Pointer(3), nil, nil, int64Pointer(5), false},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		command, ok := sequences.Commands[0].(*ast.CreateSequenceCommand)
+		if !ok {
+			t.Fatalf("Command is not %T. got=%T", &ast.CreateSequenceCommand{}, sequences.Commands[0])
+		}
+		if command.Name.Token.Literal != "ids" {
+			t.Errorf("Name of sequence should be ids, got=%s", command.Name.Token.Literal)
+		}
+		options := []struct {
+			name     string
+			actual   *int64
+			expected *int64
+		}{
+			{"INCREMENT", command.Increment, tt.expectedIncrement},
+			{"MINVALUE", command.MinValue, tt.expectedMinValue},
+			{"MAXVALUE", command.MaxValue, tt.expectedMaxValue},
+			{"START", command.Start, tt.expectedStart},
+		}
+		for _, option := range options {
+			if (option.actual == nil) != (option.expected == nil) || (option.actual != nil && *option.actual != *option.expected) {
+				t.Errorf("%q: option %s is not equal to expected one, got=%v, expected=%v", tt.input, option.name, option.actual, option.expected)
+			}
+		}
+		if command.Cycle != tt.expectedCycle {
+			t.Errorf("%q: Cycle should be %t, got=%t", tt.input, tt.expectedCycle, command.Cycle)
+		}
+	}
+}
+
+func TestParserCreateCommandWithIdentityColumns(t *testing.T) {
+	input := "CREATE TABLE tbl( one SERIAL, two INT GENERATED ALWAYS AS IDENTITY, three INT GENERATED BY DEFAULT AS IDENTITY NOT NULL );"
+	expectedTypes := []token.Type{token.SERIAL, token.INT, token.INT}
+	expectedIdentities := []ast.Identity{ast.NoIdentity, ast.IdentityAlways, ast.IdentityByDefault}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	createCommand := sequences.Commands[0].(*ast.CreateCommand)
+	for i := range expectedIdentities {
+		if createCommand.ColumnTypes[i].Type != expectedTypes[i] {
+			t.Errorf("[%d] Column should have type %s, got=%s", i, expectedTypes[i], createCommand.ColumnTypes[i].Type)
+		}
+		if createCommand.ColumnIdentities[i] != expectedIdentities[i] {
+			t.Errorf("[%d] Column should have identity %q, got=%q", i, expectedIdentities[i], createCommand.ColumnIdentities[i])
+		}
+	}
+	if !createCommand.ColumnNotNull[2] {
+		t.Errorf("Constraints written after identity should be parsed")
+	}
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func TestParserCreateTypeCommand(t *testing.T) {
+	input := "CREATE TYPE status AS ENUM ('new', 'in progress', 'closed'); CREATE TABLE tickets( id INT, state status );"
+	expectedLabels := []string{"new", "in progress", "closed"}
+	expectedColumnTypes := []token.Token{
+		{Type: token.INT, Literal: "INT"},
+		{Type: token.IDENT, Literal: "status"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+	if len(sequences.Commands) != 2 {
+		t.Fatalf("sequences does not contain 2 statements. got=%d", len(sequences.Commands))
+	}
+
+	createTypeCommand, ok := sequences.Commands[0].(*ast.CreateTypeCommand)
+	if !ok {
+		t.Fatalf("actualCreateTypeCommand is not %T. got=%T", &ast.CreateTypeCommand{}, sequences.Commands[0])
+	}
+	if createTypeCommand.Name.Token.Literal != "status" {
+		t.Errorf("%s != %s", createTypeCommand.Name.Token.Literal, "status")
+	}
+	if !stringArrayEquals(createTypeCommand.Labels, expectedLabels) {
+		t.Errorf("Expected labels %v, got: %v", expectedLabels, createTypeCommand.Labels)
+	}
+
+	testCreateStatement(t, sequences.Commands[1], "tickets", []string{"id", "state"}, expectedColumnTypes)
+}
+
+func TestParserCreateCommandWithIntegerTypes(t *testing.T) {
+	input := "CREATE TABLE tbl( one SMALLINT, two INT, three BIGINT );"
+	expectedColumnTypes := []token.Token{
+		{Type: token.SMALLINT, Literal: "SMALLINT"},
+		{Type: token.INT, Literal: "INT"},
+		{Type: token.BIGINT, Literal: "BIGINT"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	testCreateStatement(t, sequences.Commands[0], "tbl", []string{"one", "two", "three"}, expectedColumnTypes)
+}
+
 func testCreateStatement(t *testing.T, command ast.Command, expectedTableName string, expectedColumnNames []string, expectedColumTypes []token.Token) bool {
 	if command.TokenLiteral() != "CREATE" {
 		t.Errorf("command.TokenLiteral() not 'CREATE'. got=%q", command.TokenLiteral())
@@ -78,6 +513,9 @@ func TestParseInsertCommand(t *testing.T) {
 		{"INSERT INTO TBL VALUES( 'HELLO' );", "TBL", []token.Token{{Type: token.IDENT, Literal: "HELLO"}}},
 		{"INSERT INTO TBL VALUES( 'HELLO',	 10 , 'LOL');", "TBL", []token.Token{{Type: token.IDENT, Literal: "HELLO"}, {Type: token.LITERAL, Literal: "10"}, {Type: token.IDENT, Literal: "LOL"}}},
 		{"INSERT INTO TBL VALUES(NULL, 'NULL', null);", "TBL", []token.Token{{Type: token.NULL, Literal: "NULL"}, {Type: token.IDENT, Literal: "NULL"}, {Type: token.IDENT, Literal: "null"}}},
+		{"INSERT INTO TBL VALUES(TRUE, FALSE, 'TRUE');", "TBL", []token.Token{{Type: token.TRUE, Literal: "TRUE"}, {Type: token.FALSE, Literal: "FALSE"}, {Type: token.IDENT, Literal: "TRUE"}}},
+		{"INSERT INTO TBL VALUES(X'DEADBEEF', x'', 'X');", "TBL", []token.Token{{Type: token.HEX, Literal: "DEADBEEF"}, {Type: token.HEX, Literal: ""}, {Type: token.IDENT, Literal: "X"}}},
+		{"INSERT INTO TBL VALUES(ARRAY['a', 'b \"c\"', NULL], ARRAY[1, -2.5], ARRAY[]);", "TBL", []token.Token{{Type: token.ARRAY, Literal: `{"a","b \"c\"",NULL}`}, {Type: token.ARRAY, Literal: "{1,-2.5}"}, {Type: token.ARRAY, Literal: "{}"}}},
 	}
 
 	for testIndex, tt := range tests {
@@ -98,6 +536,66 @@ func TestParseInsertCommand(t *testing.T) {
 	}
 }
 
+func TestParseInsertCommandWithColumnNames(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedColumnNames  []string
+		expectedValuesTokens []token.Token
+	}{
+		{"INSERT INTO tbl (one, two) VALUES( 1, 'a' );", []string{"one", "two"}, []token.Token{{Type: token.LITERAL, Literal: "1"}, {Type: token.IDENT, Literal: "a"}}},
+		{"INSERT INTO tbl (two) VALUES( DEFAULT );", []string{"two"}, []token.Token{{Type: token.DEFAULT, Literal: "DEFAULT"}}},
+		{"INSERT INTO tbl VALUES( DEFAULT, 1 );", nil, []token.Token{{Type: token.DEFAULT, Literal: "DEFAULT"}, {Type: token.LITERAL, Literal: "1"}}},
+	}
+
+	for testIndex, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("[%d] Got error from parser: %s", testIndex, err)
+		}
+
+		if !testInsertStatement(t, sequences.Commands[0], "tbl", tt.expectedValuesTokens) {
+			return
+		}
+		insertCommand := sequences.Commands[0].(*ast.InsertCommand)
+		if !stringArrayEquals(insertCommand.ColumnNames, tt.expectedColumnNames) {
+			t.Errorf("[%d] Column names should be %v, got=%v", testIndex, tt.expectedColumnNames, insertCommand.ColumnNames)
+		}
+	}
+}
+
+func TestParseInsertCommandWithExpressions(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedValues []string
+	}{
+		{"INSERT INTO tbl VALUES( 1, 'a' );", []string{"1", "'a'"}},
+		{"INSERT INTO tbl VALUES( GEN_RANDOM_UUID(), 'Anna' );", []string{"GEN_RANDOM_UUID()", "'Anna'"}},
+		{"INSERT INTO tbl VALUES( 'a', NEXTVAL('seq') * 10, DATE '2024-01-31' );", []string{"'a'", "NEXTVAL('seq') * 10", "'2024-01-31'::DATE"}},
+		{"INSERT INTO tbl VALUES( -(1), UPPER('a') || 'b', DEFAULT );", []string{"-1", "UPPER('a') || 'b'", "DEFAULT"}},
+	}
+
+	for testIndex, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("[%d] Got error from parser: %s", testIndex, err)
+		}
+
+		insertCommand := sequences.Commands[0].(*ast.InsertCommand)
+		if len(insertCommand.Values) != len(tt.expectedValues) {
+			t.Fatalf("[%d] Expected %d values, got=%d", testIndex, len(tt.expectedValues), len(insertCommand.Values))
+		}
+		for position, expectedValue := range tt.expectedValues {
+			if ast.TifierToString(insertCommand.Values[position]) != expectedValue {
+				t.Errorf("[%d] Value in position %d should be %s, got=%s", testIndex, position, expectedValue, ast.TifierToString(insertCommand.Values[position]))
+			}
+		}
+	}
+}
+
 func testInsertStatement(t *testing.T, command ast.Command, expectedTableName string, expectedValuesTokens []token.Token) bool {
 	if command.TokenLiteral() != "INSERT" {
 		t.Errorf("command.TokenLiteral() not 'INSERT'. got=%q", command.TokenLiteral())
@@ -115,7 +613,11 @@ func testInsertStatement(t *testing.T, command ast.Command, expectedTableName st
 		return false
 	}
 
-	if !tokenArrayEquals(actualInsertCommand.Values, expectedValuesTokens) {
+	valuesTokens := make([]token.Token, 0, len(actualInsertCommand.Values))
+	for _, value := range actualInsertCommand.Values {
+		valuesTokens = append(valuesTokens, value.GetToken())
+	}
+	if !tokenArrayEquals(valuesTokens, expectedValuesTokens) {
 		t.Errorf("")
 		return false
 	}
@@ -190,6 +692,18 @@ func TestParseWhereCommand(t *testing.T) {
 		Condition: token.Token{Type: token.EQUAL, Literal: "EQUAL"},
 	}
 
+	sixthExpression := ast.ConditionExpression{
+		Left: ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "colName6"}},
+		Right: ast.FunctionCall{
+			Name: token.Token{Type: token.TYPECAST, Literal: token.TYPECAST},
+			Arguments: []ast.Tifier{
+				ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "2024-01-31"}},
+				ast.Anonymitifier{Token: token.Token{Type: token.DATE, Literal: "DATE"}},
+			},
+		},
+		Condition: token.Token{Type: token.GTE, Literal: ">="},
+	}
+
 	tests := []struct {
 		input              string
 		expectedExpression ast.Expression
@@ -218,6 +732,10 @@ func TestParseWhereCommand(t *testing.T) {
 			input:              "SELECT * FROM TBL WHERE colName5 EQUAL NULL;",
 			expectedExpression: fifthExpression,
 		},
+		{
+			input:              "SELECT * FROM TBL WHERE colName6 >= DATE '2024-01-31';",
+			expectedExpression: sixthExpression,
+		},
 	}
 
 	for testIndex, tt := range tests {
@@ -288,13 +806,300 @@ func TestParseDeleteCommand(t *testing.T) {
 	}
 }
 
-func TestParseDropCommand(t *testing.T) {
-	input := "DROP TABLE table;"
-	expectedDropCommand := ast.DropCommand{
-		Token: token.Token{Type: token.DROP, Literal: "DROP"},
-		Name:  ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "table"}},
-	}
-
+func TestParseDeleteCommandWithoutWhere(t *testing.T) {
+	input := "DELETE FROM tbl;"
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	actualDeleteCommand, ok := sequences.Commands[0].(*ast.DeleteCommand)
+	if !ok {
+		t.Fatalf("actualDeleteCommand is not %T. got=%T", &ast.DeleteCommand{}, sequences.Commands[0])
+	}
+
+	if actualDeleteCommand.Name.GetToken().Literal != "tbl" {
+		t.Errorf("Table name of DeleteCommand is not tbl. got=%s", actualDeleteCommand.Name.GetToken().Literal)
+	}
+
+	if actualDeleteCommand.HasWhereCommand() {
+		t.Errorf("DeleteCommand shouldn't contain where command")
+	}
+}
+
+func TestParseTruncateCommand(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedNames   []string
+		expectedCascade bool
+	}{
+		{"TRUNCATE TABLE tbl;", []string{"tbl"}, false},
+		{"TRUNCATE tbl;", []string{"tbl"}, false},
+		{"TRUNCATE TABLE tbl, other;", []string{"tbl", "other"}, false},
+		{"TRUNCATE tbl CASCADE;", []string{"tbl"}, true},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		if len(sequences.Commands) != 1 {
+			t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+		}
+
+		truncateCommand, ok := sequences.Commands[0].(*ast.TruncateCommand)
+		if !ok {
+			t.Fatalf("truncateCommand is not %T. got=%T", &ast.TruncateCommand{}, sequences.Commands[0])
+		}
+
+		names := make([]string, 0, len(truncateCommand.Names))
+		for _, name := range truncateCommand.Names {
+			names = append(names, name.Token.Literal)
+		}
+		if !stringArrayEquals(names, tt.expectedNames) {
+			t.Errorf("Tables of TruncateCommand for %q should be %v, got=%v", tt.input, tt.expectedNames, names)
+		}
+		if truncateCommand.Cascade != tt.expectedCascade {
+			t.Errorf("Cascade of TruncateCommand for %q should be %t, got=%t", tt.input, tt.expectedCascade, truncateCommand.Cascade)
+		}
+	}
+}
+
+func TestParseDropCommand(t *testing.T) {
+	input := "DROP TABLE table;"
+	expectedDropCommand := ast.DropCommand{
+		Token: token.Token{Type: token.DROP, Literal: "DROP"},
+		Name:  ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "table"}},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	actualDropCommand, ok := sequences.Commands[0].(*ast.DropCommand)
+	if !ok {
+		t.Errorf("actualDropCommand is not %T. got=%T", &ast.DropCommand{}, sequences.Commands[0])
+	}
+
+	if expectedDropCommand.TokenLiteral() != actualDropCommand.TokenLiteral() {
+		t.Errorf("TokenLiteral of DropCommand is not %s. got=%s", expectedDropCommand.TokenLiteral(), actualDropCommand.TokenLiteral())
+	}
+
+	if expectedDropCommand.Name.GetToken().Literal != actualDropCommand.Name.GetToken().Literal {
+		t.Errorf("Table name of DropCommand is not %s. got=%s", expectedDropCommand.Name.GetToken().Literal, actualDropCommand.Name.GetToken().Literal)
+	}
+}
+
+func TestParseDropCommandWithCascade(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedCascade bool
+	}{
+		{"DROP TABLE users;", false},
+		{"DROP TABLE users CASCADE;", true},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		dropCommand := sequences.Commands[0].(*ast.DropCommand)
+		if dropCommand.Cascade != tt.expectedCascade {
+			t.Errorf("Cascade of DropCommand for %q should be %t, got=%t", tt.input, tt.expectedCascade, dropCommand.Cascade)
+		}
+	}
+}
+
+func TestParseIfExistsOptions(t *testing.T) {
+	tests := []struct {
+		input            string
+		expectedName     string
+		expectedIfExists bool
+	}{
+		{"CREATE TABLE tbl( one INT );", "tbl", false},
+		{"CREATE TABLE IF NOT EXISTS tbl( one INT );", "tbl", true},
+		{"DROP TABLE tbl;", "tbl", false},
+		{"DROP TABLE IF EXISTS tbl CASCADE;", "tbl", true},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		var name string
+		var ifExists bool
+		switch command := sequences.Commands[0].(type) {
+		case *ast.CreateCommand:
+			name, ifExists = command.Name.Token.Literal, command.IfNotExists
+		case *ast.DropCommand:
+			name, ifExists = command.Name.Token.Literal, command.IfExists
+		default:
+			t.Fatalf("Command for %q is not CREATE TABLE nor DROP TABLE. got=%T", tt.input, command)
+		}
+		if name != tt.expectedName {
+			t.Errorf("Table name for %q should be %s, got=%s", tt.input, tt.expectedName, name)
+		}
+		if ifExists != tt.expectedIfExists {
+			t.Errorf("IF [NOT] EXISTS for %q should be %t, got=%t", tt.input, tt.expectedIfExists, ifExists)
+		}
+	}
+}
+
+func TestParserCreateIndexCommand(t *testing.T) {
+	tests := []struct {
+		input                   string
+		expectedName            string
+		expectedTable           string
+		expectedColumns         []string
+		expectedIncludedColumns []string
+		expectedMethod          ast.IndexMethod
+	}{
+		{"CREATE INDEX idx ON users (email);", "idx", "users", []string{"email"}, nil, ast.BTreeIndex},
+		{"CREATE INDEX ON users USING HASH (email);", "", "users", []string{"email"}, nil, ast.HashIndex},
+		{"CREATE INDEX by_age ON users USING BTREE (age);", "by_age", "users", []string{"age"}, nil, ast.BTreeIndex},
+		{"CREATE INDEX ON users (tenant, created);", "", "users", []string{"tenant", "created"}, nil, ast.BTreeIndex},
+		{"CREATE INDEX idx ON users (tenant) INCLUDE (email, age);", "idx", "users", []string{"tenant"}, []string{"email", "age"}, ast.BTreeIndex},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		command, ok := sequences.Commands[0].(*ast.CreateIndexCommand)
+		if !ok {
+			t.Fatalf("Command is not %T. got=%T", &ast.CreateIndexCommand{}, sequences.Commands[0])
+		}
+		name := ""
+		if command.Name != nil {
+			name = command.Name.Token.Literal
+		}
+		if name != tt.expectedName {
+			t.Errorf("Name of index for %q should be %q, got=%q", tt.input, tt.expectedName, name)
+		}
+		if command.TableName.Token.Literal != tt.expectedTable {
+			t.Errorf("Table of index for %q should be %s, got=%s", tt.input, tt.expectedTable, command.TableName.Token.Literal)
+		}
+		if !stringArrayEquals(command.ColumnNames, tt.expectedColumns) {
+			t.Errorf("Columns of index for %q should be %v, got=%v", tt.input, tt.expectedColumns, command.ColumnNames)
+		}
+		if !stringArrayEquals(command.IncludedColumnNames, tt.expectedIncludedColumns) {
+			t.Errorf("Included columns of index for %q should be %v, got=%v", tt.input, tt.expectedIncludedColumns, command.IncludedColumnNames)
+		}
+		if command.Method != tt.expectedMethod {
+			t.Errorf("Method of index for %q should be %s, got=%s", tt.input, tt.expectedMethod, command.Method)
+		}
+	}
+}
+
+func TestParseDropIndexCommand(t *testing.T) {
+	lexer := lexer.RunLexer("DROP INDEX idx;")
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	command, ok := sequences.Commands[0].(*ast.DropIndexCommand)
+	if !ok {
+		t.Fatalf("Command is not %T. got=%T", &ast.DropIndexCommand{}, sequences.Commands[0])
+	}
+	if command.Name.Token.Literal != "idx" {
+		t.Errorf("Name of dropped index should be idx, got=%s", command.Name.Token.Literal)
+	}
+}
+
+func TestParseAlterTableCommand(t *testing.T) {
+	tests := []struct {
+		input              string
+		expectedAction     ast.AlterTableAction
+		expectedColumnName string
+		expectedNewName    string
+		expectedType       token.Type
+		expectedParameters []int
+		expectedIsArray    bool
+		expectedCascade    bool
+	}{
+		{"ALTER TABLE tbl DROP COLUMN one;", ast.DropColumn, "one", "", "", nil, false, false},
+		{"ALTER TABLE tbl DROP one CASCADE;", ast.DropColumn, "one", "", "", nil, false, true},
+		{"ALTER TABLE tbl RENAME COLUMN one TO first;", ast.RenameColumn, "one", "first", "", nil, false, false},
+		{"ALTER TABLE tbl RENAME one TO first;", ast.RenameColumn, "one", "first", "", nil, false, false},
+		{"ALTER TABLE tbl RENAME TO other;", ast.RenameTable, "", "other", "", nil, false, false},
+		{"ALTER TABLE tbl ALTER COLUMN one TYPE DECIMAL(10, 2);", ast.AlterColumnType, "one", "", token.DECIMAL, []int{10, 2}, false, false},
+		{"ALTER TABLE tbl ALTER one TYPE TEXT[];", ast.AlterColumnType, "one", "", token.TEXT, nil, true, false},
+		{"ALTER TABLE tbl ALTER COLUMN one TYPE TIMESTAMP WITH TIME ZONE;", ast.AlterColumnType, "one", "", token.TIMESTAMPTZ, nil, false, false},
+	}
+
+	for _, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("Got error from parser: %s", err)
+		}
+
+		command, ok := sequences.Commands[0].(*ast.AlterTableCommand)
+		if !ok {
+			t.Fatalf("Command is not %T. got=%T", &ast.AlterTableCommand{}, sequences.Commands[0])
+		}
+		if command.Name.Token.Literal != "tbl" {
+			t.Errorf("Altered table for %q should be tbl, got=%s", tt.input, command.Name.Token.Literal)
+		}
+		if command.Action != tt.expectedAction {
+			t.Errorf("Action for %q should be %s, got=%s", tt.input, tt.expectedAction, command.Action)
+		}
+		if command.ColumnName != tt.expectedColumnName {
+			t.Errorf("Column for %q should be %q, got=%q", tt.input, tt.expectedColumnName, command.ColumnName)
+		}
+		if command.NewName != tt.expectedNewName {
+			t.Errorf("New name for %q should be %q, got=%q", tt.input, tt.expectedNewName, command.NewName)
+		}
+		if command.ColumnType.Type != tt.expectedType {
+			t.Errorf("New type for %q should be %s, got=%s", tt.input, tt.expectedType, command.ColumnType.Type)
+		}
+		if len(command.TypeParameters) != len(tt.expectedParameters) {
+			t.Errorf("Type parameters for %q should be %v, got=%v", tt.input, tt.expectedParameters, command.TypeParameters)
+		}
+		if command.IsArray != tt.expectedIsArray {
+			t.Errorf("Array type for %q should be %t, got=%t", tt.input, tt.expectedIsArray, command.IsArray)
+		}
+		if command.Cascade != tt.expectedCascade {
+			t.Errorf("CASCADE for %q should be %t, got=%t", tt.input, tt.expectedCascade, command.Cascade)
+		}
+	}
+}
+
+func TestParseAlterTableAddColumnCommand(t *testing.T) {
+	input := "ALTER TABLE tbl ADD COLUMN three VARCHAR(5) NOT NULL DEFAULT 'a' UNIQUE REFERENCES other (id);"
 	lexer := lexer.RunLexer(input)
 	parserInstance := New(lexer)
 	sequences, err := parserInstance.ParseSequence()
@@ -302,21 +1107,31 @@ func TestParseDropCommand(t *testing.T) {
 		t.Fatalf("Got error from parser: %s", err)
 	}
 
-	if len(sequences.Commands) != 1 {
-		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
-	}
-
-	actualDropCommand, ok := sequences.Commands[0].(*ast.DropCommand)
+	command, ok := sequences.Commands[0].(*ast.AlterTableCommand)
 	if !ok {
-		t.Errorf("actualDropCommand is not %T. got=%T", &ast.DropCommand{}, sequences.Commands[0])
+		t.Fatalf("Command is not %T. got=%T", &ast.AlterTableCommand{}, sequences.Commands[0])
 	}
-
-	if expectedDropCommand.TokenLiteral() != actualDropCommand.TokenLiteral() {
-		t.Errorf("TokenLiteral of DropCommand is not %s. got=%s", expectedDropCommand.TokenLiteral(), actualDropCommand.TokenLiteral())
+	if command.Action != ast.AddColumn {
+		t.Fatalf("Action should be %s, got=%s", ast.AddColumn, command.Action)
 	}
-
-	if expectedDropCommand.Name.GetToken().Literal != actualDropCommand.Name.GetToken().Literal {
-		t.Errorf("Table name of DropCommand is not %s. got=%s", expectedDropCommand.Name.GetToken().Literal, actualDropCommand.Name.GetToken().Literal)
+	definition := command.Definition
+	if definition.Name.Token.Literal != "tbl" {
+		t.Errorf("Table of added column should be tbl, got=%s", definition.Name.Token.Literal)
+	}
+	if !stringArrayEquals(definition.ColumnNames, []string{"three"}) {
+		t.Errorf("Added columns should be [three], got=%v", definition.ColumnNames)
+	}
+	if definition.ColumnTypes[0].Type != token.VARCHAR || len(definition.ColumnTypeParameters[0]) != 1 || definition.ColumnTypeParameters[0][0] != 5 {
+		t.Errorf("Type of added column should be VARCHAR(5), got=%s%v", definition.ColumnTypes[0].Literal, definition.ColumnTypeParameters[0])
+	}
+	if !definition.ColumnNotNull[0] {
+		t.Errorf("Added column should be NOT NULL")
+	}
+	if definition.ColumnDefaults[0] == nil || definition.ColumnDefaults[0].GetToken().Literal != "a" {
+		t.Errorf("Default value of added column should be a, got=%v", definition.ColumnDefaults[0])
+	}
+	if len(definition.UniqueConstraints) != 1 || len(definition.ForeignKeys) != 1 {
+		t.Errorf("Added column should have one UNIQUE and one FOREIGN KEY constraint, got=%d and %d", len(definition.UniqueConstraints), len(definition.ForeignKeys))
 	}
 }
 
@@ -660,7 +1475,7 @@ func TestSelectWithFullJoinCommand(t *testing.T) {
 }
 
 func TestSelectWithAggregateFunctions(t *testing.T) {
-	input := "SELECT MIN(colOne), MAX(colOne), COUNT(*), COUNT(colOne), SUM(colOne), AVG(colOne) FROM tbl;"
+	input := "SELECT MIN(colOne), MAX(colOne), COUNT(*), COUNT(colOne), SUM(colOne), AVG(colOne), BOOL_AND(colTwo), BOOL_OR(colTwo) FROM tbl;"
 
 	expectedTableName := "tbl"
 	expectedSpaces := []ast.Space{
@@ -688,6 +1503,14 @@ func TestSelectWithAggregateFunctions(t *testing.T) {
 			ColumnName:    token.Token{Type: token.IDENT, Literal: "colOne"},
 			AggregateFunc: &token.Token{Type: token.AVG, Literal: "AVG"},
 		},
+		{
+			ColumnName:    token.Token{Type: token.IDENT, Literal: "colTwo"},
+			AggregateFunc: &token.Token{Type: token.BOOL_AND, Literal: "BOOL_AND"},
+		},
+		{
+			ColumnName:    token.Token{Type: token.IDENT, Literal: "colTwo"},
+			AggregateFunc: &token.Token{Type: token.BOOL_OR, Literal: "BOOL_OR"},
+		},
 	}
 
 	lexer := lexer.RunLexer(input)
@@ -708,27 +1531,420 @@ func TestSelectWithAggregateFunctions(t *testing.T) {
 	}
 }
 
+func TestSelectWithScalarFunctions(t *testing.T) {
+	input := "SELECT one, UPPER(one), SUBSTR(one || 'x', 2, two) FROM tbl WHERE LOWER(one) EQUAL 'hi' ORDER BY LENGTH(one) DESC;"
+	upperFunction := ast.FunctionCall{
+		Name:      token.Token{Type: token.IDENT, Literal: "UPPER"},
+		Arguments: []ast.Tifier{ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}}},
+	}
+	substrFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.IDENT, Literal: "SUBSTR"},
+		Arguments: []ast.Tifier{
+			ast.FunctionCall{
+				Name: token.Token{Type: token.CONCAT, Literal: token.CONCAT},
+				Arguments: []ast.Tifier{
+					ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}},
+					ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "x"}},
+				},
+			},
+			ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "2"}},
+			ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "two"}},
+		},
+	}
+	lengthFunction := ast.FunctionCall{
+		Name:      token.Token{Type: token.IDENT, Literal: "LENGTH"},
+		Arguments: []ast.Tifier{ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}}},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "one"}},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "UPPER(one)"}, Function: &upperFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "SUBSTR(one || 'x', 2, two)"}, Function: &substrFunction},
+	}
+	expectedWhereExpression := ast.ConditionExpression{
+		Left: ast.FunctionCall{
+			Name:      token.Token{Type: token.IDENT, Literal: "LOWER"},
+			Arguments: []ast.Tifier{ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}}},
+		},
+		Right:     ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "hi"}},
+		Condition: token.Token{Type: token.EQUAL, Literal: "EQUAL"},
+	}
+	expectedOrderByCommand := ast.OrderByCommand{
+		Token: token.Token{Type: token.ORDER, Literal: "ORDER"},
+		SortPatterns: []ast.SortPattern{{
+			ColumnName: token.Token{Type: token.IDENT, Literal: "LENGTH(one)"},
+			Order:      token.Token{Type: token.DESC, Literal: "DESC"},
+			Function:   &lengthFunction,
+		}},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+
+	if !testSelectStatement(t, selectCommand, "tbl", expectedSpaces, false) {
+		return
+	}
+
+	actualWhereExpression, ok := selectCommand.WhereCommand.Expression.(*ast.ConditionExpression)
+	if !ok || !expressionsAreEqual(&expectedWhereExpression, *actualWhereExpression) ||
+		ast.TifierToString(actualWhereExpression.Left) != ast.TifierToString(expectedWhereExpression.Left) {
+		t.Fatalf("Actual expression is not equal to expected one.\nActual: %#v\nExpected: %#v", selectCommand.WhereCommand.Expression, expectedWhereExpression)
+	}
+
+	testOrderByCommands(t, expectedOrderByCommand, selectCommand.OrderByCommand)
+	if selectCommand.OrderByCommand.SortPatterns[0].Function.String() != lengthFunction.String() {
+		t.Errorf("Expecting sort function: %s, got: %s", lengthFunction.String(), selectCommand.OrderByCommand.SortPatterns[0].Function.String())
+	}
+}
+
+func TestSelectWithTypeConversion(t *testing.T) {
+	input := "SELECT CAST(one AS INT), two::TEXT || 'x' FROM tbl ORDER BY one::INT ASC;"
+	castFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.CAST, Literal: "CAST"},
+		Arguments: []ast.Tifier{
+			ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}},
+			ast.Anonymitifier{Token: token.Token{Type: token.INT, Literal: "INT"}},
+		},
+	}
+	concatFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.CONCAT, Literal: token.CONCAT},
+		Arguments: []ast.Tifier{
+			ast.FunctionCall{
+				Name: token.Token{Type: token.TYPECAST, Literal: token.TYPECAST},
+				Arguments: []ast.Tifier{
+					ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "two"}},
+					ast.Anonymitifier{Token: token.Token{Type: token.TEXT, Literal: "TEXT"}},
+				},
+			},
+			ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "x"}},
+		},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "CAST(one AS INT)"}, Function: &castFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "two::TEXT || 'x'"}, Function: &concatFunction},
+	}
+	expectedOrderByCommand := ast.OrderByCommand{
+		Token: token.Token{Type: token.ORDER, Literal: "ORDER"},
+		SortPatterns: []ast.SortPattern{{
+			ColumnName: token.Token{Type: token.IDENT, Literal: "one::INT"},
+			Order:      token.Token{Type: token.ASC, Literal: "ASC"},
+		}},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+
+	if !testSelectStatement(t, selectCommand, "tbl", expectedSpaces, false) {
+		return
+	}
+
+	testOrderByCommands(t, expectedOrderByCommand, selectCommand.OrderByCommand)
+}
+
+func TestSelectWithArithmeticOperators(t *testing.T) {
+	input := "SELECT one + two * -3, (one - 1.5) / 2e1 FROM tbl ORDER BY -one ASC;"
+	one := ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}}
+	two := ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "two"}}
+	additionFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.PLUS, Literal: token.PLUS},
+		Arguments: []ast.Tifier{
+			one,
+			ast.FunctionCall{
+				Name:      token.Token{Type: token.ASTERISK, Literal: token.ASTERISK},
+				Arguments: []ast.Tifier{two, ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "-3"}}},
+			},
+		},
+	}
+	divisionFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.SLASH, Literal: token.SLASH},
+		Arguments: []ast.Tifier{
+			ast.FunctionCall{
+				Name:      token.Token{Type: token.MINUS, Literal: token.MINUS},
+				Arguments: []ast.Tifier{one, ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "1.5"}}},
+			},
+			ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "2e1"}},
+		},
+	}
+	negationFunction := ast.FunctionCall{
+		Name:      token.Token{Type: token.MINUS, Literal: token.MINUS},
+		Arguments: []ast.Tifier{one},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "one + two * -3"}, Function: &additionFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "(one - 1.5) / 2e1"}, Function: &divisionFunction},
+	}
+	expectedOrderByCommand := ast.OrderByCommand{
+		Token: token.Token{Type: token.ORDER, Literal: "ORDER"},
+		SortPatterns: []ast.SortPattern{{
+			ColumnName: token.Token{Type: token.IDENT, Literal: "-one"},
+			Order:      token.Token{Type: token.ASC, Literal: "ASC"},
+			Function:   &negationFunction,
+		}},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+
+	if !testSelectStatement(t, selectCommand, "tbl", expectedSpaces, false) {
+		return
+	}
+
+	testOrderByCommands(t, expectedOrderByCommand, selectCommand.OrderByCommand)
+}
+
+func TestSelectWithJsonOperators(t *testing.T) {
+	input := "SELECT payload->'user'->>'name', (payload || '')->0 FROM tbl ORDER BY payload->>'age' DESC;"
+	payload := ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "payload"}}
+	nameFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.DOUBLE_ARROW, Literal: token.DOUBLE_ARROW},
+		Arguments: []ast.Tifier{
+			ast.FunctionCall{
+				Name:      token.Token{Type: token.ARROW, Literal: token.ARROW},
+				Arguments: []ast.Tifier{payload, ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "user"}}},
+			},
+			ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "name"}},
+		},
+	}
+	elementFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.ARROW, Literal: token.ARROW},
+		Arguments: []ast.Tifier{
+			ast.FunctionCall{
+				Name:      token.Token{Type: token.CONCAT, Literal: token.CONCAT},
+				Arguments: []ast.Tifier{payload, ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: ""}}},
+			},
+			ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "0"}},
+		},
+	}
+	ageFunction := ast.FunctionCall{
+		Name:      token.Token{Type: token.DOUBLE_ARROW, Literal: token.DOUBLE_ARROW},
+		Arguments: []ast.Tifier{payload, ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "age"}}},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "payload->'user'->>'name'"}, Function: &nameFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "(payload || '')->0"}, Function: &elementFunction},
+	}
+	expectedOrderByCommand := ast.OrderByCommand{
+		Token: token.Token{Type: token.ORDER, Literal: "ORDER"},
+		SortPatterns: []ast.SortPattern{{
+			ColumnName: token.Token{Type: token.IDENT, Literal: "payload->>'age'"},
+			Order:      token.Token{Type: token.DESC, Literal: "DESC"},
+			Function:   &ageFunction,
+		}},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+
+	if !testSelectStatement(t, selectCommand, "tbl", expectedSpaces, false) {
+		return
+	}
+
+	testOrderByCommands(t, expectedOrderByCommand, selectCommand.OrderByCommand)
+}
+
+func TestSelectWithArrayOperators(t *testing.T) {
+	input := "SELECT tags[1], ARRAY['a', NULL] FROM tbl WHERE 'a' EQUAL ANY(tags);"
+	tags := ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "tags"}}
+	subscriptFunction := ast.FunctionCall{
+		Name:      token.Token{Type: token.LBRACKET, Literal: token.LBRACKET},
+		Arguments: []ast.Tifier{tags, ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "1"}}},
+	}
+	arrayFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.ARRAY, Literal: "ARRAY"},
+		Arguments: []ast.Tifier{
+			ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "a"}},
+			ast.Anonymitifier{Token: token.Token{Type: token.NULL, Literal: "NULL"}},
+		},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "tags[1]"}, Function: &subscriptFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "ARRAY['a', NULL]"}, Function: &arrayFunction},
+	}
+	expectedWhereExpression := ast.ConditionExpression{
+		Left:       ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "a"}},
+		Right:      tags,
+		Condition:  token.Token{Type: token.EQUAL, Literal: "EQUAL"},
+		Quantifier: &token.Token{Type: token.ANY, Literal: "ANY"},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+
+	if !testSelectStatement(t, selectCommand, "tbl", expectedSpaces, false) {
+		return
+	}
+
+	whereStatementIsValid(t, selectCommand.WhereCommand, expectedWhereExpression)
+}
+
+func TestSelectFromUnnest(t *testing.T) {
+	tests := []struct {
+		input             string
+		expectedTableName string
+	}{
+		{"SELECT * FROM UNNEST(ARRAY[1, 2]);", "unnest"},
+		{"SELECT tag FROM UNNEST(tags) AS tag WHERE tag EQUAL 'a';", "tag"},
+	}
+
+	for testIndex, tt := range tests {
+		lexer := lexer.RunLexer(tt.input)
+		parserInstance := New(lexer)
+		sequences, err := parserInstance.ParseSequence()
+		if err != nil {
+			t.Fatalf("[%d] Got error from parser: %s", testIndex, err)
+		}
+
+		selectCommand := sequences.Commands[0].(*ast.SelectCommand)
+		if selectCommand.Name.Token.Literal != tt.expectedTableName {
+			t.Errorf("[%d] Table name should be %s, got=%s", testIndex, tt.expectedTableName, selectCommand.Name.Token.Literal)
+		}
+		if selectCommand.TableFunction == nil || selectCommand.TableFunction.Name.Type != token.UNNEST {
+			t.Errorf("[%d] Table function should be UNNEST, got=%v", testIndex, selectCommand.TableFunction)
+		}
+	}
+}
+
+func TestSelectWithTemporalExpressions(t *testing.T) {
+	input := "SELECT EXTRACT(year FROM one), one + INTERVAL '1 day', CAST(two AS TIMESTAMP WITH TIME ZONE) FROM tbl;"
+	one := ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "one"}}
+	extractFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.EXTRACT, Literal: "EXTRACT"},
+		Arguments: []ast.Tifier{
+			ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "year"}},
+			one,
+		},
+	}
+	additionFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.PLUS, Literal: token.PLUS},
+		Arguments: []ast.Tifier{
+			one,
+			ast.FunctionCall{
+				Name: token.Token{Type: token.TYPECAST, Literal: token.TYPECAST},
+				Arguments: []ast.Tifier{
+					ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "1 day"}},
+					ast.Anonymitifier{Token: token.Token{Type: token.INTERVAL, Literal: "INTERVAL"}},
+				},
+			},
+		},
+	}
+	castFunction := ast.FunctionCall{
+		Name: token.Token{Type: token.CAST, Literal: "CAST"},
+		Arguments: []ast.Tifier{
+			ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "two"}},
+			ast.Anonymitifier{Token: token.Token{Type: token.TIMESTAMPTZ, Literal: "TIMESTAMPTZ"}},
+		},
+	}
+	expectedSpaces := []ast.Space{
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "EXTRACT(year FROM one)"}, Function: &extractFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "one + '1 day'::INTERVAL"}, Function: &additionFunction},
+		{ColumnName: token.Token{Type: token.IDENT, Literal: "CAST(two AS TIMESTAMPTZ)"}, Function: &castFunction},
+	}
+
+	lexer := lexer.RunLexer(input)
+	parserInstance := New(lexer)
+	sequences, err := parserInstance.ParseSequence()
+	if err != nil {
+		t.Fatalf("Got error from parser: %s", err)
+	}
+
+	if len(sequences.Commands) != 1 {
+		t.Fatalf("sequences does not contain 1 statements. got=%d", len(sequences.Commands))
+	}
+
+	testSelectStatement(t, sequences.Commands[0], "tbl", expectedSpaces, false)
+}
+
 func TestParseUpdateCommand(t *testing.T) {
 	tests := []struct {
 		input             string
 		expectedTableName string
-		expectedChanges   map[token.Token]ast.Anonymitifier
+		expectedChanges   map[token.Token]ast.Tifier
 	}{
 		{
-			input: "UPDATE tbl SET colName TO 5;", expectedTableName: "tbl", expectedChanges: map[token.Token]ast.Anonymitifier{
-				{Type: token.IDENT, Literal: "colName"}: {Token: token.Token{Type: token.LITERAL, Literal: "5"}},
+			input: "UPDATE tbl SET colName TO 5;", expectedTableName: "tbl", expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName"}: ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "5"}},
+			},
+		},
+		{
+			input: "UPDATE tbl1 SET colName1 TO 'hi hello', colName2 TO 5;", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName1"}: ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "hi hello"}},
+				{Type: token.IDENT, Literal: "colName2"}: ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "5"}},
+			},
+		},
+		{
+			input: "UPDATE tbl1 SET colName1 TO NULL, colName2 TO 'NULL';", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName1"}: ast.Anonymitifier{Token: token.Token{Type: token.NULL, Literal: "NULL"}},
+				{Type: token.IDENT, Literal: "colName2"}: ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "NULL"}},
 			},
 		},
 		{
-			input: "UPDATE tbl1 SET colName1 TO 'hi hello', colName2 TO 5;", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Anonymitifier{
-				{Type: token.IDENT, Literal: "colName1"}: {Token: token.Token{Type: token.IDENT, Literal: "hi hello"}},
-				{Type: token.IDENT, Literal: "colName2"}: {Token: token.Token{Type: token.LITERAL, Literal: "5"}},
+			input: "UPDATE tbl1 SET colName1 TO UPPER(colName2), colName2 TO colName1 || '!';", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName1"}: ast.FunctionCall{
+					Name:      token.Token{Type: token.IDENT, Literal: "UPPER"},
+					Arguments: []ast.Tifier{ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "colName2"}}},
+				},
+				{Type: token.IDENT, Literal: "colName2"}: ast.FunctionCall{
+					Name: token.Token{Type: token.CONCAT, Literal: token.CONCAT},
+					Arguments: []ast.Tifier{
+						ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "colName1"}},
+						ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "!"}},
+					},
+				},
 			},
 		},
 		{
-			input: "UPDATE tbl1 SET colName1 TO NULL, colName2 TO 'NULL';", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Anonymitifier{
-				{Type: token.IDENT, Literal: "colName1"}: {Token: token.Token{Type: token.NULL, Literal: "NULL"}},
-				{Type: token.IDENT, Literal: "colName2"}: {Token: token.Token{Type: token.LITERAL, Literal: "NULL"}},
+			input: "UPDATE tbl1 SET colName1 TO DEFAULT, colName2 TO 5;", expectedTableName: "tbl1", expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName1"}: ast.Anonymitifier{Token: token.Token{Type: token.DEFAULT, Literal: "DEFAULT"}},
+				{Type: token.IDENT, Literal: "colName2"}: ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "5"}},
 			},
 		},
 	}
@@ -755,14 +1971,14 @@ func TestParseUpdateCommandWithWhere(t *testing.T) {
 	tests := []struct {
 		input                string
 		expectedTableName    string
-		expectedChanges      map[token.Token]ast.Anonymitifier
+		expectedChanges      map[token.Token]ast.Tifier
 		expectedWhereCommand ast.Expression
 	}{
 		{
 			input:             "UPDATE tbl SET colName TO 5 WHERE id EQUAL 3;",
 			expectedTableName: "tbl",
-			expectedChanges: map[token.Token]ast.Anonymitifier{
-				{Type: token.IDENT, Literal: "colName"}: {Token: token.Token{Type: token.LITERAL, Literal: "5"}},
+			expectedChanges: map[token.Token]ast.Tifier{
+				{Type: token.IDENT, Literal: "colName"}: ast.Anonymitifier{Token: token.Token{Type: token.LITERAL, Literal: "5"}},
 			},
 			expectedWhereCommand: ast.ConditionExpression{
 				Left:      ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "id"}},
@@ -839,6 +2055,21 @@ func TestParseLogicOperatorsInCommand(t *testing.T) {
 		Right:     ast.Anonymitifier{Token: token.Token{Type: token.IDENT, Literal: "colName1 EQUAL;"}},
 		Condition: token.Token{Type: token.EQUAL, Literal: "EQUAL"}}
 
+	fifthExpression := ast.OperationExpression{
+		Left: ast.PredicateExpression{
+			Value: ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "isActive"}},
+		},
+		Right: ast.ContainExpression{
+			Left: ast.Identifier{Token: token.Token{Type: token.IDENT, Literal: "isAdmin"}},
+			Right: []ast.Anonymitifier{
+				{Token: token.Token{Type: token.TRUE, Literal: "TRUE"}},
+				{Token: token.Token{Type: token.NULL, Literal: "NULL"}},
+			},
+			Contains: true,
+		},
+		Operation: token.Token{Type: token.AND, Literal: "AND"},
+	}
+
 	tests := []struct {
 		input              string
 		expectedExpression ast.Expression
@@ -859,6 +2090,10 @@ func TestParseLogicOperatorsInCommand(t *testing.T) {
 			input:              "SELECT * FROM TBL WHERE 'colName1 EQUAL;' EQUAL 'colName1 EQUAL;';",
 			expectedExpression: fourthExpression,
 		},
+		{
+			input:              "SELECT * FROM TBL WHERE isActive AND isAdmin IN (TRUE, NULL);",
+			expectedExpression: fifthExpression,
+		},
 	}
 
 	for testIndex, tt := range tests {
@@ -915,7 +2150,7 @@ func testSelectStatement(t *testing.T, command ast.Command, expectedTableName st
 	return true
 }
 
-func testUpdateStatement(t *testing.T, command ast.Command, expectedTableName string, expectedChanges map[token.Token]ast.Anonymitifier) bool {
+func testUpdateStatement(t *testing.T, command ast.Command, expectedTableName string, expectedChanges map[token.Token]ast.Tifier) bool {
 	if command.TokenLiteral() != "UPDATE" {
 		t.Errorf("command.TokenLiteral() not 'UPDATE'. got=%q", command.TokenLiteral())
 		return false
@@ -931,7 +2166,7 @@ func testUpdateStatement(t *testing.T, command ast.Command, expectedTableName st
 		return false
 	}
 	if !tokenMapEquals(actualUpdateCommand.Changes, expectedChanges) {
-		t.Errorf("")
+		t.Errorf("changes are not equal, expected: %v, got: %v", expectedChanges, actualUpdateCommand.Changes)
 		return false
 	}
 
@@ -999,16 +2234,23 @@ func spaceArrayEquals(a []ast.Space, b []ast.Space) bool {
 		if v.ContainsAggregateFunc() && b[i].ContainsAggregateFunc() && v.AggregateFunc.Literal != b[i].AggregateFunc.Literal {
 			return false
 		}
+		if v.ContainsFunction() != b[i].ContainsFunction() {
+			return false
+		}
+		if v.ContainsFunction() && b[i].ContainsFunction() && v.Function.String() != b[i].Function.String() {
+			return false
+		}
 	}
 	return true
 }
 
-func tokenMapEquals(a map[token.Token]ast.Anonymitifier, b map[token.Token]ast.Anonymitifier) bool {
+func tokenMapEquals(a map[token.Token]ast.Tifier, b map[token.Token]ast.Tifier) bool {
 	if len(a) != len(b) {
 		return false
 	}
 	for k, v := range a {
-		if v.GetToken().Literal != b[k].GetToken().Literal {
+		actual, exist := b[k]
+		if !exist || ast.TifierToString(v) != ast.TifierToString(actual) {
 			return false
 		}
 	}
@@ -1085,6 +2327,11 @@ func expressionsAreEqual(first ast.Expression, second ast.Expression) bool {
 		return validateBooleanExpressions(second, booleanExpression)
 	}
 
+	predicateExpression, predicateExpressionIsValid := first.(*ast.PredicateExpression)
+	if predicateExpressionIsValid {
+		return validatePredicateExpression(second, predicateExpression)
+	}
+
 	conditionExpression, conditionExpressionIsValid := first.(*ast.ConditionExpression)
 	if conditionExpressionIsValid {
 		return validateConditionExpression(second, conditionExpression)
@@ -1167,9 +2414,28 @@ func validateConditionExpression(second ast.Expression, conditionExpression *ast
 		return false
 	}
 
+	if (conditionExpression.Quantifier == nil) != (secondConditionExpression.Quantifier == nil) {
+		return false
+	}
+
+	if conditionExpression.Quantifier != nil && conditionExpression.Quantifier.Type != secondConditionExpression.Quantifier.Type {
+		return false
+	}
+
 	return true
 }
 
+func validatePredicateExpression(second ast.Expression, predicateExpression *ast.PredicateExpression) bool {
+	secondPredicateExpression, secondPredicateExpressionIsValid := second.(ast.PredicateExpression)
+
+	if !secondPredicateExpressionIsValid {
+		return false
+	}
+
+	return predicateExpression.Value.GetToken() == secondPredicateExpression.Value.GetToken() &&
+		predicateExpression.Value.IsIdentifier() == secondPredicateExpression.Value.IsIdentifier()
+}
+
 func validateBooleanExpressions(second ast.Expression, booleanExpression *ast.BooleanExpression) bool {
 	secondBooleanExpression, secondBooleanExpressionIsValid := second.(ast.BooleanExpression)
 
@@ -11,6 +11,21 @@ type Token struct {
 const (
 	// ASTERISK - Operators
 	ASTERISK = "*"
+	CONCAT   = "||"
+	TYPECAST = "::"
+	PLUS     = "+"
+	MINUS    = "-"
+	SLASH    = "/"
+
+	// ARROW - JSON operators, ARROW returns JSON field and DOUBLE_ARROW returns it as SQL value
+	ARROW        = "->"
+	DOUBLE_ARROW = "->>"
+
+	// LT - Comparison operators
+	LT  = "<"
+	GT  = ">"
+	LTE = "<="
+	GTE = ">="
 
 	// IDENT - Identifiers + literals
 	IDENT   = "IDENT"   // tab, car, apple...
@@ -28,40 +43,91 @@ const (
 	LPAREN = "("
 	RPAREN = ")"
 
+	// LBRACKET - Brackets used in array literals, array types and array subscripts
+	LBRACKET = "["
+	RBRACKET = "]"
+
 	// CREATE - Keywords
-	CREATE   = "CREATE"
-	DROP     = "DROP"
-	TABLE    = "TABLE"
-	INSERT   = "INSERT"
-	INTO     = "INTO"
-	VALUES   = "VALUES"
-	SELECT   = "SELECT"
-	FROM     = "FROM"
-	WHERE    = "WHERE"
-	DELETE   = "DELETE"
-	ORDER    = "ORDER"
-	BY       = "BY"
-	ASC      = "ASC"
-	DESC     = "DESC"
-	LIMIT    = "LIMIT"
-	OFFSET   = "OFFSET"
-	UPDATE   = "UPDATE"
-	SET      = "SET"
-	DISTINCT = "DISTINCT"
-	JOIN     = "JOIN"
-	INNER    = "INNER"
-	FULL     = "FULL"
-	LEFT     = "LEFT"
-	RIGHT    = "RIGHT"
-	ON       = "ON"
-	MIN      = "MIN"
-	MAX      = "MAX"
-	COUNT    = "COUNT"
-	SUM      = "SUM"
-	AVG      = "AVG"
-	IN       = "IN"
-	NOTIN    = "NOTIN"
-	NULL     = "NULL"
+	CREATE     = "CREATE"
+	DROP       = "DROP"
+	TABLE      = "TABLE"
+	TYPE       = "TYPE"
+	ENUM       = "ENUM"
+	INSERT     = "INSERT"
+	INTO       = "INTO"
+	VALUES     = "VALUES"
+	SELECT     = "SELECT"
+	FROM       = "FROM"
+	WHERE      = "WHERE"
+	DELETE     = "DELETE"
+	ORDER      = "ORDER"
+	BY         = "BY"
+	ASC        = "ASC"
+	DESC       = "DESC"
+	LIMIT      = "LIMIT"
+	OFFSET     = "OFFSET"
+	UPDATE     = "UPDATE"
+	SET        = "SET"
+	DISTINCT   = "DISTINCT"
+	JOIN       = "JOIN"
+	INNER      = "INNER"
+	FULL       = "FULL"
+	LEFT       = "LEFT"
+	RIGHT      = "RIGHT"
+	ON         = "ON"
+	MIN        = "MIN"
+	MAX        = "MAX"
+	COUNT      = "COUNT"
+	SUM        = "SUM"
+	AVG        = "AVG"
+	BOOL_AND   = "BOOL_AND"
+	BOOL_OR    = "BOOL_OR"
+	IN         = "IN"
+	NOTIN      = "NOTIN"
+	NULL       = "NULL"
+	CAST       = "CAST"
+	AS         = "AS"
+	EXTRACT    = "EXTRACT"
+	INTERVAL   = "INTERVAL"
+	WITH       = "WITH"
+	ZONE       = "ZONE"
+	ARRAY      = "ARRAY"
+	ANY        = "ANY"
+	ALL        = "ALL"
+	UNNEST     = "UNNEST"
+	PRIMARY    = "PRIMARY"
+	KEY        = "KEY"
+	DEFAULT    = "DEFAULT"
+	CHECK      = "CHECK"
+	CONSTRAINT = "CONSTRAINT"
+	UNIQUE     = "UNIQUE"
+	NULLS      = "NULLS"
+	FOREIGN    = "FOREIGN"
+	REFERENCES = "REFERENCES"
+	CASCADE    = "CASCADE"
+	RESTRICT   = "RESTRICT"
+	SEQUENCE   = "SEQUENCE"
+	INCREMENT  = "INCREMENT"
+	MINVALUE   = "MINVALUE"
+	MAXVALUE   = "MAXVALUE"
+	START      = "START"
+	CYCLE      = "CYCLE"
+	NO         = "NO"
+	GENERATED  = "GENERATED"
+	ALWAYS     = "ALWAYS"
+	IDENTITY   = "IDENTITY"
+	INDEX      = "INDEX"
+	USING      = "USING"
+	HASH       = "HASH"
+	BTREE      = "BTREE"
+	INCLUDE    = "INCLUDE"
+	ALTER      = "ALTER"
+	ADD        = "ADD"
+	COLUMN     = "COLUMN"
+	RENAME     = "RENAME"
+	IF         = "IF"
+	EXISTS     = "EXISTS"
+	TRUNCATE   = "TRUNCATE"
 
 	TO = "TO"
 
@@ -74,56 +140,139 @@ const (
 	FALSE = "FALSE"
 
 	// TEXT - Data types
-	TEXT = "TEXT"
-	INT  = "INT"
+	TEXT        = "TEXT"
+	VARCHAR     = "VARCHAR"
+	CHAR        = "CHAR"
+	SMALLINT    = "SMALLINT"
+	INT         = "INT"
+	BIGINT      = "BIGINT"
+	FLOAT       = "FLOAT"
+	REAL        = "REAL"
+	DECIMAL     = "DECIMAL"
+	BOOLEAN     = "BOOLEAN"
+	DATE        = "DATE"
+	TIME        = "TIME"
+	TIMESTAMP   = "TIMESTAMP"
+	TIMESTAMPTZ = "TIMESTAMPTZ"
+	BLOB        = "BLOB"
+	JSON        = "JSON"
+	UUID        = "UUID"
+	SERIAL      = "SERIAL"
+
+	// HEX - Binary literal written as X'DEADBEEF', literal contains only hexadecimal digits
+	HEX = "HEX"
 
 	// ILLEGAL - System
 	ILLEGAL = "ILLEGAL"
 )
 
 var keywords = map[string]Type{
-	"TEXT":     TEXT,
-	"INT":      INT,
-	"CREATE":   CREATE,
-	"DROP":     DROP,
-	"TABLE":    TABLE,
-	"INSERT":   INSERT,
-	"INTO":     INTO,
-	"SELECT":   SELECT,
-	"FROM":     FROM,
-	"DELETE":   DELETE,
-	"ORDER":    ORDER,
-	"BY":       BY,
-	"ASC":      ASC,
-	"DESC":     DESC,
-	"LIMIT":    LIMIT,
-	"OFFSET":   OFFSET,
-	"UPDATE":   UPDATE,
-	"SET":      SET,
-	"DISTINCT": DISTINCT,
-	"INNER":    INNER,
-	"FULL":     FULL,
-	"LEFT":     LEFT,
-	"RIGHT":    RIGHT,
-	"JOIN":     JOIN,
-	"ON":       ON,
-	"MIN":      MIN,
-	"MAX":      MAX,
-	"COUNT":    COUNT,
-	"SUM":      SUM,
-	"AVG":      AVG,
-	"IN":       IN,
-	"NOTIN":    NOTIN,
-	"TO":       TO,
-	"VALUES":   VALUES,
-	"WHERE":    WHERE,
-	"EQUAL":    EQUAL,
-	"NOT":      NOT,
-	"AND":      AND,
-	"OR":       OR,
-	"TRUE":     TRUE,
-	"FALSE":    FALSE,
-	"NULL":     NULL,
+	"TEXT":        TEXT,
+	"VARCHAR":     VARCHAR,
+	"CHAR":        CHAR,
+	"SMALLINT":    SMALLINT,
+	"INT":         INT,
+	"BIGINT":      BIGINT,
+	"FLOAT":       FLOAT,
+	"REAL":        REAL,
+	"DECIMAL":     DECIMAL,
+	"BOOLEAN":     BOOLEAN,
+	"DATE":        DATE,
+	"TIME":        TIME,
+	"TIMESTAMP":   TIMESTAMP,
+	"TIMESTAMPTZ": TIMESTAMPTZ,
+	"BLOB":        BLOB,
+	"BYTEA":       BLOB,
+	"JSON":        JSON,
+	"UUID":        UUID,
+	"SERIAL":      SERIAL,
+	"CREATE":      CREATE,
+	"DROP":        DROP,
+	"TABLE":       TABLE,
+	"TYPE":        TYPE,
+	"ENUM":        ENUM,
+	"INSERT":      INSERT,
+	"INTO":        INTO,
+	"SELECT":      SELECT,
+	"FROM":        FROM,
+	"DELETE":      DELETE,
+	"ORDER":       ORDER,
+	"BY":          BY,
+	"ASC":         ASC,
+	"DESC":        DESC,
+	"LIMIT":       LIMIT,
+	"OFFSET":      OFFSET,
+	"UPDATE":      UPDATE,
+	"SET":         SET,
+	"DISTINCT":    DISTINCT,
+	"INNER":       INNER,
+	"FULL":        FULL,
+	"LEFT":        LEFT,
+	"RIGHT":       RIGHT,
+	"JOIN":        JOIN,
+	"ON":          ON,
+	"MIN":         MIN,
+	"MAX":         MAX,
+	"COUNT":       COUNT,
+	"SUM":         SUM,
+	"AVG":         AVG,
+	"BOOL_AND":    BOOL_AND,
+	"BOOL_OR":     BOOL_OR,
+	"IN":          IN,
+	"NOTIN":       NOTIN,
+	"TO":          TO,
+	"VALUES":      VALUES,
+	"WHERE":       WHERE,
+	"EQUAL":       EQUAL,
+	"NOT":         NOT,
+	"AND":         AND,
+	"OR":          OR,
+	"TRUE":        TRUE,
+	"FALSE":       FALSE,
+	"NULL":        NULL,
+	"CAST":        CAST,
+	"AS":          AS,
+	"EXTRACT":     EXTRACT,
+	"INTERVAL":    INTERVAL,
+	"WITH":        WITH,
+	"ZONE":        ZONE,
+	"ARRAY":       ARRAY,
+	"ANY":         ANY,
+	"ALL":         ALL,
+	"UNNEST":      UNNEST,
+	"PRIMARY":     PRIMARY,
+	"KEY":         KEY,
+	"DEFAULT":     DEFAULT,
+	"CHECK":       CHECK,
+	"CONSTRAINT":  CONSTRAINT,
+	"UNIQUE":      UNIQUE,
+	"NULLS":       NULLS,
+	"FOREIGN":     FOREIGN,
+	"REFERENCES":  REFERENCES,
+	"CASCADE":     CASCADE,
+	"RESTRICT":    RESTRICT,
+	"SEQUENCE":    SEQUENCE,
+	"INCREMENT":   INCREMENT,
+	"MINVALUE":    MINVALUE,
+	"MAXVALUE":    MAXVALUE,
+	"START":       START,
+	"CYCLE":       CYCLE,
+	"NO":          NO,
+	"GENERATED":   GENERATED,
+	"ALWAYS":      ALWAYS,
+	"IDENTITY":    IDENTITY,
+	"INDEX":       INDEX,
+	"USING":       USING,
+	"HASH":        HASH,
+	"BTREE":       BTREE,
+	"INCLUDE":     INCLUDE,
+	"ALTER":       ALTER,
+	"ADD":         ADD,
+	"COLUMN":      COLUMN,
+	"RENAME":      RENAME,
+	"IF":          IF,
+	"EXISTS":      EXISTS,
+	"TRUNCATE":    TRUNCATE,
 }
 
 // LookupIdent - Return keyword type from defined list if exists, otherwise it returns IDENT type